@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/balancer"
 	_ "google.golang.org/grpc/xds"
 
+	"github.com/authzed/spicedb/internal/dispatch/membership"
 	log "github.com/authzed/spicedb/internal/logging"
 	"github.com/authzed/spicedb/pkg/cmd"
 	cmdutil "github.com/authzed/spicedb/pkg/cmd/server"
@@ -24,6 +25,9 @@ func main() {
 	// Enable Kubernetes gRPC resolver
 	kuberesolver.RegisterInCluster()
 
+	// Enable static peer file and DNS SRV gRPC resolvers
+	membership.RegisterResolvers()
+
 	// Enable consistent hashring gRPC load balancer
 	balancer.Register(cmdutil.ConsistentHashringBuilder)
 
@@ -52,6 +56,14 @@ func main() {
 	cmd.RegisterDatastoreRootFlags(datastoreCmd)
 	rootCmd.AddCommand(datastoreCmd)
 
+	// Add import command
+	var importConfig cmd.ImportConfig
+	importCmd := cmd.NewImportCommand(rootCmd.Use, &importConfig)
+	if err := cmd.RegisterImportFlags(importCmd, &importConfig); err != nil {
+		log.Fatal().Err(err).Msg("failed to register import flags")
+	}
+	rootCmd.AddCommand(importCmd)
+
 	// Add deprecated head command
 	headCmd := cmd.NewHeadCommand(rootCmd.Use)
 	cmd.RegisterHeadFlags(headCmd)
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// CertificateScheme authenticates requests made over connections whose TLS client certificate
+// was verified by the server. The principal is identified by the first URI subject alternative
+// name of the certificate, such as a SPIFFE ID, or by its first DNS name if it has no URI.
+func CertificateScheme(ctx context.Context) (Principal, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return Principal{}, errSchemeNotApplicable
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return Principal{}, errSchemeNotApplicable
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	switch {
+	case len(leaf.URIs) > 0:
+		return Principal{Method: MethodCertificate, ID: leaf.URIs[0].String()}, nil
+	case len(leaf.DNSNames) > 0:
+		return Principal{Method: MethodCertificate, ID: leaf.DNSNames[0]}, nil
+	default:
+		return Principal{}, status.Errorf(codes.PermissionDenied, "invalid client certificate: the certificate has no URI or DNS subject alternative name")
+	}
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// jwtClockSkewLeeway is the leeway granted when validating the time claims of JWTs.
+	jwtClockSkewLeeway = time.Minute
+
+	// jwksMinReloadInterval is the minimum interval between reloads of the JWKS file, which is
+	// reloaded when a token is signed by an unknown key.
+	jwksMinReloadInterval = 10 * time.Second
+)
+
+// JWTConfig configures the authentication of requests with signed JWT bearer tokens.
+type JWTConfig struct {
+	// JWKSPath is the local path to the JSON Web Key Set of the keys signing the tokens.
+	JWKSPath string
+
+	// Issuer is the required `iss` claim of the tokens, if non-empty.
+	Issuer string
+
+	// Audience must be among the `aud` claim of the tokens, if non-empty.
+	Audience string
+
+	// IdentityClaim is the claim identifying the principal, which defaults to `sub`.
+	IdentityClaim string
+}
+
+// supportedAlgorithms are the JWS algorithms of the tokens accepted by a JWTVerifier.
+var supportedAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTVerifier verifies the signed JWT bearer tokens of requests against a local JWKS file.
+type JWTVerifier struct {
+	config JWTConfig
+	now    func() time.Time
+
+	lock       sync.RWMutex
+	keys       []jose.JSONWebKey
+	modTime    time.Time
+	lastReload time.Time
+}
+
+// NewJWTVerifier creates a new JWTVerifier, loading the keys of its JWKS file.
+func NewJWTVerifier(config JWTConfig) (*JWTVerifier, error) {
+	if config.JWKSPath == "" {
+		return nil, fmt.Errorf("a JWKS path is required to verify JWTs")
+	}
+
+	if config.IdentityClaim == "" {
+		config.IdentityClaim = "sub"
+	}
+
+	v := &JWTVerifier{config: config, now: time.Now}
+	if err := v.reload(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// reload loads the keys of the JWKS file, if it was modified since they were last loaded.
+func (v *JWTVerifier) reload() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.lastReload = v.now()
+
+	info, err := os.Stat(v.config.JWKSPath)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	if v.keys != nil && info.ModTime().Equal(v.modTime) {
+		return nil
+	}
+
+	contents, err := os.ReadFile(v.config.JWKSPath)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	keys, err := parseJWKS(contents)
+	if err != nil {
+		return fmt.Errorf("failed to parse JWKS file `%s`: %w", v.config.JWKSPath, err)
+	}
+
+	v.keys = keys
+	v.modTime = info.ModTime()
+	return nil
+}
+
+// keysFor returns the keys which may have signed a token with the given key ID and algorithm.
+func (v *JWTVerifier) keysFor(kid, alg string) []jose.JSONWebKey {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	var keys []jose.JSONWebKey
+	for _, key := range v.keys {
+		if kid != "" && key.KeyID != kid {
+			continue
+		}
+		if key.Algorithm != "" && key.Algorithm != alg {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Scheme returns a Scheme authenticating requests with a bearer token shaped like a JWT.
+func (v *JWTVerifier) Scheme() Scheme {
+	return func(ctx context.Context) (Principal, error) {
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return Principal{}, err
+		}
+
+		if !isJWT(token) {
+			return Principal{}, errSchemeNotApplicable
+		}
+
+		identity, err := v.Verify(token)
+		if err != nil {
+			return Principal{}, status.Errorf(codes.PermissionDenied, "invalid JWT: %s", err)
+		}
+		return Principal{Method: MethodJWT, ID: identity}, nil
+	}
+}
+
+// Verify verifies the signature and claims of the token, returning the identity of its
+// principal.
+func (v *JWTVerifier) Verify(token string) (string, error) {
+	parsed, err := jwt.ParseSigned(token, supportedAlgorithms)
+	if err != nil {
+		return "", fmt.Errorf("malformed token: %w", err)
+	}
+
+	header := parsed.Headers[0]
+	keys := v.keysFor(header.KeyID, header.Algorithm)
+	if len(keys) == 0 {
+		v.lock.RLock()
+		canReload := v.now().Sub(v.lastReload) >= jwksMinReloadInterval
+		v.lock.RUnlock()
+
+		if canReload {
+			if err := v.reload(); err != nil {
+				return "", err
+			}
+			keys = v.keysFor(header.KeyID, header.Algorithm)
+		}
+	}
+
+	var (
+		claims      jwt.Claims
+		otherClaims map[string]any
+		verified    bool
+	)
+	for _, key := range keys {
+		if err := parsed.Claims(key.Key, &claims, &otherClaims); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return "", errors.New("the signature was not made by any key of the JWKS")
+	}
+
+	if err := v.validateClaims(claims); err != nil {
+		return "", err
+	}
+
+	identity, ok := otherClaims[v.config.IdentityClaim].(string)
+	if !ok || identity == "" {
+		return "", fmt.Errorf("the token has no `%s` claim", v.config.IdentityClaim)
+	}
+	return identity, nil
+}
+
+// validateClaims validates the registered claims of a token.
+func (v *JWTVerifier) validateClaims(claims jwt.Claims) error {
+	if claims.Expiry == nil {
+		return errors.New("the token has no expiration")
+	}
+
+	expected := jwt.Expected{Issuer: v.config.Issuer, Time: v.now()}
+	if v.config.Audience != "" {
+		expected.AnyAudience = jwt.Audience{v.config.Audience}
+	}
+
+	err := claims.ValidateWithLeeway(expected, jwtClockSkewLeeway)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, jwt.ErrExpired):
+		return errors.New("the token is expired")
+	case errors.Is(err, jwt.ErrNotValidYet), errors.Is(err, jwt.ErrIssuedInTheFuture):
+		return errors.New("the token is not valid yet")
+	case errors.Is(err, jwt.ErrInvalidIssuer):
+		return fmt.Errorf("the token was not issued by `%s`", v.config.Issuer)
+	case errors.Is(err, jwt.ErrInvalidAudience):
+		return fmt.Errorf("the token is not intended for audience `%s`", v.config.Audience)
+	default:
+		return err
+	}
+}
+
+// parseJWKS parses the signature verification keys of a JSON Web Key Set.
+func parseJWKS(contents []byte) ([]jose.JSONWebKey, error) {
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(contents, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make([]jose.JSONWebKey, 0, len(jwks.Keys))
+	for index, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		if !key.Valid() || !key.IsPublic() {
+			return nil, fmt.Errorf("invalid key #%d: not a valid public key", index+1)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("the JWKS has no signature verification key")
+	}
+	return keys, nil
+}
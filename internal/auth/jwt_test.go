@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+type testSigner struct {
+	kid string
+	alg string
+	key crypto.Signer
+}
+
+func (s testSigner) jwk() jose.JSONWebKey {
+	return jose.JSONWebKey{Key: s.key.Public(), KeyID: s.kid}
+}
+
+func (s testSigner) sign(t *testing.T, claims map[string]any) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.SignatureAlgorithm(s.alg), Key: jose.JSONWebKey{Key: s.key, KeyID: s.kid}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func writeJWKS(t *testing.T, path string, signers ...testSigner) {
+	jwks := jose.JSONWebKeySet{}
+	for _, signer := range signers {
+		jwks.Keys = append(jwks.Keys, signer.jwk())
+	}
+
+	contents, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+}
+
+func TestJWTVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rsaSigner := testSigner{"rsa", "RS256", rsaKey}
+	pssSigner := testSigner{"rsa", "PS384", rsaKey}
+	ecSigner := testSigner{"ec", "ES256", ecKey}
+	edSigner := testSigner{"ed", "EdDSA", edKey}
+	unknownSigner := testSigner{"ec", "ES256", otherKey}
+
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksPath, rsaSigner, ecSigner, edSigner)
+
+	verifier, err := NewJWTVerifier(JWTConfig{JWKSPath: jwksPath, Issuer: "https://issuer", Audience: "spicedb"})
+	require.NoError(t, err)
+
+	now := time.Now()
+	verifier.now = func() time.Time { return now }
+
+	validClaims := func() map[string]any {
+		return map[string]any{"sub": "analytics", "iss": "https://issuer", "aud": []string{"other", "spicedb"}, "exp": now.Add(time.Hour).Unix()}
+	}
+
+	tcs := []struct {
+		name          string
+		signer        testSigner
+		modifyClaims  func(claims map[string]any)
+		expectedError string
+	}{
+		{"RS256", rsaSigner, nil, ""},
+		{"PS384", pssSigner, nil, ""},
+		{"ES256", ecSigner, nil, ""},
+		{"EdDSA", edSigner, nil, ""},
+		{"single audience", ecSigner, func(claims map[string]any) { claims["aud"] = "spicedb" }, ""},
+		{"unknown key", unknownSigner, nil, "signature"},
+		{"expired", ecSigner, func(claims map[string]any) { claims["exp"] = now.Add(-time.Hour).Unix() }, "expired"},
+		{"no expiration", ecSigner, func(claims map[string]any) { delete(claims, "exp") }, "expiration"},
+		{"not valid yet", ecSigner, func(claims map[string]any) { claims["nbf"] = now.Add(time.Hour).Unix() }, "not valid yet"},
+		{"other issuer", ecSigner, func(claims map[string]any) { claims["iss"] = "https://other" }, "issued"},
+		{"other audience", ecSigner, func(claims map[string]any) { claims["aud"] = "other" }, "audience"},
+		{"no subject", ecSigner, func(claims map[string]any) { delete(claims, "sub") }, "sub"},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			claims := validClaims()
+			if tc.modifyClaims != nil {
+				tc.modifyClaims(claims)
+			}
+
+			identity, err := verifier.Verify(tc.signer.sign(t, claims))
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "analytics", identity)
+		})
+	}
+
+	_, err = verifier.Verify("not.a.token")
+	require.Error(t, err)
+
+	// Tokens signed by keys added to the JWKS file are accepted once it is reloaded.
+	writeJWKS(t, jwksPath, rsaSigner, ecSigner, edSigner, testSigner{"other", "ES256", otherKey})
+	require.NoError(t, os.Chtimes(jwksPath, now, now.Add(time.Minute)))
+
+	otherToken := testSigner{"other", "ES256", otherKey}.sign(t, validClaims())
+	_, err = verifier.Verify(otherToken)
+	require.Error(t, err, "the JWKS file must not be reloaded more often than the minimum interval")
+
+	now = now.Add(jwksMinReloadInterval)
+	_, err = verifier.Verify(otherToken)
+	require.NoError(t, err)
+}
+
+func TestNewJWTVerifierValidation(t *testing.T) {
+	_, err := NewJWTVerifier(JWTConfig{})
+	require.Error(t, err)
+
+	_, err = NewJWTVerifier(JWTConfig{JWKSPath: filepath.Join(t.TempDir(), "missing.json")})
+	require.Error(t, err)
+
+	emptyPath := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(emptyPath, []byte(`{"keys": [{"kty": "RSA", "use": "enc"}]}`), 0o600))
+	_, err = NewJWTVerifier(JWTConfig{JWKSPath: emptyPath})
+	require.Error(t, err)
+
+	invalidPath := filepath.Join(t.TempDir(), "invalid.json")
+	require.NoError(t, os.WriteFile(invalidPath, []byte(`{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`), 0o600))
+	_, err = NewJWTVerifier(JWTConfig{JWKSPath: invalidPath})
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"crypto/subtle"
+	"strconv"
 
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"google.golang.org/grpc/codes"
@@ -12,6 +13,8 @@ import (
 const (
 	errInvalidPresharedKey = "invalid preshared key: %s"
 	errMissingPresharedKey = "missing preshared key"
+
+	errInvalidDispatchCredentials = "dispatches require a preshared key of the cluster: %s"
 )
 
 var errInvalidToken = "invalid token"
@@ -19,6 +22,44 @@ var errInvalidToken = "invalid token"
 // MustRequirePresharedKey requires that gRPC requests have a Bearer Token value
 // equivalent to one of the provided preshared key(s).
 func MustRequirePresharedKey(presharedKeys []string) grpcauth.AuthFunc {
+	scheme := MustPresharedKeyScheme(presharedKeys, false)
+	return func(ctx context.Context) (context.Context, error) {
+		token, err := grpcauth.AuthFromMD(ctx, "bearer")
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, errInvalidPresharedKey, err.Error())
+		}
+
+		if token == "" {
+			return nil, status.Errorf(codes.Unauthenticated, errMissingPresharedKey)
+		}
+
+		principal, err := scheme(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return ContextWithPrincipal(ctx, principal), nil
+	}
+}
+
+// MustRequireDispatchPresharedKey requires that dispatch requests have a Bearer Token value
+// equivalent to one of the preshared key(s) shared by the nodes of the cluster. Requests made
+// with any other credentials, such as a client certificate or a JWT, are unauthenticated, as
+// these do not grant access to the dispatch API.
+func MustRequireDispatchPresharedKey(presharedKeys []string) grpcauth.AuthFunc {
+	authFunc := MustRequirePresharedKey(presharedKeys)
+	return func(ctx context.Context) (context.Context, error) {
+		authenticatedCtx, err := authFunc(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, errInvalidDispatchCredentials, status.Convert(err).Message())
+		}
+		return authenticatedCtx, nil
+	}
+}
+
+// MustPresharedKeyScheme returns a Scheme authenticating requests whose Bearer Token value is
+// equivalent to one of the provided preshared key(s). If deferJWTs is true, tokens shaped like
+// a JWT which match no key are left to the following schemes.
+func MustPresharedKeyScheme(presharedKeys []string, deferJWTs bool) Scheme {
 	if len(presharedKeys) == 0 {
 		panic("RequirePresharedKey was given an empty preshared keys slice")
 	}
@@ -29,22 +70,21 @@ func MustRequirePresharedKey(presharedKeys []string) grpcauth.AuthFunc {
 		}
 	}
 
-	return func(ctx context.Context) (context.Context, error) {
-		token, err := grpcauth.AuthFromMD(ctx, "bearer")
+	return func(ctx context.Context) (Principal, error) {
+		token, err := bearerToken(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, errInvalidPresharedKey, err.Error())
+			return Principal{}, err
 		}
 
-		if token == "" {
-			return nil, status.Errorf(codes.Unauthenticated, errMissingPresharedKey)
-		}
-
-		for _, presharedKey := range presharedKeys {
+		for index, presharedKey := range presharedKeys {
 			if match := subtle.ConstantTimeCompare([]byte(presharedKey), []byte(token)); match == 1 {
-				return ctx, nil
+				return Principal{Method: MethodPresharedKey, ID: strconv.Itoa(index + 1)}, nil
 			}
 		}
 
-		return nil, status.Errorf(codes.PermissionDenied, errInvalidPresharedKey, errInvalidToken)
+		if deferJWTs && isJWT(token) {
+			return Principal{}, errSchemeNotApplicable
+		}
+		return Principal{}, status.Errorf(codes.PermissionDenied, errInvalidPresharedKey, errInvalidToken)
 	}
 }
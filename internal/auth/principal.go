@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+
+	log "github.com/authzed/spicedb/internal/logging"
+)
+
+// Method is the scheme by which the principal of a request was authenticated.
+type Method string
+
+const (
+	// MethodPresharedKey authenticates principals holding a preshared key, identified by the
+	// 1-based index of their key.
+	MethodPresharedKey Method = "presharedkey"
+
+	// MethodCertificate authenticates principals by their TLS client certificate, identified by
+	// the first URI or DNS subject alternative name of their certificate.
+	MethodCertificate Method = "certificate"
+
+	// MethodJWT authenticates principals by a signed JWT bearer token, identified by the
+	// configured claim of their token.
+	MethodJWT Method = "jwt"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	Method Method
+	ID     string
+}
+
+// String returns the identity of the principal, of the form `method:id`.
+func (p Principal) String() string {
+	return string(p.Method) + ":" + p.ID
+}
+
+// IsPrincipalIdentity returns whether the string is the identity of a principal authenticated
+// with a client certificate or a JWT, as returned by Principal.String.
+func IsPrincipalIdentity(identity string) bool {
+	for _, method := range []Method{MethodCertificate, MethodJWT} {
+		if id, ok := strings.CutPrefix(identity, string(method)+":"); ok && id != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type ctxKeyType struct{}
+
+var principalKey ctxKeyType = struct{}{}
+
+// ContextWithPrincipal returns a context carrying the principal of its request, whose logger
+// records the principal.
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	ctx = context.WithValue(ctx, principalKey, principal)
+	ctx = grpclog.InjectFields(ctx, grpclog.Fields{"principal", principal.String()})
+
+	logger := log.Ctx(ctx).With().Str("principal", principal.String()).Logger()
+	return logger.WithContext(ctx)
+}
+
+// PrincipalFromContext returns the principal of the request of the context, if it was
+// authenticated.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(Principal)
+	return principal, ok
+}
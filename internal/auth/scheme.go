@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errMissingCredentials = "missing credentials: %s"
+	errInvalidCredentials = "invalid credentials: the bearer token is not accepted by any authentication scheme"
+)
+
+// errSchemeNotApplicable is returned by schemes that do not apply to the credentials of a
+// request, such that the next scheme is tried.
+var errSchemeNotApplicable = errors.New("authentication scheme not applicable")
+
+// Scheme authenticates the principal of a request. It returns errSchemeNotApplicable if the
+// request does not carry credentials it can verify.
+type Scheme func(ctx context.Context) (Principal, error)
+
+// MustRequireAnyScheme requires that gRPC requests be authenticated by one of the given schemes,
+// tried in order. The principal of authenticated requests is added to their context.
+func MustRequireAnyScheme(schemes ...Scheme) grpcauth.AuthFunc {
+	if len(schemes) == 0 {
+		panic("RequireAnyScheme was given no authentication scheme")
+	}
+
+	return func(ctx context.Context) (context.Context, error) {
+		for _, scheme := range schemes {
+			principal, err := scheme(ctx)
+			if errors.Is(err, errSchemeNotApplicable) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			return ContextWithPrincipal(ctx, principal), nil
+		}
+
+		token, err := grpcauth.AuthFromMD(ctx, "bearer")
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, errMissingCredentials, err.Error())
+		}
+		if token == "" {
+			return nil, status.Errorf(codes.Unauthenticated, errMissingCredentials, "empty bearer token")
+		}
+		return nil, status.Errorf(codes.PermissionDenied, errInvalidCredentials)
+	}
+}
+
+// bearerToken returns the bearer token of the request, or errSchemeNotApplicable if it has none.
+func bearerToken(ctx context.Context) (string, error) {
+	token, err := grpcauth.AuthFromMD(ctx, "bearer")
+	if err != nil || token == "" {
+		return "", errSchemeNotApplicable
+	}
+	return token, nil
+}
+
+// isJWT returns whether the token has the shape of a JWT in compact serialization.
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/authzed/grpcutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func withVerifiedCertificate(ctx context.Context, certificate *x509.Certificate) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{certificate}},
+		}},
+	})
+}
+
+func TestCertificateScheme(t *testing.T) {
+	spiffeID, err := url.Parse("spiffe://cluster.local/ns/analytics/sa/job")
+	require.NoError(t, err)
+
+	principal, err := CertificateScheme(withVerifiedCertificate(context.Background(), &x509.Certificate{
+		URIs:     []*url.URL{spiffeID},
+		DNSNames: []string{"analytics.internal"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "certificate:spiffe://cluster.local/ns/analytics/sa/job", principal.String())
+
+	principal, err = CertificateScheme(withVerifiedCertificate(context.Background(), &x509.Certificate{
+		DNSNames: []string{"admin.internal"},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "certificate:admin.internal", principal.String())
+
+	_, err = CertificateScheme(withVerifiedCertificate(context.Background(), &x509.Certificate{}))
+	grpcutil.RequireStatus(t, codes.PermissionDenied, err)
+
+	_, err = CertificateScheme(context.Background())
+	require.ErrorIs(t, err, errSchemeNotApplicable)
+
+	_, err = CertificateScheme(peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}}))
+	require.ErrorIs(t, err, errSchemeNotApplicable)
+}
+
+func TestMustRequireAnyScheme(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	signer := testSigner{"key", "ES256", key}
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksPath, signer)
+
+	verifier, err := NewJWTVerifier(JWTConfig{JWKSPath: jwksPath})
+	require.NoError(t, err)
+
+	validJWT := signer.sign(t, map[string]any{"sub": "analytics", "exp": time.Now().Add(time.Hour).Unix()})
+	expiredJWT := signer.sign(t, map[string]any{"sub": "analytics", "exp": time.Now().Add(-time.Hour).Unix()})
+
+	authFunc := MustRequireAnyScheme(CertificateScheme, MustPresharedKeyScheme([]string{"one", "two"}, true), verifier.Scheme())
+	presharedKeysOnly := MustRequireAnyScheme(MustPresharedKeyScheme([]string{"one", "two"}, false))
+
+	testcases := []struct {
+		name              string
+		ctx               context.Context
+		expectedStatus    codes.Code
+		expectedPrincipal string
+	}{
+		{"preshared key", withTokenMetadata("bearer two"), codes.OK, "presharedkey:2"},
+		{"JWT", withTokenMetadata("bearer " + validJWT), codes.OK, "jwt:analytics"},
+		{"expired JWT", withTokenMetadata("bearer " + expiredJWT), codes.PermissionDenied, ""},
+		{"unknown token", withTokenMetadata("bearer three"), codes.PermissionDenied, ""},
+		{"certificate", withVerifiedCertificate(context.Background(), &x509.Certificate{DNSNames: []string{"admin.internal"}}), codes.OK, "certificate:admin.internal"},
+		{"certificate with token", withVerifiedCertificate(withTokenMetadata("bearer three"), &x509.Certificate{DNSNames: []string{"admin.internal"}}), codes.OK, "certificate:admin.internal"},
+		{"empty token", withTokenMetadata("bearer "), codes.Unauthenticated, ""},
+		{"missing credentials", context.Background(), codes.Unauthenticated, ""},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			ctx, err := authFunc(testcase.ctx)
+			if testcase.expectedStatus != codes.OK {
+				grpcutil.RequireStatus(t, testcase.expectedStatus, err)
+				return
+			}
+
+			require.NoError(t, err)
+			principal, ok := PrincipalFromContext(ctx)
+			require.True(t, ok)
+			require.Equal(t, testcase.expectedPrincipal, principal.String())
+		})
+	}
+
+	// Without JWT authentication, tokens shaped like a JWT are treated as preshared keys.
+	_, err = presharedKeysOnly(withTokenMetadata("bearer " + validJWT))
+	grpcutil.RequireStatus(t, codes.PermissionDenied, err)
+}
+
+func TestIsPrincipalIdentity(t *testing.T) {
+	require.True(t, IsPrincipalIdentity("jwt:analytics"))
+	require.True(t, IsPrincipalIdentity("certificate:spiffe://cluster.local/ns/admin"))
+	require.False(t, IsPrincipalIdentity("presharedkey:1"))
+	require.False(t, IsPrincipalIdentity("jwt:"))
+	require.False(t, IsPrincipalIdentity("somekey"))
+}
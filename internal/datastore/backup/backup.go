@@ -0,0 +1,476 @@
+// Package backup implements exporting the full contents of a datastore at a single revision into a
+// portable file, and restoring such a file into any supported datastore.
+//
+// A backup file is a gzip-compressed stream of newline-delimited JSON records: a header, followed
+// by all namespace and caveat definitions, followed by all relationships and finally a footer
+// holding the SHA-256 checksum of all preceding records. Definitions and relationships are encoded
+// as the protobuf JSON form of their core messages, so files can be restored into any engine.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/datastore/options"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+
+	// Registers the types used within namespace metadata for JSON encoding.
+	_ "github.com/authzed/spicedb/pkg/proto/impl/v1"
+)
+
+// FormatVersion is the version of the backup file format written by Export.
+const FormatVersion = 1
+
+const defaultBatchSize = 1_000
+
+// Header describes the contents of a backup file.
+type Header struct {
+	// Version is the version of the backup file format.
+	Version int `json:"version"`
+
+	// Engine is the engine of the datastore from which the backup was exported.
+	Engine string `json:"engine"`
+
+	// Revision is the revision of the datastore at which the backup was exported. The revision is
+	// only meaningful for the exporting datastore, and is not preserved on restore.
+	Revision string `json:"revision"`
+
+	// CreatedAt is the time at which the backup was exported.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Stats holds the number of items exported or restored.
+type Stats struct {
+	Namespaces    uint64 `json:"namespaces"`
+	Caveats       uint64 `json:"caveats"`
+	Relationships uint64 `json:"relationships"`
+}
+
+type footer struct {
+	Stats
+	Checksum string `json:"checksum"`
+}
+
+type record struct {
+	Header       *Header         `json:"header,omitempty"`
+	Namespace    json.RawMessage `json:"namespace,omitempty"`
+	Caveat       json.RawMessage `json:"caveat,omitempty"`
+	Relationship json.RawMessage `json:"relationship,omitempty"`
+	Footer       *footer         `json:"footer,omitempty"`
+}
+
+// Export writes the schema, caveats and all relationships found in the datastore at the given
+// revision to the writer.
+func Export(ctx context.Context, ds datastore.Datastore, revision datastore.Revision, engine string, w io.Writer) (Stats, error) {
+	gzipWriter := gzip.NewWriter(w)
+	rw := &recordWriter{
+		w:    bufio.NewWriter(gzipWriter),
+		hash: sha256.New(),
+	}
+
+	if err := rw.write(record{Header: &Header{
+		Version:   FormatVersion,
+		Engine:    engine,
+		Revision:  revision.String(),
+		CreatedAt: time.Now().UTC(),
+	}}); err != nil {
+		return Stats{}, err
+	}
+
+	reader := ds.SnapshotReader(revision)
+
+	namespaces, err := reader.ListAllNamespaces(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	// Make sure the namespaces are always in a stable order.
+	slices.SortFunc(namespaces, func(lhs, rhs datastore.RevisionedNamespace) int {
+		return strings.Compare(lhs.Definition.Name, rhs.Definition.Name)
+	})
+
+	caveats, err := reader.ListAllCaveats(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to list caveats: %w", err)
+	}
+
+	slices.SortFunc(caveats, func(lhs, rhs datastore.RevisionedCaveat) int {
+		return strings.Compare(lhs.Definition.Name, rhs.Definition.Name)
+	})
+
+	var stats Stats
+	for _, caveat := range caveats {
+		encoded, err := protojson.Marshal(caveat.Definition)
+		if err != nil {
+			return Stats{}, fmt.Errorf("failed to encode caveat `%s`: %w", caveat.Definition.Name, err)
+		}
+
+		if err := rw.write(record{Caveat: encoded}); err != nil {
+			return Stats{}, err
+		}
+		stats.Caveats++
+	}
+
+	for _, namespace := range namespaces {
+		encoded, err := protojson.Marshal(namespace.Definition)
+		if err != nil {
+			return Stats{}, fmt.Errorf("failed to encode namespace `%s`: %w", namespace.Definition.Name, err)
+		}
+
+		if err := rw.write(record{Namespace: encoded}); err != nil {
+			return Stats{}, err
+		}
+		stats.Namespaces++
+	}
+
+	for _, namespace := range namespaces {
+		exported, err := exportRelationships(ctx, reader, namespace.Definition.Name, rw)
+		if err != nil {
+			return Stats{}, err
+		}
+		stats.Relationships += exported
+	}
+
+	if err := rw.writeFooter(stats); err != nil {
+		return Stats{}, err
+	}
+
+	if err := rw.w.Flush(); err != nil {
+		return Stats{}, fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return Stats{}, fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	return stats, nil
+}
+
+func exportRelationships(ctx context.Context, reader datastore.Reader, namespaceName string, rw *recordWriter) (uint64, error) {
+	limit := uint64(defaultBatchSize)
+
+	var exported uint64
+	var cursor options.Cursor
+	for {
+		iter, err := reader.QueryRelationships(
+			ctx,
+			datastore.RelationshipsFilter{OptionalResourceType: namespaceName},
+			options.WithLimit(&limit),
+			options.WithAfter(cursor),
+			options.WithSort(options.ByResource),
+		)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query relationships: %w", err)
+		}
+
+		var batchCount uint64
+		for tpl := iter.Next(); tpl != nil; tpl = iter.Next() {
+			encoded, err := protojson.Marshal(tpl)
+			if err != nil {
+				iter.Close()
+				return 0, fmt.Errorf("failed to encode relationship: %w", err)
+			}
+
+			if err := rw.write(record{Relationship: encoded}); err != nil {
+				iter.Close()
+				return 0, err
+			}
+			batchCount++
+		}
+
+		if iter.Err() != nil {
+			iter.Close()
+			return 0, fmt.Errorf("failed to query relationships: %w", iter.Err())
+		}
+
+		exported += batchCount
+		if batchCount < limit {
+			iter.Close()
+			return exported, nil
+		}
+
+		cursor, err = iter.Cursor()
+		iter.Close()
+		if err != nil {
+			return 0, fmt.Errorf("failed to query relationships: %w", err)
+		}
+	}
+}
+
+// recordWriter writes records, hashing all records other than the footer.
+type recordWriter struct {
+	w    *bufio.Writer
+	hash hash.Hash
+}
+
+func (rw *recordWriter) write(rec record) error {
+	encoded, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode backup record: %w", err)
+	}
+	encoded = append(encoded, '\n')
+
+	rw.hash.Write(encoded)
+	if _, err := rw.w.Write(encoded); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+func (rw *recordWriter) writeFooter(stats Stats) error {
+	encoded, err := json.Marshal(record{Footer: &footer{
+		Stats:    stats,
+		Checksum: hex.EncodeToString(rw.hash.Sum(nil)),
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to encode backup record: %w", err)
+	}
+
+	if _, err := rw.w.Write(append(encoded, '\n')); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+// ErrChecksumMismatch is returned when the contents of a backup file do not match its checksum.
+var ErrChecksumMismatch = errors.New("backup file checksum does not match its contents")
+
+// Verify reads the entire backup file, ensuring that it is complete and that its contents match
+// its checksum, and returns its header and the stats recorded when it was exported.
+func Verify(r io.Reader) (*Header, Stats, error) {
+	rr, err := newRecordReader(r)
+	if err != nil {
+		return nil, Stats{}, err
+	}
+
+	for {
+		rec, err := rr.next()
+		if err != nil {
+			return nil, Stats{}, err
+		}
+
+		if rec.Footer != nil {
+			return rr.header, rec.Footer.Stats, nil
+		}
+	}
+}
+
+// Restore loads the contents of a backup file into the datastore, which must not contain any
+// namespaces. Definitions are written in a single transaction, while relationships are loaded in
+// batches of the given size via BulkLoad.
+//
+// The checksum of the file is only validated once all of its contents have been read, so callers
+// should call Verify before restoring from a file which may have been corrupted.
+func Restore(ctx context.Context, r io.Reader, ds datastore.Datastore, batchSize int) (Stats, error) {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	headRevision, err := ds.HeadRevision(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to determine head revision: %w", err)
+	}
+
+	existing, err := ds.SnapshotReader(headRevision).ListAllNamespaces(ctx)
+	if err != nil {
+		return Stats{}, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	if len(existing) > 0 {
+		return Stats{}, fmt.Errorf("backups can only be restored into an empty datastore, found %d existing definitions", len(existing))
+	}
+
+	rr, err := newRecordReader(r)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	var stats Stats
+	var namespaces []*core.NamespaceDefinition
+	var caveats []*core.CaveatDefinition
+	var batch []*core.RelationTuple
+	definitionsWritten := false
+
+	writeDefinitions := func() error {
+		definitionsWritten = true
+		_, err := ds.ReadWriteTx(ctx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+			if err := rwt.WriteCaveats(ctx, caveats); err != nil {
+				return err
+			}
+			return rwt.WriteNamespaces(ctx, namespaces...)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write definitions: %w", err)
+		}
+
+		stats.Namespaces = uint64(len(namespaces))
+		stats.Caveats = uint64(len(caveats))
+		return nil
+	}
+
+	loadBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		var loaded uint64
+		_, err := ds.ReadWriteTx(ctx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+			var err error
+			loaded, err = rwt.BulkLoad(ctx, common.NewSliceRelationshipSource(batch))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to load relationships: %w", err)
+		}
+
+		stats.Relationships += loaded
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		rec, err := rr.next()
+		if err != nil {
+			return stats, err
+		}
+
+		switch {
+		case rec.Caveat != nil:
+			if definitionsWritten {
+				return stats, fmt.Errorf("found caveat definition after relationships")
+			}
+
+			caveat := &core.CaveatDefinition{}
+			if err := protojson.Unmarshal(rec.Caveat, caveat); err != nil {
+				return stats, fmt.Errorf("failed to decode caveat: %w", err)
+			}
+			caveats = append(caveats, caveat)
+
+		case rec.Namespace != nil:
+			if definitionsWritten {
+				return stats, fmt.Errorf("found namespace definition after relationships")
+			}
+
+			namespace := &core.NamespaceDefinition{}
+			if err := protojson.Unmarshal(rec.Namespace, namespace); err != nil {
+				return stats, fmt.Errorf("failed to decode namespace: %w", err)
+			}
+			namespaces = append(namespaces, namespace)
+
+		case rec.Relationship != nil:
+			if !definitionsWritten {
+				if err := writeDefinitions(); err != nil {
+					return stats, err
+				}
+			}
+
+			tpl := &core.RelationTuple{}
+			if err := protojson.Unmarshal(rec.Relationship, tpl); err != nil {
+				return stats, fmt.Errorf("failed to decode relationship: %w", err)
+			}
+
+			batch = append(batch, tpl)
+			if len(batch) >= batchSize {
+				if err := loadBatch(); err != nil {
+					return stats, err
+				}
+			}
+
+		case rec.Footer != nil:
+			if !definitionsWritten {
+				if err := writeDefinitions(); err != nil {
+					return stats, err
+				}
+			}
+
+			if err := loadBatch(); err != nil {
+				return stats, err
+			}
+
+			if stats != rec.Footer.Stats {
+				return stats, fmt.Errorf("restored %+v, but backup file contains %+v", stats, rec.Footer.Stats)
+			}
+			return stats, nil
+
+		default:
+			return stats, fmt.Errorf("unknown record on line %d of backup file", rr.line)
+		}
+	}
+}
+
+// recordReader reads the records of a backup file, validating its header and checksum.
+type recordReader struct {
+	r      *bufio.Reader
+	hash   hash.Hash
+	header *Header
+	line   int
+}
+
+func newRecordReader(r io.Reader) (*recordReader, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup file: %w", err)
+	}
+
+	rr := &recordReader{
+		r:    bufio.NewReader(gzipReader),
+		hash: sha256.New(),
+	}
+
+	rec, err := rr.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if rec.Header == nil {
+		return nil, fmt.Errorf("backup file is missing its header")
+	}
+
+	if rec.Header.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup file version %d", rec.Header.Version)
+	}
+
+	rr.header = rec.Header
+	return rr, nil
+}
+
+func (rr *recordReader) next() (record, error) {
+	line, err := rr.r.ReadBytes('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return record{}, fmt.Errorf("backup file is truncated")
+		}
+		return record{}, fmt.Errorf("failed to read backup file: %w", err)
+	}
+	rr.line++
+
+	var rec record
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return record{}, fmt.Errorf("invalid record on line %d of backup file: %w", rr.line, err)
+	}
+
+	if rec.Footer != nil {
+		if rec.Footer.Checksum != hex.EncodeToString(rr.hash.Sum(nil)) {
+			return record{}, ErrChecksumMismatch
+		}
+		return rec, nil
+	}
+
+	rr.hash.Write(line)
+	return rec, nil
+}
@@ -0,0 +1,184 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/testfixtures"
+	"github.com/authzed/spicedb/pkg/datastore"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/testutil"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+func exportStandardDatastore(t *testing.T) (datastore.Datastore, []byte, Stats) {
+	require := require.New(t)
+
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	ds, _ := testfixtures.StandardDatastoreWithCaveatedData(rawDS, require)
+
+	// Add an expiring relationship, to ensure expirations are preserved.
+	revision, err := common.WriteTuples(context.Background(), ds, core.RelationTupleUpdate_CREATE,
+		tuple.WithExpiration(tuple.MustParse("document:expiring#viewer@user:tom"), time.Now().Add(time.Hour)),
+	)
+	require.NoError(err)
+
+	buf := &bytes.Buffer{}
+	stats, err := Export(context.Background(), ds, revision, "memory", buf)
+	require.NoError(err)
+
+	return ds, buf.Bytes(), stats
+}
+
+func TestExportAndRestore(t *testing.T) {
+	require := require.New(t)
+
+	sourceDS, exported, exportStats := exportStandardDatastore(t)
+	require.NotZero(exportStats.Namespaces)
+	require.NotZero(exportStats.Caveats)
+	require.NotZero(exportStats.Relationships)
+
+	header, verifiedStats, err := Verify(bytes.NewReader(exported))
+	require.NoError(err)
+	require.Equal(FormatVersion, header.Version)
+	require.Equal("memory", header.Engine)
+	require.Equal(exportStats, verifiedStats)
+
+	targetDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	restoreStats, err := Restore(context.Background(), bytes.NewReader(exported), targetDS, 7)
+	require.NoError(err)
+	require.Equal(exportStats, restoreStats)
+
+	sourceRevision, err := sourceDS.HeadRevision(context.Background())
+	require.NoError(err)
+	targetRevision, err := targetDS.HeadRevision(context.Background())
+	require.NoError(err)
+
+	sourceReader := sourceDS.SnapshotReader(sourceRevision)
+	targetReader := targetDS.SnapshotReader(targetRevision)
+
+	sourceNamespaces, err := sourceReader.ListAllNamespaces(context.Background())
+	require.NoError(err)
+	targetNamespaces, err := targetReader.ListAllNamespaces(context.Background())
+	require.NoError(err)
+	require.Len(targetNamespaces, len(sourceNamespaces))
+
+	for _, sourceNamespace := range sourceNamespaces {
+		targetNamespace, _, err := targetReader.ReadNamespaceByName(context.Background(), sourceNamespace.Definition.Name)
+		require.NoError(err)
+		testutil.RequireProtoEqual(t, sourceNamespace.Definition, targetNamespace, "namespace %s differs", sourceNamespace.Definition.Name)
+
+		require.Equal(
+			relationshipsFor(t, sourceReader, sourceNamespace.Definition.Name),
+			relationshipsFor(t, targetReader, sourceNamespace.Definition.Name),
+		)
+	}
+
+	sourceCaveats, err := sourceReader.ListAllCaveats(context.Background())
+	require.NoError(err)
+	for _, sourceCaveat := range sourceCaveats {
+		targetCaveat, _, err := targetReader.ReadCaveatByName(context.Background(), sourceCaveat.Definition.Name)
+		require.NoError(err)
+		testutil.RequireProtoEqual(t, sourceCaveat.Definition, targetCaveat, "caveat %s differs", sourceCaveat.Definition.Name)
+	}
+
+	require.True(slices.ContainsFunc(relationshipsFor(t, targetReader, "document"), func(rel string) bool {
+		return strings.HasPrefix(rel, "document:expiring#viewer@user:tom[expiration:")
+	}))
+}
+
+func relationshipsFor(t *testing.T, reader datastore.Reader, namespaceName string) []string {
+	iter, err := reader.QueryRelationships(context.Background(), datastore.RelationshipsFilter{
+		OptionalResourceType: namespaceName,
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	var found []string
+	for tpl := iter.Next(); tpl != nil; tpl = iter.Next() {
+		found = append(found, tuple.MustString(tpl))
+	}
+	require.NoError(t, iter.Err())
+	return found
+}
+
+func TestRestoreIntoNonEmptyDatastore(t *testing.T) {
+	sourceDS, exported, _ := exportStandardDatastore(t)
+
+	_, err := Restore(context.Background(), bytes.NewReader(exported), sourceDS, 0)
+	require.ErrorContains(t, err, "backups can only be restored into an empty datastore")
+}
+
+func TestVerifyCorruptedBackup(t *testing.T) {
+	_, exported, _ := exportStandardDatastore(t)
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(exported))
+	require.NoError(t, err)
+
+	contents, err := io.ReadAll(gzipReader)
+	require.NoError(t, err)
+
+	lines := strings.SplitAfter(string(contents), "\n")
+	recompress := func(lines []string) []byte {
+		buf := &bytes.Buffer{}
+		writer := gzip.NewWriter(buf)
+		_, err := writer.Write([]byte(strings.Join(lines, "")))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return buf.Bytes()
+	}
+
+	tcs := []struct {
+		name          string
+		contents      []byte
+		expectedError string
+	}{
+		{
+			"modified relationship",
+			recompress(append(append(append([]string{}, lines[:len(lines)-3]...), strings.Replace(lines[len(lines)-3], `"relation":"`, `"relation":"x`, 1)), lines[len(lines)-2:]...)),
+			ErrChecksumMismatch.Error(),
+		},
+		{
+			"missing relationship",
+			recompress(append(append([]string{}, lines[:len(lines)-3]...), lines[len(lines)-2:]...)),
+			ErrChecksumMismatch.Error(),
+		},
+		{
+			"truncated",
+			recompress(lines[:len(lines)-2]),
+			"backup file is truncated",
+		},
+		{
+			"missing header",
+			recompress(lines[1:]),
+			"backup file is missing its header",
+		},
+		{
+			"not compressed",
+			contents,
+			"failed to read backup file",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := Verify(bytes.NewReader(tc.contents))
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
@@ -2,9 +2,11 @@ package common
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	log "github.com/authzed/spicedb/internal/logging"
 	"github.com/authzed/spicedb/pkg/datastore"
@@ -33,6 +35,7 @@ type changeRecord[R datastore.Revision] struct {
 	definitionsChanged map[string]datastore.SchemaDefinition
 	namespacesDeleted  map[string]struct{}
 	caveatsDeleted     map[string]struct{}
+	metadata           *structpb.Struct
 }
 
 // NewChanges creates a new Changes object for change tracking and de-duplication.
@@ -92,6 +95,7 @@ func (ch *Changes[R, K]) recordForRevision(rev R) changeRecord[R] {
 			make(map[string]datastore.SchemaDefinition),
 			make(map[string]struct{}),
 			make(map[string]struct{}),
+			nil,
 		}
 		ch.records[k] = revisionChanges
 	}
@@ -99,6 +103,29 @@ func (ch *Changes[R, K]) recordForRevision(rev R) changeRecord[R] {
 	return revisionChanges
 }
 
+// SetRevisionMetadata sets the metadata attached to the transaction that made the relationship
+// changes at the revision.
+func (ch *Changes[R, K]) SetRevisionMetadata(
+	_ context.Context,
+	rev R,
+	metadata map[string]any,
+) error {
+	if ch.content&datastore.WatchRelationships != datastore.WatchRelationships || len(metadata) == 0 {
+		return nil
+	}
+
+	converted, err := structpb.NewStruct(metadata)
+	if err != nil {
+		return fmt.Errorf("invalid transaction metadata: %w", err)
+	}
+
+	k := ch.keyFunc(rev)
+	record := ch.recordForRevision(rev)
+	record.metadata = converted
+	ch.records[k] = record
+	return nil
+}
+
 // AddDeletedNamespace adds a change indicating that the namespace with the name was deleted.
 func (ch *Changes[R, K]) AddDeletedNamespace(
 	_ context.Context,
@@ -178,6 +205,11 @@ func (ch *Changes[R, K]) revisionChanges(lessThanFunc func(lhs, rhs K) bool, bou
 
 	revisionsWithChanges := make([]K, 0, len(ch.records))
 	for rk, cr := range ch.records {
+		// Metadata alone does not make a change, as the transaction may not have changed anything.
+		if cr.isMetadataOnly() {
+			continue
+		}
+
 		if !withBound || boundRev.GreaterThan(cr.rev) {
 			revisionsWithChanges = append(revisionsWithChanges, rk)
 		}
@@ -210,11 +242,17 @@ func (ch *Changes[R, K]) revisionChanges(lessThanFunc func(lhs, rhs K) bool, bou
 		changes[i].ChangedDefinitions = maps.Values(revisionChangeRecord.definitionsChanged)
 		changes[i].DeletedNamespaces = maps.Keys(revisionChangeRecord.namespacesDeleted)
 		changes[i].DeletedCaveats = maps.Keys(revisionChangeRecord.caveatsDeleted)
+		changes[i].Metadata = revisionChangeRecord.metadata
 	}
 
 	return changes
 }
 
+func (cr changeRecord[R]) isMetadataOnly() bool {
+	return len(cr.tupleTouches) == 0 && len(cr.tupleDeletes) == 0 && len(cr.definitionsChanged) == 0 &&
+		len(cr.namespacesDeleted) == 0 && len(cr.caveatsDeleted) == 0
+}
+
 func (ch *Changes[R, K]) removeAllChangesBefore(boundRev R) {
 	for rk, cr := range ch.records {
 		if boundRev.GreaterThan(cr.rev) {
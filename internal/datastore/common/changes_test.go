@@ -405,6 +405,33 @@ func TestFilterAndRemoveRevisionChanges(t *testing.T) {
 	require.True(t, ch.IsEmpty())
 }
 
+func TestRevisionMetadata(t *testing.T) {
+	ctx := context.Background()
+	ch := NewChanges(revisions.TransactionIDKeyFunc, datastore.WatchRelationships|datastore.WatchSchema)
+
+	err := ch.SetRevisionMetadata(ctx, rev1, map[string]any{"reason": "ticket-1234"})
+	require.NoError(t, err)
+
+	err = ch.AddRelationshipChange(ctx, rev1, tuple.MustParse(tuple1), core.RelationTupleUpdate_TOUCH)
+	require.NoError(t, err)
+
+	// Metadata of a transaction which changed nothing is not reported.
+	err = ch.SetRevisionMetadata(ctx, rev2, map[string]any{"reason": "ticket-5678"})
+	require.NoError(t, err)
+
+	results := ch.FilterAndRemoveRevisionChanges(revisions.TransactionIDKeyLessThanFunc, rev3)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, rev1, results[0].Revision)
+	require.Equal(t, map[string]any{"reason": "ticket-1234"}, results[0].Metadata.AsMap())
+	require.True(t, ch.IsEmpty())
+
+	// Metadata is ignored when relationship changes are not watched.
+	schemaOnly := NewChanges(revisions.TransactionIDKeyFunc, datastore.WatchSchema)
+	err = schemaOnly.SetRevisionMetadata(ctx, rev1, map[string]any{"reason": "ticket-1234"})
+	require.NoError(t, err)
+	require.True(t, schemaOnly.IsEmpty())
+}
+
 func TestHLCOrdering(t *testing.T) {
 	ctx := context.Background()
 
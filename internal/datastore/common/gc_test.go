@@ -27,6 +27,7 @@ type fakeGC struct {
 
 type gcMetrics struct {
 	deleteBeforeTxCount   int
+	deleteExpiredCount    int
 	markedCompleteCount   int
 	resetGCCompletedCount int
 }
@@ -71,6 +72,15 @@ func (gc *fakeGC) DeleteBeforeTx(_ context.Context, rev datastore.Revision) (Del
 	return gc.deleter.DeleteBeforeTx(int64(revInt))
 }
 
+func (gc *fakeGC) DeleteExpiredRelationships(_ context.Context, _ time.Time) (int64, error) {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+
+	gc.metrics.deleteExpiredCount++
+
+	return 0, nil
+}
+
 func (gc *fakeGC) HasGCRun() bool {
 	gc.lock.Lock()
 	defer gc.lock.Unlock()
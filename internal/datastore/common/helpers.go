@@ -3,8 +3,10 @@ package common
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
@@ -46,3 +48,43 @@ func ContextualizedCaveatFrom(name string, context map[string]any) (*core.Contex
 	}
 	return caveat, nil
 }
+
+// ExpirationFrom returns the expiration time of the tuple, if any, in UTC, suitable for
+// storage in a nullable timestamp column.
+func ExpirationFrom(tpl *core.RelationTuple) *time.Time {
+	if tpl.OptionalExpirationTime == nil {
+		return nil
+	}
+
+	expiration := tpl.OptionalExpirationTime.AsTime().UTC()
+	return &expiration
+}
+
+// ExpirationTimestampFrom is the inverse of ExpirationFrom, converting a nullable timestamp
+// column value into the expiration time of a tuple.
+func ExpirationTimestampFrom(expiration *time.Time) *timestamppb.Timestamp {
+	if expiration == nil {
+		return nil
+	}
+	return timestamppb.New(*expiration)
+}
+
+// NewSliceRelationshipSource returns a datastore.BulkWriteRelationshipSource which produces the
+// given tuples, in order.
+func NewSliceRelationshipSource(tuples []*core.RelationTuple) datastore.BulkWriteRelationshipSource {
+	return &sliceRelationshipSource{tuples: tuples}
+}
+
+type sliceRelationshipSource struct {
+	tuples []*core.RelationTuple
+	index  int
+}
+
+func (srs *sliceRelationshipSource) Next(_ context.Context) (*core.RelationTuple, error) {
+	if srs.index >= len(srs.tuples) {
+		return nil, nil
+	}
+
+	srs.index++
+	return srs.tuples[srs.index-1], nil
+}
@@ -73,6 +73,7 @@ type SchemaInformation struct {
 	colUsersetObjectID   string
 	colUsersetRelation   string
 	colCaveatName        string
+	colExpiration        string
 	paginationFilterType PaginationFilterType
 }
 
@@ -83,7 +84,8 @@ func NewSchemaInformation(
 	colUsersetNamespace,
 	colUsersetObjectID,
 	colUsersetRelation,
-	colCaveatName string,
+	colCaveatName,
+	colExpiration string,
 	paginationFilterType PaginationFilterType,
 ) SchemaInformation {
 	return SchemaInformation{
@@ -94,6 +96,7 @@ func NewSchemaInformation(
 		colUsersetObjectID,
 		colUsersetRelation,
 		colCaveatName,
+		colExpiration,
 		paginationFilterType,
 	}
 }
@@ -105,14 +108,18 @@ type SchemaQueryFilterer struct {
 	queryBuilder          sq.SelectBuilder
 	filteringColumnCounts map[string]int
 	tracerAttributes      []attribute.KeyValue
+	revisionTime          sq.Sqlizer
 }
 
-// NewSchemaQueryFilterer creates a new SchemaQueryFilterer object.
-func NewSchemaQueryFilterer(schema SchemaInformation, initialQuery sq.SelectBuilder) SchemaQueryFilterer {
+// NewSchemaQueryFilterer creates a new SchemaQueryFilterer object. The revisionTime is an
+// expression for the time of the revision being read, at which the expiration of
+// relationships is evaluated.
+func NewSchemaQueryFilterer(schema SchemaInformation, initialQuery sq.SelectBuilder, revisionTime sq.Sqlizer) SchemaQueryFilterer {
 	return SchemaQueryFilterer{
 		schema:                schema,
 		queryBuilder:          initialQuery,
 		filteringColumnCounts: map[string]int{},
+		revisionTime:          revisionTime,
 	}
 }
 
@@ -518,6 +525,17 @@ func (sqf SchemaQueryFilterer) FilterWithCaveatName(caveatName string) SchemaQue
 	return sqf
 }
 
+// excludeExpired returns a new SchemaQueryFilterer which filters out any relationships which
+// had expired at the time of the revision being read. Reads at the same revision therefore
+// always return the same relationships, however long after the revision they are made.
+func (sqf SchemaQueryFilterer) excludeExpired() SchemaQueryFilterer {
+	sqf.queryBuilder = sqf.queryBuilder.Where(sq.Or{
+		sq.Eq{sqf.schema.colExpiration: nil},
+		sq.Expr(sqf.schema.colExpiration+" > ?", sqf.revisionTime),
+	})
+	return sqf
+}
+
 // Limit returns a new SchemaQueryFilterer which is limited to the specified number of results.
 func (sqf SchemaQueryFilterer) limit(limit uint64) SchemaQueryFilterer {
 	sqf.queryBuilder = sqf.queryBuilder.Limit(limit)
@@ -553,7 +571,7 @@ func (tqs QueryExecutor) ExecuteQuery(
 		limit = int(*queryOpts.Limit)
 	}
 
-	toExecute := query.limit(uint64(limit))
+	toExecute := query.excludeExpired().limit(uint64(limit))
 	sql, args, err := toExecute.queryBuilder.ToSql()
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/authzed/spicedb/pkg/datastore/options"
 
@@ -665,9 +666,10 @@ func TestSchemaQueryFilterer(t *testing.T) {
 				"subject_object_id",
 				"subject_relation",
 				"caveat",
+				"expiration",
 				TupleComparison,
 			)
-			filterer := NewSchemaQueryFilterer(schema, base)
+			filterer := NewSchemaQueryFilterer(schema, base, sq.Expr("NOW()"))
 
 			ran := test.run(filterer)
 			require.Equal(t, test.expectedColumnCounts, ran.filteringColumnCounts)
@@ -679,3 +681,33 @@ func TestSchemaQueryFilterer(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaQueryFiltererExcludeExpired(t *testing.T) {
+	schema := NewSchemaInformation(
+		"ns",
+		"object_id",
+		"relation",
+		"subject_ns",
+		"subject_object_id",
+		"subject_relation",
+		"caveat",
+		"expiration",
+		TupleComparison,
+	)
+
+	revisionTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	filterer := NewSchemaQueryFilterer(schema, sq.Select("*"), sq.Expr("?", revisionTime)).FilterToResourceType("sometype").excludeExpired()
+
+	sql, args, err := filterer.queryBuilder.ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * WHERE ns = ? AND (expiration IS NULL OR expiration > ?)", sql)
+	require.Equal(t, []any{"sometype", revisionTime}, args)
+	require.Equal(t, map[string]int{"ns": 1}, filterer.filteringColumnCounts)
+
+	filterer = NewSchemaQueryFilterer(schema, sq.Select("*"), sq.Expr("(SELECT timestamp FROM tx WHERE id = ?)", 42)).excludeExpired()
+
+	sql, args, err = filterer.queryBuilder.ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * WHERE (expiration IS NULL OR expiration > (SELECT timestamp FROM tx WHERE id = ?))", sql)
+	require.Equal(t, []any{42}, args)
+}
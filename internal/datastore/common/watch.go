@@ -0,0 +1,36 @@
+package common
+
+import (
+	"context"
+	"errors"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+// RevisionChecker is implemented by datastores which can determine whether a revision is still
+// within their garbage collection window.
+type RevisionChecker interface {
+	CheckRevision(ctx context.Context, revision datastore.Revision) error
+}
+
+// CheckWatchStartRevision returns a datastore.ErrWatchRevisionTooOld if the revision at which a
+// watch is to be started has fallen outside of the garbage collection window of the datastore.
+//
+// Revisions which cannot (yet) be determined are allowed, as a watch started at a revision
+// slightly ahead of the datastore's view of HEAD will simply receive the changes after it.
+func CheckWatchStartRevision(ctx context.Context, checker RevisionChecker, revision datastore.Revision) error {
+	err := checker.CheckRevision(ctx, revision)
+	if err == nil {
+		return nil
+	}
+
+	var invalidRevisionErr datastore.ErrInvalidRevision
+	if errors.As(err, &invalidRevisionErr) {
+		if invalidRevisionErr.Reason() == datastore.RevisionStale {
+			return datastore.NewWatchRevisionTooOldErr(revision)
+		}
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+type fakeRevisionChecker struct {
+	err error
+}
+
+func (frc fakeRevisionChecker) CheckRevision(_ context.Context, _ datastore.Revision) error {
+	return frc.err
+}
+
+func TestCheckWatchStartRevision(t *testing.T) {
+	otherErr := errors.New("some error")
+
+	tcs := []struct {
+		name          string
+		checkErr      error
+		expectTooOld  bool
+		expectedError error
+	}{
+		{"valid", nil, false, nil},
+		{"stale", datastore.NewInvalidRevisionErr(rev1, datastore.RevisionStale), true, nil},
+		{"undetermined", datastore.NewInvalidRevisionErr(rev1, datastore.CouldNotDetermineRevision), false, nil},
+		{"other error", otherErr, false, otherErr},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := CheckWatchStartRevision(context.Background(), fakeRevisionChecker{tc.checkErr}, rev1)
+
+			if tc.expectTooOld {
+				var tooOldErr datastore.ErrWatchRevisionTooOld
+				require.ErrorAs(t, err, &tooOldErr)
+				require.True(t, rev1.Equal(tooOldErr.InvalidRevision()))
+				return
+			}
+
+			require.Equal(t, tc.expectedError, err)
+		})
+	}
+}
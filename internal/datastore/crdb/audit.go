@@ -0,0 +1,67 @@
+package crdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+const (
+	tableAuditLog = "audit_log"
+	colAuditID    = "id"
+	colRecord     = "record"
+)
+
+func (cds *crdbDatastore) WriteAuditRecord(ctx context.Context, record datastore.AuditRecord) error {
+	sql, args, err := psql.Insert(tableAuditLog).
+		Columns(colTimestamp, colRecord).
+		Values(record.Timestamp.UTC(), string(record.Data)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to prepare audit record sql: %w", err)
+	}
+
+	if err := cds.writePool.ExecFunc(ctx, func(ctx context.Context, tag pgconn.CommandTag, err error) error {
+		return err
+	}, sql, args...); err != nil {
+		return fmt.Errorf("unable to write audit record: %w", err)
+	}
+	return nil
+}
+
+func (cds *crdbDatastore) ReadAuditRecords(ctx context.Context, since time.Time, limit uint64) ([]datastore.AuditRecord, error) {
+	sql, args, err := psql.Select(colTimestamp, colRecord).
+		From(tableAuditLog).
+		Where(sq.GtOrEq{colTimestamp: since.UTC()}).
+		OrderBy(colTimestamp, colAuditID).
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare audit records sql: %w", err)
+	}
+
+	var records []datastore.AuditRecord
+	if err := cds.readPool.QueryFunc(ctx, func(ctx context.Context, rows pgx.Rows) error {
+		for rows.Next() {
+			var record datastore.AuditRecord
+			var data string
+			if err := rows.Scan(&record.Timestamp, &data); err != nil {
+				return err
+			}
+			record.Data = []byte(data)
+			records = append(records, record)
+		}
+		return rows.Err()
+	}, sql, args...); err != nil {
+		return nil, fmt.Errorf("unable to read audit records: %w", err)
+	}
+	return records, nil
+}
+
+var _ datastore.AuditLogDatastore = &crdbDatastore{}
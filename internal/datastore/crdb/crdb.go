@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/IBM/pgxpoolprometheus"
@@ -51,6 +52,8 @@ const (
 	tableTransactions = "transactions"
 	tableCaveat       = "caveat"
 
+	tableTransactionMetadata = "transaction_metadata"
+
 	colNamespace         = "namespace"
 	colConfig            = "serialized_config"
 	colTimestamp         = "timestamp"
@@ -64,6 +67,8 @@ const (
 	colCaveatDefinition  = "definition"
 	colCaveatContextName = "caveat_name"
 	colCaveatContext     = "caveat_context"
+	colExpiration        = "expiration"
+	colMetadata          = "metadata"
 
 	errUnableToInstantiate = "unable to instantiate datastore"
 	errRevision            = "unable to find revision: %w"
@@ -215,6 +220,11 @@ func newCRDBDatastore(ctx context.Context, url string, options ...Option) (datas
 			ds.cancel()
 			return nil, err
 		}
+
+		if err := common.RegisterGCMetrics(); err != nil {
+			ds.cancel()
+			return nil, err
+		}
 	}
 
 	// TODO: this (and the GC startup that it's based on for mysql/pg) should
@@ -240,6 +250,23 @@ func newCRDBDatastore(ctx context.Context, url string, options ...Option) (datas
 		})
 	}
 
+	// Start a goroutine for garbage collection of expired relationships.
+	if config.gcInterval > 0*time.Minute && config.gcEnabled {
+		var gcCtx context.Context
+		ds.gcGroup, gcCtx = errgroup.WithContext(ds.ctx)
+		ds.gcGroup.Go(func() error {
+			return common.StartGarbageCollector(
+				gcCtx,
+				ds,
+				config.gcInterval,
+				config.gcWindow,
+				config.gcMaxOperationTime,
+			)
+		})
+	} else {
+		log.Warn().Msg("datastore background garbage collection disabled")
+	}
+
 	return ds, nil
 }
 
@@ -271,6 +298,8 @@ type crdbDatastore struct {
 	featureGroup singleflight.Group[string, *datastore.Features]
 
 	pruneGroup *errgroup.Group
+	gcGroup    *errgroup.Group
+	gcHasRun   atomic.Bool
 	ctx        context.Context
 	cancel     context.CancelFunc
 }
@@ -284,7 +313,10 @@ func (cds *crdbDatastore) SnapshotReader(rev datastore.Revision) datastore.Reade
 		return query.From(fromStr + " AS OF SYSTEM TIME " + rev.String())
 	}
 
-	return &crdbReader{cds.readPool, executor, noOverlapKeyer, nil, fromBuilder}
+	// Expiration is evaluated at the time of the revision being read.
+	revisionTime := sq.Expr("?", time.Unix(0, rev.(revisions.HLCRevision).TimestampNanoSec()).UTC())
+
+	return &crdbReader{cds.readPool, executor, noOverlapKeyer, nil, fromBuilder, revisionTime}
 }
 
 func (cds *crdbDatastore) ReadWriteTx(
@@ -314,6 +346,7 @@ func (cds *crdbDatastore) ReadWriteTx(
 				func(query sq.SelectBuilder, fromStr string) sq.SelectBuilder {
 					return query.From(fromStr)
 				},
+				sq.Expr("now()"),
 			},
 			tx,
 			0,
@@ -323,6 +356,14 @@ func (cds *crdbDatastore) ReadWriteTx(
 			return err
 		}
 
+		// The metadata is written in the transaction, so that it is reported by the changefeed
+		// at the same timestamp as the changes it is attached to.
+		if config.Metadata != nil {
+			if _, err := tx.Exec(ctx, queryWriteTransactionMetadata, config.Metadata.AsMap()); err != nil {
+				return fmt.Errorf("error writing transaction metadata: %w", err)
+			}
+		}
+
 		// Touching the transaction key happens last so that the "write intent" for
 		// the transaction as a whole lands in a range for the affected tuples.
 		for k := range rwt.overlapKeySet {
@@ -411,6 +452,12 @@ func (cds *crdbDatastore) ReadyState(ctx context.Context) (datastore.ReadyState,
 
 func (cds *crdbDatastore) Close() error {
 	cds.cancel()
+
+	if cds.gcGroup != nil {
+		err := cds.gcGroup.Wait()
+		log.Warn().Err(err).Msg("completed shutdown of cockroach datastore")
+	}
+
 	cds.readPool.Close()
 	cds.writePool.Close()
 	return nil
@@ -0,0 +1,83 @@
+package crdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	"github.com/authzed/spicedb/internal/datastore/revisions"
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+const gcBatchDeleteSize = 1000
+
+var (
+	_ common.GarbageCollector = (*crdbDatastore)(nil)
+
+	deleteExpiredRelationships = fmt.Sprintf(
+		"DELETE FROM %s WHERE %s < $1 LIMIT %d",
+		tableTuple,
+		colExpiration,
+		gcBatchDeleteSize,
+	)
+)
+
+func (cds *crdbDatastore) HasGCRun() bool {
+	return cds.gcHasRun.Load()
+}
+
+func (cds *crdbDatastore) MarkGCCompleted() {
+	cds.gcHasRun.Store(true)
+}
+
+func (cds *crdbDatastore) ResetGCCompleted() {
+	cds.gcHasRun.Store(false)
+}
+
+func (cds *crdbDatastore) Now(ctx context.Context) (time.Time, error) {
+	// Retrieve the `now` time from the database.
+	now, err := readCRDBNow(ctx, cds.readPool)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, now.(revisions.HLCRevision).TimestampNanoSec()).UTC(), nil
+}
+
+func (cds *crdbDatastore) TxIDBefore(_ context.Context, before time.Time) (datastore.Revision, error) {
+	return revisions.NewHLCForTime(before), nil
+}
+
+func (cds *crdbDatastore) DeleteBeforeTx(_ context.Context, _ datastore.Revision) (common.DeletionCounts, error) {
+	// Deleted relationships, namespaces and transactions are removed by CockroachDB's own
+	// garbage collection of old row versions, once they are older than the gc.ttlseconds of
+	// the cluster.
+	return common.DeletionCounts{}, nil
+}
+
+func (cds *crdbDatastore) DeleteExpiredRelationships(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	// Delete any relationship rows that expired before the GC window.
+	var deletedCount int64
+	for {
+		var rowsDeleted int64
+		if err := cds.writePool.ExecFunc(ctx, func(ctx context.Context, tag pgconn.CommandTag, err error) error {
+			if err != nil {
+				return err
+			}
+			rowsDeleted = tag.RowsAffected()
+			return nil
+		}, deleteExpiredRelationships, expiredBefore); err != nil {
+			return deletedCount, fmt.Errorf("failed to GC expired relationships: %w", err)
+		}
+
+		deletedCount += rowsDeleted
+		if rowsDeleted < gcBatchDeleteSize {
+			break
+		}
+	}
+
+	return deletedCount, nil
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	addRelationshipExpiration = `ALTER TABLE relation_tuple
+		ADD COLUMN expiration TIMESTAMPTZ DEFAULT NULL;`
+
+	// The index only covers relationships with an expiration, which the garbage collector
+	// scans for those that have expired.
+	addExpiredRelationshipsIndex = `CREATE INDEX ix_relation_tuple_expired ON relation_tuple (expiration)
+		WHERE expiration IS NOT NULL;`
+)
+
+func init() {
+	err := CRDBMigrations.Register("add-relationship-expiration", "remove-stats-table", addRelationshipExpirationFunc, noAtomicMigration)
+	if err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
+
+func addRelationshipExpirationFunc(ctx context.Context, conn *pgx.Conn) error {
+	if _, err := conn.Exec(ctx, addRelationshipExpiration); err != nil {
+		return err
+	}
+	if _, err := conn.Exec(ctx, addExpiredRelationshipsIndex); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	createAuditLog = `CREATE TABLE audit_log (
+		id UUID NOT NULL DEFAULT gen_random_uuid(),
+		timestamp TIMESTAMPTZ NOT NULL,
+		record JSONB NOT NULL,
+		CONSTRAINT pk_audit_log PRIMARY KEY (id),
+		INDEX ix_audit_log_by_timestamp (timestamp)
+	);`
+)
+
+func init() {
+	err := CRDBMigrations.Register("add-audit-log", "add-relationship-expiration", addAuditLog, noAtomicMigration)
+	if err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
+
+func addAuditLog(ctx context.Context, conn *pgx.Conn) error {
+	if _, err := conn.Exec(ctx, createAuditLog); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// Metadata rows are only read through the changefeed, so they expire once the changes they
+	// are attached to can no longer be watched.
+	createTransactionMetadata = `CREATE TABLE transaction_metadata (
+		id UUID NOT NULL DEFAULT gen_random_uuid(),
+		metadata JSONB NOT NULL,
+		CONSTRAINT pk_transaction_metadata PRIMARY KEY (id)
+	) WITH (ttl_expire_after = '2 days');`
+)
+
+func init() {
+	err := CRDBMigrations.Register("add-transaction-metadata", "add-audit-log", addTransactionMetadata, noAtomicMigration)
+	if err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
+
+func addTransactionMetadata(ctx context.Context, conn *pgx.Conn) error {
+	if _, err := conn.Exec(ctx, createTransactionMetadata); err != nil {
+		return err
+	}
+	return nil
+}
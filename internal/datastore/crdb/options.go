@@ -17,6 +17,9 @@ type crdbOptions struct {
 	followerReadDelay           time.Duration
 	maxRevisionStalenessPercent float64
 	gcWindow                    time.Duration
+	gcInterval                  time.Duration
+	gcMaxOperationTime          time.Duration
+	gcEnabled                   bool
 	maxRetries                  uint8
 	overlapStrategy             string
 	overlapKey                  string
@@ -41,6 +44,10 @@ const (
 	defaultWatchBufferWriteTimeout     = 1 * time.Second
 	defaultSplitSize                   = 1024
 
+	defaultGarbageCollectionInterval         = time.Minute * 3
+	defaultGarbageCollectionMaxOperationTime = time.Minute
+	defaultGCEnabled                         = true
+
 	defaultMaxRetries      = 5
 	defaultOverlapKey      = "defaultsynckey"
 	defaultOverlapStrategy = overlapStrategyStatic
@@ -57,6 +64,9 @@ type Option func(*crdbOptions)
 func generateConfig(options []Option) (crdbOptions, error) {
 	computed := crdbOptions{
 		gcWindow:                    24 * time.Hour,
+		gcInterval:                  defaultGarbageCollectionInterval,
+		gcMaxOperationTime:          defaultGarbageCollectionMaxOperationTime,
+		gcEnabled:                   defaultGCEnabled,
 		watchBufferLength:           defaultWatchBufferLength,
 		watchBufferWriteTimeout:     defaultWatchBufferWriteTimeout,
 		revisionQuantization:        defaultRevisionQuantization,
@@ -256,6 +266,30 @@ func GCWindow(window time.Duration) Option {
 	return func(po *crdbOptions) { po.gcWindow = window }
 }
 
+// GCInterval is the the interval at which garbage collection of expired
+// relationships will occur.
+//
+// This value defaults to 3 minutes.
+func GCInterval(interval time.Duration) Option {
+	return func(po *crdbOptions) { po.gcInterval = interval }
+}
+
+// GCMaxOperationTime is the maximum operation time of a garbage collection
+// pass before it times out.
+//
+// This value defaults to 1 minute.
+func GCMaxOperationTime(time time.Duration) Option {
+	return func(po *crdbOptions) { po.gcMaxOperationTime = time }
+}
+
+// GCEnabled indicates whether garbage collection of expired relationships is
+// enabled. Other old data is removed by the garbage collection of CockroachDB.
+//
+// GC is enabled by default.
+func GCEnabled(isGCEnabled bool) Option {
+	return func(po *crdbOptions) { po.gcEnabled = isGCEnabled }
+}
+
 // ConnectRate is the rate at which new datastore connections can be made.
 //
 // This is a duration, the rate is 1/period.
@@ -34,6 +34,7 @@ var (
 		colUsersetRelation,
 		colCaveatContextName,
 		colCaveatContext,
+		colExpiration,
 	)
 
 	schema = common.NewSchemaInformation(
@@ -44,6 +45,7 @@ var (
 		colUsersetObjectID,
 		colUsersetRelation,
 		colCaveatContextName,
+		colExpiration,
 		common.ExpandedLogicComparison,
 	)
 )
@@ -54,6 +56,7 @@ type crdbReader struct {
 	keyer         overlapKeyer
 	overlapKeySet keySet
 	fromBuilder   func(query sq.SelectBuilder, fromStr string) sq.SelectBuilder
+	revisionTime  sq.Sqlizer
 }
 
 func (cr *crdbReader) ReadNamespaceByName(
@@ -96,7 +99,7 @@ func (cr *crdbReader) QueryRelationships(
 	opts ...options.QueryOptionsOption,
 ) (iter datastore.RelationshipIterator, err error) {
 	query := cr.fromBuilder(queryTuples, tableTuple)
-	qBuilder, err := common.NewSchemaQueryFilterer(schema, query).FilterWithRelationshipsFilter(filter)
+	qBuilder, err := common.NewSchemaQueryFilterer(schema, query, cr.revisionTime).FilterWithRelationshipsFilter(filter)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +113,7 @@ func (cr *crdbReader) ReverseQueryRelationships(
 	opts ...options.ReverseQueryOptionsOption,
 ) (iter datastore.RelationshipIterator, err error) {
 	query := cr.fromBuilder(queryTuples, tableTuple)
-	qBuilder, err := common.NewSchemaQueryFilterer(schema, query).
+	qBuilder, err := common.NewSchemaQueryFilterer(schema, query, cr.revisionTime).
 		FilterWithSubjectsSelectors(subjectsFilter.AsSelector())
 	if err != nil {
 		return nil, err
@@ -12,6 +12,7 @@ import (
 	"github.com/jzelinskie/stringz"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/authzed/spicedb/internal/datastore/common"
 	pgxcommon "github.com/authzed/spicedb/internal/datastore/postgres/common"
 	log "github.com/authzed/spicedb/internal/logging"
 	"github.com/authzed/spicedb/pkg/datastore"
@@ -49,7 +50,7 @@ type crdbReadWriteTXN struct {
 
 var (
 	upsertTupleSuffix = fmt.Sprintf(
-		"ON CONFLICT (%s,%s,%s,%s,%s,%s) DO UPDATE SET %s = now(), %s = excluded.%s, %s = excluded.%s WHERE (relation_tuple.%s <> excluded.%s OR relation_tuple.%s <> excluded.%s)",
+		"ON CONFLICT (%s,%s,%s,%s,%s,%s) DO UPDATE SET %s = now(), %s = excluded.%s, %s = excluded.%s, %s = excluded.%s WHERE (relation_tuple.%s <> excluded.%s OR relation_tuple.%s <> excluded.%s OR relation_tuple.%s IS DISTINCT FROM excluded.%s)",
 		colNamespace,
 		colObjectID,
 		colRelation,
@@ -61,10 +62,14 @@ var (
 		colCaveatContextName,
 		colCaveatContext,
 		colCaveatContext,
+		colExpiration,
+		colExpiration,
 		colCaveatContextName,
 		colCaveatContextName,
 		colCaveatContext,
 		colCaveatContext,
+		colExpiration,
+		colExpiration,
 	)
 
 	queryWriteTuple = psql.Insert(tableTuple).Columns(
@@ -76,6 +81,7 @@ var (
 		colUsersetRelation,
 		colCaveatContextName,
 		colCaveatContext,
+		colExpiration,
 	)
 
 	queryTouchTuple = queryWriteTuple.Suffix(upsertTupleSuffix)
@@ -89,10 +95,17 @@ var (
 		colTransactionKey,
 		colTimestamp,
 	)
+
+	queryWriteTransactionMetadata = fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES ($1)",
+		tableTransactionMetadata,
+		colMetadata,
+	)
 )
 
 func (rwt *crdbReadWriteTXN) WriteRelationships(ctx context.Context, mutations []*core.RelationTupleUpdate) error {
 	bulkWrite := queryWriteTuple
+	bulkWriteOr := sq.Or{}
 	var bulkWriteCount int64
 
 	bulkTouch := queryTouchTuple
@@ -128,6 +141,7 @@ func (rwt *crdbReadWriteTXN) WriteRelationships(ctx context.Context, mutations [
 				rel.Subject.Relation,
 				caveatName,
 				caveatContext,
+				common.ExpirationFrom(rel),
 			)
 			bulkTouchCount++
 		case core.RelationTupleUpdate_CREATE:
@@ -141,7 +155,9 @@ func (rwt *crdbReadWriteTXN) WriteRelationships(ctx context.Context, mutations [
 				rel.Subject.Relation,
 				caveatName,
 				caveatContext,
+				common.ExpirationFrom(rel),
 			)
+			bulkWriteOr = append(bulkWriteOr, exactRelationshipClause(rel))
 			bulkWriteCount++
 		case core.RelationTupleUpdate_DELETE:
 			rwt.relCountChange--
@@ -166,6 +182,24 @@ func (rwt *crdbReadWriteTXN) WriteRelationships(ctx context.Context, mutations [
 		}
 	}
 
+	// Expired relationships are no longer visible, so a CREATE over one must succeed. Remove
+	// any such rows before inserting, to avoid violating the primary key.
+	if bulkWriteCount > 0 {
+		sql, args, err := queryDeleteTuples.
+			Where(bulkWriteOr).
+			Where(sq.Expr(colExpiration + " <= now()")).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf(errUnableToWriteRelationships, err)
+		}
+
+		modified, err := rwt.tx.Exec(ctx, sql, args...)
+		if err != nil {
+			return fmt.Errorf(errUnableToWriteRelationships, err)
+		}
+		rwt.relCountChange -= modified.RowsAffected()
+	}
+
 	bulkUpdateQueries := make([]sq.InsertBuilder, 0, 2)
 	if bulkWriteCount > 0 {
 		bulkUpdateQueries = append(bulkUpdateQueries, bulkWrite)
@@ -344,6 +378,7 @@ var copyCols = []string{
 	colUsersetRelation,
 	colCaveatContextName,
 	colCaveatContext,
+	colExpiration,
 }
 
 func (rwt *crdbReadWriteTXN) BulkLoad(ctx context.Context, iter datastore.BulkWriteRelationshipSource) (uint64, error) {
@@ -22,8 +22,12 @@ import (
 )
 
 const (
-	queryChangefeed       = "EXPERIMENTAL CHANGEFEED FOR %s WITH updated, cursor = '%s', resolved = '%s', min_checkpoint_frequency = '0';"
-	queryChangefeedPreV22 = "EXPERIMENTAL CHANGEFEED FOR %s WITH updated, cursor = '%s', resolved = '%s';"
+	queryChangefeed       = "EXPERIMENTAL CHANGEFEED FOR %s WITH updated, diff, cursor = '%s', resolved = '%s', min_checkpoint_frequency = '0';"
+	queryChangefeedPreV22 = "EXPERIMENTAL CHANGEFEED FOR %s WITH updated, diff, cursor = '%s', resolved = '%s';"
+
+	// Error message fragment returned by CRDB when a changefeed cursor falls behind the GC
+	// threshold of the watched tables.
+	crdbGCThresholdMessage = "GC threshold"
 )
 
 var retryHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
@@ -50,6 +54,12 @@ type changeDetails struct {
 
 		RelationshipCaveatContext map[string]any `json:"caveat_context"`
 		RelationshipCaveatName    string         `json:"caveat_name"`
+		RelationshipExpiration    *time.Time     `json:"expiration"`
+
+		Metadata map[string]any `json:"metadata"`
+	}
+	Before *struct {
+		RelationshipExpiration *time.Time `json:"expiration"`
 	}
 }
 
@@ -100,9 +110,9 @@ func (cds *crdbDatastore) watch(
 	}
 	defer func() { _ = conn.Close(ctx) }()
 
-	tableNames := make([]string, 0, 3)
+	tableNames := make([]string, 0, 4)
 	if opts.Content&datastore.WatchRelationships == datastore.WatchRelationships {
-		tableNames = append(tableNames, tableTuple)
+		tableNames = append(tableNames, tableTuple, tableTransactionMetadata)
 	}
 	if opts.Content&datastore.WatchSchema == datastore.WatchSchema {
 		tableNames = append(tableNames, tableNamespace)
@@ -134,6 +144,11 @@ func (cds *crdbDatastore) watch(
 			return
 		}
 
+		if strings.Contains(err.Error(), crdbGCThresholdMessage) {
+			errs <- datastore.NewWatchRevisionTooOldErr(afterRevision)
+			return
+		}
+
 		if pool.IsResettableError(ctx, err) || pool.IsRetryableError(ctx, err) {
 			errs <- datastore.NewWatchTemporaryErr(err)
 			return
@@ -142,6 +157,11 @@ func (cds *crdbDatastore) watch(
 		errs <- err
 	}
 
+	if err := common.CheckWatchStartRevision(ctx, cds, afterRevision); err != nil {
+		sendError(err)
+		return
+	}
+
 	watchBufferWriteTimeout := opts.WatchBufferWriteTimeout
 	if watchBufferWriteTimeout <= 0 {
 		watchBufferWriteTimeout = cds.watchBufferWriteTimeout
@@ -248,6 +268,11 @@ func (cds *crdbDatastore) watch(
 				return
 			}
 
+			var expiration *time.Time
+			if details.After != nil {
+				expiration = details.After.RelationshipExpiration
+			}
+
 			tuple := &core.RelationTuple{
 				ResourceAndRelation: &core.ObjectAndRelation{
 					Namespace: pkValues[0],
@@ -259,7 +284,8 @@ func (cds *crdbDatastore) watch(
 					ObjectId:  pkValues[4],
 					Relation:  pkValues[5],
 				},
-				Caveat: ctxCaveat,
+				Caveat:                 ctxCaveat,
+				OptionalExpirationTime: common.ExpirationTimestampFrom(expiration),
 			}
 
 			rev, err := revisions.HLCRevisionFromString(details.Updated)
@@ -269,6 +295,13 @@ func (cds *crdbDatastore) watch(
 			}
 
 			if details.After == nil {
+				// Rows removed by the garbage collector, or replaced by a write, after they
+				// expired had already been excluded from reads, so their removal is not a change.
+				if details.Before != nil && details.Before.RelationshipExpiration != nil &&
+					!details.Before.RelationshipExpiration.After(time.Unix(0, rev.TimestampNanoSec())) {
+					continue
+				}
+
 				if err := tracked.AddRelationshipChange(ctx, rev, tuple, core.RelationTupleUpdate_DELETE); err != nil {
 					sendError(err)
 					return
@@ -341,6 +374,23 @@ func (cds *crdbDatastore) watch(
 			} else {
 				tracked.AddDeletedCaveat(ctx, rev, definitionName)
 			}
+
+		case tableTransactionMetadata:
+			// Expired metadata rows are reported as deletions, which are ignored.
+			if details.After == nil {
+				continue
+			}
+
+			rev, err := revisions.HLCRevisionFromString(details.Updated)
+			if err != nil {
+				sendError(fmt.Errorf("malformed update timestamp: %w", err))
+				return
+			}
+
+			if err := tracked.SetRevisionMetadata(ctx, rev, details.After.Metadata); err != nil {
+				sendError(err)
+				return
+			}
 		}
 	}
 
@@ -0,0 +1,55 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+func (mdb *memdbDatastore) WriteAuditRecord(_ context.Context, record datastore.AuditRecord) error {
+	mdb.Lock()
+	defer mdb.Unlock()
+
+	if mdb.db == nil {
+		return fmt.Errorf("datastore has been closed")
+	}
+
+	// Records are kept ordered by timestamp, as they may be written out of order by concurrent
+	// requests.
+	index := sort.Search(len(mdb.auditRecords), func(i int) bool {
+		return mdb.auditRecords[i].Timestamp.After(record.Timestamp)
+	})
+
+	record.Data = append([]byte(nil), record.Data...)
+	mdb.auditRecords = append(mdb.auditRecords, datastore.AuditRecord{})
+	copy(mdb.auditRecords[index+1:], mdb.auditRecords[index:])
+	mdb.auditRecords[index] = record
+	return nil
+}
+
+func (mdb *memdbDatastore) ReadAuditRecords(_ context.Context, since time.Time, limit uint64) ([]datastore.AuditRecord, error) {
+	mdb.RLock()
+	defer mdb.RUnlock()
+
+	if mdb.db == nil {
+		return nil, fmt.Errorf("datastore has been closed")
+	}
+
+	index := sort.Search(len(mdb.auditRecords), func(i int) bool {
+		return !mdb.auditRecords[i].Timestamp.Before(since)
+	})
+
+	records := make([]datastore.AuditRecord, 0, min(limit, uint64(len(mdb.auditRecords)-index)))
+	for _, record := range mdb.auditRecords[index:] {
+		if uint64(len(records)) >= limit {
+			break
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+var _ datastore.AuditLogDatastore = &memdbDatastore{}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -32,6 +33,9 @@ func TestMemdbDatastore(t *testing.T) {
 func TestConcurrentWritePanic(t *testing.T) {
 	require := require.New(t)
 
+	// The writes below can only overlap if their goroutines run in parallel.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(max(2, runtime.GOMAXPROCS(0))))
+
 	ds, err := NewMemdbDatastore(0, 1*time.Hour, 1*time.Hour)
 	require.NoError(err)
 
@@ -83,6 +87,9 @@ func TestConcurrentWritePanic(t *testing.T) {
 func TestConcurrentWriteRelsError(t *testing.T) {
 	require := require.New(t)
 
+	// The writes below can only overlap if their goroutines run in parallel.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(max(2, runtime.GOMAXPROCS(0))))
+
 	ds, err := NewMemdbDatastore(0, 1*time.Hour, 1*time.Hour)
 	require.NoError(err)
 
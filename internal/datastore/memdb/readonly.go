@@ -7,6 +7,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-memdb"
 
@@ -23,6 +24,10 @@ type memdbReader struct {
 	TryLocker
 	txSource txFactory
 	initErr  error
+
+	// revisionTime is the time of the revision being read, at which the expiration of
+	// relationships is evaluated.
+	revisionTime time.Time
 }
 
 // QueryRelationships reads relationships starting from the resource side.
@@ -62,6 +67,7 @@ func (r *memdbReader) QueryRelationships(
 		filter.OptionalSubjectsSelectors,
 		filter.OptionalCaveatName,
 		makeCursorFilterFn(queryOpts.After, queryOpts.Sort),
+		r.revisionTime,
 	)
 	filteredIterator := memdb.NewFilterIterator(bestIterator, matchingRelationshipsFilterFunc)
 
@@ -130,6 +136,7 @@ func (r *memdbReader) ReverseQueryRelationships(
 		[]datastore.SubjectsSelector{subjectsFilter.AsSelector()},
 		"",
 		makeCursorFilterFn(queryOpts.AfterForReverse, queryOpts.SortForReverse),
+		r.revisionTime,
 	)
 	filteredIterator := memdb.NewFilterIterator(iterator, matchingRelationshipsFilterFunc)
 
@@ -301,11 +308,14 @@ func filterFuncForFilters(
 	optionalSubjectsSelectors []datastore.SubjectsSelector,
 	optionalCaveatFilter string,
 	cursorFilter func(*relationship) bool,
+	now time.Time,
 ) memdb.FilterFunc {
 	return func(tupleRaw interface{}) bool {
 		tuple := tupleRaw.(*relationship)
 
 		switch {
+		case tuple.isExpired(now):
+			return true
 		case optionalResourceType != "" && optionalResourceType != tuple.namespace:
 			return true
 		case len(optionalResourceIds) > 0 && !slices.Contains(optionalResourceIds, tuple.resourceID):
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/hashicorp/go-memdb"
@@ -46,6 +47,7 @@ func (rwt *memdbReadWriteTx) write(tx *memdb.Txn, mutations ...*core.RelationTup
 			mutation.Tuple.Subject.ObjectId,
 			mutation.Tuple.Subject.Relation,
 			rwt.toCaveatReference(mutation),
+			rwt.toExpiration(mutation),
 		}
 
 		found, err := tx.First(
@@ -69,7 +71,8 @@ func (rwt *memdbReadWriteTx) write(tx *memdb.Txn, mutations ...*core.RelationTup
 
 		switch mutation.Operation {
 		case core.RelationTupleUpdate_CREATE:
-			if existing != nil {
+			// An expired relationship is treated as no longer existing, so it can be replaced.
+			if existing != nil && !existing.isExpired(rwt.revisionTime) {
 				rt, err := existing.RelationTuple()
 				if err != nil {
 					return err
@@ -119,6 +122,15 @@ func (rwt *memdbReadWriteTx) toCaveatReference(mutation *core.RelationTupleUpdat
 	return cr
 }
 
+func (rwt *memdbReadWriteTx) toExpiration(mutation *core.RelationTupleUpdate) *time.Time {
+	if mutation.Tuple.OptionalExpirationTime == nil {
+		return nil
+	}
+
+	expiration := mutation.Tuple.OptionalExpirationTime.AsTime()
+	return &expiration
+}
+
 func (rwt *memdbReadWriteTx) DeleteRelationships(_ context.Context, filter *v1.RelationshipFilter, opts ...options.DeleteOptionsOption) (bool, error) {
 	rwt.mustLock()
 	defer rwt.Unlock()
@@ -15,6 +15,12 @@ func nowRevision() revisions.TimestampRevision {
 	return revisions.NewForTime(time.Now().UTC())
 }
 
+// revisionTime returns the time of the revision, at which the expiration of the relationships
+// read at the revision is evaluated.
+func revisionTime(rev datastore.Revision) time.Time {
+	return time.Unix(0, rev.(revisions.TimestampRevision).TimestampNanoSec()).UTC()
+}
+
 func (mdb *memdbDatastore) newRevisionID() revisions.TimestampRevision {
 	mdb.Lock()
 	defer mdb.Unlock()
@@ -1,11 +1,14 @@
 package memdb
 
 import (
+	"time"
+
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/hashicorp/go-memdb"
 	"github.com/jzelinskie/stringz"
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
@@ -42,6 +45,7 @@ type relationship struct {
 	subjectObjectID  string
 	subjectRelation  string
 	caveat           *contextualizedCaveat
+	expiration       *time.Time
 }
 
 type contextualizedCaveat struct {
@@ -69,7 +73,17 @@ func (r relationship) String() string {
 		caveat = "[" + r.caveat.caveatName + "]"
 	}
 
-	return r.namespace + ":" + r.resourceID + "#" + r.relation + "@" + r.subjectNamespace + ":" + r.subjectObjectID + "#" + r.subjectRelation + caveat
+	expiration := ""
+	if r.expiration != nil {
+		expiration = "[expiration:" + r.expiration.Format(time.RFC3339Nano) + "]"
+	}
+
+	return r.namespace + ":" + r.resourceID + "#" + r.relation + "@" + r.subjectNamespace + ":" + r.subjectObjectID + "#" + r.subjectRelation + caveat + expiration
+}
+
+// isExpired returns true if the relationship has an expiration at or before the given time.
+func (r relationship) isExpired(now time.Time) bool {
+	return r.expiration != nil && !r.expiration.After(now)
 }
 
 func (r relationship) MarshalZerologObject(e *zerolog.Event) {
@@ -98,6 +112,12 @@ func (r relationship) RelationTuple() (*core.RelationTuple, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	var expiration *timestamppb.Timestamp
+	if r.expiration != nil {
+		expiration = timestamppb.New(*r.expiration)
+	}
+
 	return &core.RelationTuple{
 		ResourceAndRelation: &core.ObjectAndRelation{
 			Namespace: r.namespace,
@@ -109,7 +129,8 @@ func (r relationship) RelationTuple() (*core.RelationTuple, error) {
 			ObjectId:  r.subjectObjectID,
 			Relation:  r.subjectRelation,
 		},
-		Caveat: cr,
+		Caveat:                 cr,
+		OptionalExpirationTime: expiration,
 	}, nil
 }
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-memdb"
 
+	"github.com/authzed/spicedb/internal/datastore/common"
 	"github.com/authzed/spicedb/internal/datastore/revisions"
 	"github.com/authzed/spicedb/pkg/datastore"
 )
@@ -54,6 +55,11 @@ func (mdb *memdbDatastore) Watch(ctx context.Context, ar datastore.Revision, opt
 		defer close(updates)
 		defer close(errs)
 
+		if err := common.CheckWatchStartRevision(ctx, mdb, ar); err != nil {
+			errs <- err
+			return
+		}
+
 		currentTxn := ar.(revisions.TimestampRevision).TimestampNanoSec()
 
 		for {
@@ -114,6 +120,13 @@ func (mdb *memdbDatastore) loadChanges(_ context.Context, currentTxn int64, opti
 			changes = append(changes, &change.changes)
 		}
 
+		if options.Content&datastore.WatchSchema == datastore.WatchSchema &&
+			(len(change.changes.ChangedDefinitions) > 0 || len(change.changes.DeletedCaveats) > 0 || len(change.changes.DeletedNamespaces) > 0) {
+			changes = append(changes, &change.changes)
+		}
+
+		// The checkpoint is sent after all changes at its revision, as it indicates that they have
+		// all been reported.
 		if options.Content&datastore.WatchCheckpoints == datastore.WatchCheckpoints && change.revisionNanos > lastRevision {
 			changes = append(changes, &datastore.RevisionChanges{
 				Revision:     revisions.NewForTimestamp(change.revisionNanos),
@@ -121,11 +134,6 @@ func (mdb *memdbDatastore) loadChanges(_ context.Context, currentTxn int64, opti
 			})
 		}
 
-		if options.Content&datastore.WatchSchema == datastore.WatchSchema &&
-			len(change.changes.ChangedDefinitions) > 0 || len(change.changes.DeletedCaveats) > 0 || len(change.changes.DeletedNamespaces) > 0 {
-			changes = append(changes, &change.changes)
-		}
-
 		lastRevision = change.revisionNanos
 	}
 
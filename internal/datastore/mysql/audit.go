@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+func (mds *Datastore) WriteAuditRecord(ctx context.Context, record datastore.AuditRecord) error {
+	query, args, err := mds.WriteAuditRecordQuery.Values(record.Timestamp.UTC(), string(record.Data)).ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to prepare audit record sql: %w", err)
+	}
+
+	if _, err := mds.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("unable to write audit record: %w", err)
+	}
+	return nil
+}
+
+func (mds *Datastore) ReadAuditRecords(ctx context.Context, since time.Time, limit uint64) ([]datastore.AuditRecord, error) {
+	query, args, err := mds.ReadAuditRecordsQuery.
+		Where(sq.GtOrEq{colTimestamp: since.UTC()}).
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare audit records sql: %w", err)
+	}
+
+	rows, err := mds.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []datastore.AuditRecord
+	for rows.Next() {
+		var record datastore.AuditRecord
+		var data string
+		if err := rows.Scan(&record.Timestamp, &data); err != nil {
+			return nil, fmt.Errorf("unable to read audit record: %w", err)
+		}
+		record.Timestamp = record.Timestamp.UTC()
+		record.Data = []byte(data)
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read audit records: %w", err)
+	}
+	return records, nil
+}
+
+var _ datastore.AuditLogDatastore = &Datastore{}
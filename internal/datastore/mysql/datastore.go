@@ -48,6 +48,9 @@ const (
 	colCaveatDefinition = "definition"
 	colCaveatName       = "caveat_name"
 	colCaveatContext    = "caveat_context"
+	colExpiration       = "expiration"
+	colAuditRecord      = "record"
+	colMetadata         = "metadata"
 
 	errUnableToInstantiate = "unable to instantiate datastore: %w"
 	liveDeletedTxnID       = uint64(math.MaxInt64)
@@ -161,7 +164,7 @@ func newMySQLDatastore(ctx context.Context, uri string, options ...Option) (*Dat
 	driver := migrations.NewMySQLDriverFromDB(db, config.tablePrefix)
 	queryBuilder := NewQueryBuilder(driver)
 
-	createTxn, _, err := sb.Insert(driver.RelationTupleTransaction()).Values().ToSql()
+	createTxn, _, err := sb.Insert(driver.RelationTupleTransaction()).Columns(colMetadata).Values(nil).ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("NewMySQLDatastore: %w", err)
 	}
@@ -271,6 +274,7 @@ func (mds *Datastore) SnapshotReader(rev datastore.Revision) datastore.Reader {
 		createTxFunc,
 		executor,
 		buildLivingObjectFilterForRevision(rev),
+		mds.buildTimeForRevision(rev),
 	}
 }
 
@@ -289,7 +293,7 @@ func (mds *Datastore) ReadWriteTx(
 	for i := uint8(0); i <= mds.maxRetries; i++ {
 		var newTxnID uint64
 		if err = migrations.BeginTxFunc(ctx, mds.db, &sql.TxOptions{Isolation: sql.LevelSerializable}, func(tx *sql.Tx) error {
-			newTxnID, err = mds.createNewTransaction(ctx, tx)
+			newTxnID, err = mds.createNewTransaction(ctx, tx, config.Metadata)
 			if err != nil {
 				return fmt.Errorf("unable to create new txn ID: %w", err)
 			}
@@ -308,6 +312,7 @@ func (mds *Datastore) ReadWriteTx(
 					longLivedTx,
 					executor,
 					currentlyLivingObjects,
+					currentTime,
 				},
 				mds.driver.RelationTuple(),
 				tx,
@@ -392,6 +397,7 @@ func newMySQLExecutor(tx querier) common.ExecuteQueryFunc {
 
 			var caveatName string
 			var caveatContext caveatContextWrapper
+			var expiration *time.Time
 			err := rows.Scan(
 				&nextTuple.ResourceAndRelation.Namespace,
 				&nextTuple.ResourceAndRelation.ObjectId,
@@ -401,6 +407,7 @@ func newMySQLExecutor(tx querier) common.ExecuteQueryFunc {
 				&nextTuple.Subject.Relation,
 				&caveatName,
 				&caveatContext,
+				&expiration,
 			)
 			if err != nil {
 				return nil, fmt.Errorf(errUnableToQueryTuples, err)
@@ -410,6 +417,7 @@ func newMySQLExecutor(tx querier) common.ExecuteQueryFunc {
 			if err != nil {
 				return nil, fmt.Errorf(errUnableToQueryTuples, err)
 			}
+			nextTuple.OptionalExpirationTime = common.ExpirationTimestampFrom(expiration)
 
 			tuples = append(tuples, nextTuple)
 		}
@@ -604,6 +612,19 @@ func buildLivingObjectFilterForRevision(revision datastore.Revision) queryFilter
 	}
 }
 
+// buildTimeForRevision returns an expression for the time of the revision, at which the expiration
+// of relationships read at the revision is evaluated: the time of its transaction.
+func (mds *Datastore) buildTimeForRevision(revision datastore.Revision) sq.Sqlizer {
+	return sb.Select(colTimestamp).
+		From(mds.driver.RelationTupleTransaction()).
+		Where(sq.Eq{colID: revision.(revisions.TransactionIDRevision).TransactionID()}).
+		Prefix("(").
+		Suffix(")")
+}
+
+// currentTime is the time of the revision being written by a transaction.
+var currentTime = sq.Expr("UTC_TIMESTAMP(6)")
+
 func currentlyLivingObjects(original sq.SelectBuilder) sq.SelectBuilder {
 	return original.Where(sq.Eq{colDeletedTxn: liveDeletedTxnID})
 }
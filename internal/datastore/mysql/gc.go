@@ -92,6 +92,11 @@ func (mds *Datastore) DeleteBeforeTx(
 	return
 }
 
+func (mds *Datastore) DeleteExpiredRelationships(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	// Delete any relationship rows, living or dead, that expired before the GC window.
+	return mds.batchDelete(ctx, mds.driver.RelationTuple(), sq.Lt{colExpiration: expiredBefore})
+}
+
 // - query was reworked to make it compatible with Vitess
 // - API differences with PSQL driver
 func (mds *Datastore) batchDelete(ctx context.Context, tableName string, filter sqlFilter) (int64, error) {
@@ -7,6 +7,7 @@ const (
 	tableMigrationVersion   = "mysql_migration_version"
 	tableMetadataDefault    = "mysql_metadata"
 	tableCaveatDefault      = "caveat"
+	tableAuditLogDefault    = "audit_log"
 )
 
 type tables struct {
@@ -16,6 +17,7 @@ type tables struct {
 	tableNamespace        string
 	tableMetadata         string
 	tableCaveat           string
+	tableAuditLog         string
 }
 
 func newTables(prefix string) *tables {
@@ -26,6 +28,7 @@ func newTables(prefix string) *tables {
 		tableNamespace:        prefix + tableNamespaceDefault,
 		tableMetadata:         prefix + tableMetadataDefault,
 		tableCaveat:           prefix + tableCaveatDefault,
+		tableAuditLog:         prefix + tableAuditLogDefault,
 	}
 }
 
@@ -55,3 +58,8 @@ func (tn *tables) Metadata() string {
 func (tn *tables) Caveat() string {
 	return tn.tableCaveat
 }
+
+// AuditLog returns the prefixed audit log table name.
+func (tn *tables) AuditLog() string {
+	return tn.tableAuditLog
+}
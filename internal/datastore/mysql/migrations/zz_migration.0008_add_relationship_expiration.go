@@ -0,0 +1,26 @@
+package migrations
+
+import "fmt"
+
+// Expiration times are stored in UTC, with microsecond precision.
+func addExpirationToRelationTuplesTable(t *tables) string {
+	return fmt.Sprintf(`ALTER TABLE %s
+			ADD COLUMN expiration DATETIME(6) DEFAULT NULL;`,
+		t.RelationTuple(),
+	)
+}
+
+func addExpirationIndex(t *tables) string {
+	return fmt.Sprintf(`CREATE INDEX ix_relation_tuple_expiration
+    ON %s (expiration);`, t.RelationTuple(),
+	)
+}
+
+func init() {
+	mustRegisterMigration("add_relationship_expiration", "watch_api_relation_tuple_index", noNonatomicMigration,
+		newStatementBatch(
+			addExpirationToRelationTuplesTable,
+			addExpirationIndex,
+		).execute,
+	)
+}
@@ -0,0 +1,23 @@
+package migrations
+
+import "fmt"
+
+// Timestamps of audit records are stored in UTC, with microsecond precision.
+func createAuditLogTable(t *tables) string {
+	return fmt.Sprintf(`CREATE TABLE %s (
+		id BIGINT NOT NULL AUTO_INCREMENT,
+		timestamp DATETIME(6) NOT NULL,
+		record JSON NOT NULL,
+		CONSTRAINT pk_audit_log PRIMARY KEY (id),
+		INDEX ix_audit_log_by_timestamp (timestamp, id));`,
+		t.AuditLog(),
+	)
+}
+
+func init() {
+	mustRegisterMigration("add_audit_log", "add_relationship_expiration", noNonatomicMigration,
+		newStatementBatch(
+			createAuditLogTable,
+		).execute,
+	)
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import "fmt"
+
+func addMetadataToRelationTupleTransactionTable(t *tables) string {
+	return fmt.Sprintf(`ALTER TABLE %s
+			ADD COLUMN metadata JSON DEFAULT NULL;`,
+		t.RelationTupleTransaction(),
+	)
+}
+
+func init() {
+	mustRegisterMigration("add_transaction_metadata", "add_audit_log", noNonatomicMigration,
+		newStatementBatch(
+			addMetadataToRelationTupleTransactionTable,
+		).execute,
+	)
+}
@@ -12,6 +12,8 @@ type QueryBuilder struct {
 	GetLastRevision  sq.SelectBuilder
 	GetRevisionRange sq.SelectBuilder
 
+	QueryTransactionMetadataQuery sq.SelectBuilder
+
 	WriteNamespaceQuery        sq.InsertBuilder
 	ReadNamespaceQuery         sq.SelectBuilder
 	DeleteNamespaceQuery       sq.UpdateBuilder
@@ -29,6 +31,9 @@ type QueryBuilder struct {
 	ReadCaveatQuery   sq.SelectBuilder
 	ListCaveatsQuery  sq.SelectBuilder
 	DeleteCaveatQuery sq.UpdateBuilder
+
+	WriteAuditRecordQuery sq.InsertBuilder
+	ReadAuditRecordsQuery sq.SelectBuilder
 }
 
 // NewQueryBuilder returns a new QueryBuilder instance. The migration
@@ -39,6 +44,7 @@ func NewQueryBuilder(driver *migrations.MySQLDriver) *QueryBuilder {
 	// transaction builders
 	builder.GetLastRevision = getLastRevision(driver.RelationTupleTransaction())
 	builder.GetRevisionRange = getRevisionRange(driver.RelationTupleTransaction())
+	builder.QueryTransactionMetadataQuery = queryTransactionMetadata(driver.RelationTupleTransaction())
 
 	// namespace builders
 	builder.WriteNamespaceQuery = writeNamespace(driver.Namespace())
@@ -61,9 +67,21 @@ func NewQueryBuilder(driver *migrations.MySQLDriver) *QueryBuilder {
 	builder.WriteCaveatQuery = writeCaveat(driver.Caveat())
 	builder.DeleteCaveatQuery = deleteCaveat(driver.Caveat())
 
+	// audit log builders
+	builder.WriteAuditRecordQuery = writeAuditRecord(driver.AuditLog())
+	builder.ReadAuditRecordsQuery = readAuditRecords(driver.AuditLog())
+
 	return &builder
 }
 
+func writeAuditRecord(tableAuditLog string) sq.InsertBuilder {
+	return sb.Insert(tableAuditLog).Columns(colTimestamp, colAuditRecord)
+}
+
+func readAuditRecords(tableAuditLog string) sq.SelectBuilder {
+	return sb.Select(colTimestamp, colAuditRecord).From(tableAuditLog).OrderBy(colTimestamp, colID)
+}
+
 func listCaveats(tableCaveat string) sq.SelectBuilder {
 	return sb.Select(colCaveatDefinition, colCreatedTxn).From(tableCaveat).OrderBy(colName)
 }
@@ -92,6 +110,10 @@ func getRevisionRange(tableTransaction string) sq.SelectBuilder {
 	return sb.Select("MIN(id)", "MAX(id)").From(tableTransaction)
 }
 
+func queryTransactionMetadata(tableTransaction string) sq.SelectBuilder {
+	return sb.Select(colID, colMetadata).From(tableTransaction).Where(sq.NotEq{colMetadata: nil})
+}
+
 func writeNamespace(tableNamespace string) sq.InsertBuilder {
 	return sb.Insert(tableNamespace).Columns(
 		colNamespace,
@@ -123,6 +145,7 @@ func queryTuplesWithIds(tableTuple string) sq.SelectBuilder {
 		colUsersetRelation,
 		colCaveatName,
 		colCaveatContext,
+		colExpiration,
 	).From(tableTuple)
 }
 
@@ -136,6 +159,7 @@ func queryTuples(tableTuple string) sq.SelectBuilder {
 		colUsersetRelation,
 		colCaveatName,
 		colCaveatContext,
+		colExpiration,
 	).From(tableTuple)
 }
 
@@ -163,6 +187,7 @@ func writeTuple(tableTuple string) sq.InsertBuilder {
 		colUsersetRelation,
 		colCaveatName,
 		colCaveatContext,
+		colExpiration,
 		colCreatedTxn,
 	)
 }
@@ -177,6 +202,7 @@ func queryChanged(tableTuple string) sq.SelectBuilder {
 		colUsersetRelation,
 		colCaveatName,
 		colCaveatContext,
+		colExpiration,
 		colCreatedTxn,
 		colDeletedTxn,
 	).From(tableTuple)
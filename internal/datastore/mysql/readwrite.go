@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
@@ -86,6 +87,12 @@ func (rwt *mysqlReadWriteTXN) WriteRelationships(ctx context.Context, mutations
 		case core.RelationTupleUpdate_CREATE:
 			createAndTouchMutationsByTuple[tplString] = mut
 
+			// Expired relationships are no longer visible, so a CREATE over one must replace it.
+			clauses = append(clauses, sq.And{
+				exactRelationshipClause(tpl),
+				sq.Expr(colExpiration + " <= UTC_TIMESTAMP(6)"),
+			})
+
 		case core.RelationTupleUpdate_TOUCH:
 			createAndTouchMutationsByTuple[tplString] = mut
 			clauses = append(clauses, exactRelationshipClause(tpl))
@@ -120,6 +127,7 @@ func (rwt *mysqlReadWriteTXN) WriteRelationships(ctx context.Context, mutations
 
 		var caveatName string
 		var caveatContext caveatContextWrapper
+		var expiration *time.Time
 
 		tupleIdsToDelete := make([]int64, 0, len(clauses))
 		for rows.Next() {
@@ -134,6 +142,7 @@ func (rwt *mysqlReadWriteTXN) WriteRelationships(ctx context.Context, mutations
 				&foundTpl.Subject.Relation,
 				&caveatName,
 				&caveatContext,
+				&expiration,
 			); err != nil {
 				return fmt.Errorf(errUnableToWriteRelationships, err)
 			}
@@ -141,11 +150,12 @@ func (rwt *mysqlReadWriteTXN) WriteRelationships(ctx context.Context, mutations
 			// if the relationship to be deleted is for a TOUCH operation and the caveat
 			// name or context has not changed, then remove it from delete and create.
 			tplString := tuple.StringWithoutCaveat(foundTpl)
-			if mut, ok := createAndTouchMutationsByTuple[tplString]; ok {
+			if mut, ok := createAndTouchMutationsByTuple[tplString]; ok && mut.Operation == core.RelationTupleUpdate_TOUCH {
 				foundTpl.Caveat, err = common.ContextualizedCaveatFrom(caveatName, caveatContext)
 				if err != nil {
 					return fmt.Errorf(errUnableToQueryTuples, err)
 				}
+				foundTpl.OptionalExpirationTime = common.ExpirationTimestampFrom(expiration)
 
 				// Ensure the tuples are the same.
 				if tuple.Equal(mut.Tuple, foundTpl) {
@@ -194,6 +204,7 @@ func (rwt *mysqlReadWriteTXN) WriteRelationships(ctx context.Context, mutations
 			tpl.Subject.Relation,
 			caveatName,
 			&caveatContext,
+			common.ExpirationFrom(tpl),
 			rwt.newTxnID,
 		)
 		bulkWriteHasValues = true
@@ -373,7 +384,7 @@ func (rwt *mysqlReadWriteTXN) DeleteNamespaces(ctx context.Context, nsNames ...s
 func (rwt *mysqlReadWriteTXN) BulkLoad(ctx context.Context, iter datastore.BulkWriteRelationshipSource) (uint64, error) {
 	var sqlStmt bytes.Buffer
 
-	sql, _, err := rwt.WriteTupleQuery.Values(1, 2, 3, 4, 5, 6, 7, 8, 9).ToSql()
+	sql, _, err := rwt.WriteTupleQuery.Values(1, 2, 3, 4, 5, 6, 7, 8, 9, 10).ToSql()
 	if err != nil {
 		return 0, err
 	}
@@ -392,7 +403,7 @@ func (rwt *mysqlReadWriteTXN) BulkLoad(ctx context.Context, iter datastore.BulkW
 
 		for ; tpl != nil && err == nil && batchLen < bulkInsertRowsLimit; tpl, err = iter.Next(ctx) {
 			if batchLen != 0 {
-				sqlStmt.WriteString(",(?,?,?,?,?,?,?,?,?)")
+				sqlStmt.WriteString(",(?,?,?,?,?,?,?,?,?,?)")
 			}
 
 			var caveatName string
@@ -410,6 +421,7 @@ func (rwt *mysqlReadWriteTXN) BulkLoad(ctx context.Context, iter datastore.BulkW
 				tpl.Subject.Relation,
 				caveatName,
 				&caveatContext,
+				common.ExpirationFrom(tpl),
 				rwt.newTxnID,
 			)
 			batchLen++
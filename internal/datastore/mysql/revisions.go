@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/protobuf/types/known/structpb"
+
 	"github.com/authzed/spicedb/internal/datastore/revisions"
 	"github.com/authzed/spicedb/pkg/datastore"
 )
@@ -156,7 +158,7 @@ func (mds *Datastore) checkValidTransaction(ctx context.Context, revisionTx uint
 	return freshEnough.Bool, unknown.Bool, nil
 }
 
-func (mds *Datastore) createNewTransaction(ctx context.Context, tx *sql.Tx) (newTxnID uint64, err error) {
+func (mds *Datastore) createNewTransaction(ctx context.Context, tx *sql.Tx, metadata *structpb.Struct) (newTxnID uint64, err error) {
 	ctx, span := tracer.Start(ctx, "createNewTransaction")
 	defer span.End()
 
@@ -165,7 +167,14 @@ func (mds *Datastore) createNewTransaction(ctx context.Context, tx *sql.Tx) (new
 		return 0, fmt.Errorf("createNewTransaction: %w", err)
 	}
 
-	result, err := tx.ExecContext(ctx, createQuery)
+	// Transactions without metadata leave the column NULL.
+	var metadataValue any
+	if metadata != nil {
+		metadataWrapper := caveatContextWrapper(metadata.AsMap())
+		metadataValue = &metadataWrapper
+	}
+
+	result, err := tx.ExecContext(ctx, createQuery, metadataValue)
 	if err != nil {
 		return 0, fmt.Errorf("createNewTransaction: %w", err)
 	}
@@ -71,8 +71,19 @@ func (mds *Datastore) Watch(ctx context.Context, afterRevisionRaw datastore.Revi
 		defer close(updates)
 		defer close(errs)
 
+		if err := common.CheckWatchStartRevision(ctx, mds, afterRevision); err != nil {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				errs <- datastore.NewWatchCanceledErr()
+			} else {
+				errs <- err
+			}
+			return
+		}
+
 		currentTxn := afterRevision.TransactionID()
 		for {
+			previousTxn := currentTxn
+
 			var stagedUpdates []datastore.RevisionChanges
 			var err error
 			stagedUpdates, currentTxn, err = mds.loadChanges(ctx, currentTxn, options)
@@ -93,6 +104,16 @@ func (mds *Datastore) Watch(ctx context.Context, afterRevisionRaw datastore.Revi
 				}
 			}
 
+			// Report that all changes through the newly loaded revision have been sent.
+			if options.Content&datastore.WatchCheckpoints == datastore.WatchCheckpoints && currentTxn > previousTxn {
+				if !sendChange(&datastore.RevisionChanges{
+					Revision:     revisions.NewForTransactionID(currentTxn),
+					IsCheckpoint: true,
+				}) {
+					return
+				}
+			}
+
 			// If there were no changes, sleep a bit
 			if len(stagedUpdates) == 0 {
 				sleep := time.NewTimer(watchSleep)
@@ -160,6 +181,7 @@ func (mds *Datastore) loadChanges(
 		var deletedTxn uint64
 		var caveatName string
 		var caveatContext caveatContextWrapper
+		var expiration *time.Time
 		err = rows.Scan(
 			&nextTuple.ResourceAndRelation.Namespace,
 			&nextTuple.ResourceAndRelation.ObjectId,
@@ -169,6 +191,7 @@ func (mds *Datastore) loadChanges(
 			&nextTuple.Subject.Relation,
 			&caveatName,
 			&caveatContext,
+			&expiration,
 			&createdTxn,
 			&deletedTxn,
 		)
@@ -179,6 +202,7 @@ func (mds *Datastore) loadChanges(
 		if err != nil {
 			return
 		}
+		nextTuple.OptionalExpirationTime = common.ExpirationTimestampFrom(expiration)
 
 		if createdTxn > afterRevision && createdTxn <= newRevision {
 			if err = stagedChanges.AddRelationshipChange(ctx, revisions.NewForTransactionID(createdTxn), nextTuple, core.RelationTupleUpdate_TOUCH); err != nil {
@@ -196,6 +220,47 @@ func (mds *Datastore) loadChanges(
 		return
 	}
 
+	if err = mds.loadTransactionMetadata(ctx, afterRevision, newRevision, stagedChanges); err != nil {
+		return
+	}
+
 	changes = stagedChanges.AsRevisionChanges(revisions.TransactionIDKeyLessThanFunc)
 	return
 }
+
+func (mds *Datastore) loadTransactionMetadata(
+	ctx context.Context,
+	afterRevision uint64,
+	newRevision uint64,
+	stagedChanges *common.Changes[revisions.TransactionIDRevision, uint64],
+) error {
+	sql, args, err := mds.QueryTransactionMetadataQuery.Where(sq.And{
+		sq.Gt{colID: afterRevision},
+		sq.LtOrEq{colID: newRevision},
+	}).ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := mds.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return datastore.NewWatchCanceledErr()
+		}
+		return err
+	}
+	defer common.LogOnError(ctx, rows.Close)
+
+	for rows.Next() {
+		var txnID uint64
+		var metadata caveatContextWrapper
+		if err := rows.Scan(&txnID, &metadata); err != nil {
+			return err
+		}
+
+		if err := stagedChanges.SetRevisionMetadata(ctx, revisions.NewForTransactionID(txnID), metadata); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
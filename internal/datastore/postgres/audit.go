@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+const (
+	tableAuditLog = "audit_log"
+	colID         = "id"
+	colRecord     = "record"
+)
+
+func (pgd *pgDatastore) WriteAuditRecord(ctx context.Context, record datastore.AuditRecord) error {
+	sql, args, err := psql.Insert(tableAuditLog).
+		Columns(colTimestamp, colRecord).
+		Values(record.Timestamp.UTC(), string(record.Data)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to prepare audit record sql: %w", err)
+	}
+
+	if _, err := pgd.writePool.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("unable to write audit record: %w", err)
+	}
+	return nil
+}
+
+func (pgd *pgDatastore) ReadAuditRecords(ctx context.Context, since time.Time, limit uint64) ([]datastore.AuditRecord, error) {
+	sql, args, err := psql.Select(colTimestamp, colRecord).
+		From(tableAuditLog).
+		Where(sq.GtOrEq{colTimestamp: since.UTC()}).
+		OrderBy(colTimestamp, colID).
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare audit records sql: %w", err)
+	}
+
+	rows, err := pgd.readPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []datastore.AuditRecord
+	for rows.Next() {
+		var record datastore.AuditRecord
+		var data string
+		if err := rows.Scan(&record.Timestamp, &data); err != nil {
+			return nil, fmt.Errorf("unable to read audit record: %w", err)
+		}
+		record.Data = []byte(data)
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read audit records: %w", err)
+	}
+	return records, nil
+}
+
+var _ datastore.AuditLogDatastore = &pgDatastore{}
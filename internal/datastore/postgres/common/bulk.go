@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 
+	"github.com/authzed/spicedb/internal/datastore/common"
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 )
@@ -45,6 +46,7 @@ func (tg *tupleSourceAdapter) Values() ([]any, error) {
 	tg.valuesBuffer[5] = tg.current.Subject.Relation
 	tg.valuesBuffer[6] = caveatName
 	tg.valuesBuffer[7] = caveatContext
+	tg.valuesBuffer[8] = common.ExpirationFrom(tg.current)
 
 	return tg.valuesBuffer, nil
 }
@@ -65,7 +67,7 @@ func BulkLoad(
 	adapter := &tupleSourceAdapter{
 		source:       iter,
 		ctx:          ctx,
-		valuesBuffer: make([]any, 8),
+		valuesBuffer: make([]any, 9),
 	}
 	copied, err := tx.CopyFrom(ctx, pgx.Identifier{tupleTableName}, colNames, adapter)
 	return uint64(copied), err
@@ -45,6 +45,7 @@ func queryTuples(ctx context.Context, sqlStatement string, args []any, span trac
 			}
 			var caveatName sql.NullString
 			var caveatCtx map[string]any
+			var expiration *time.Time
 			err := rows.Scan(
 				&nextTuple.ResourceAndRelation.Namespace,
 				&nextTuple.ResourceAndRelation.ObjectId,
@@ -54,6 +55,7 @@ func queryTuples(ctx context.Context, sqlStatement string, args []any, span trac
 				&nextTuple.Subject.Relation,
 				&caveatName,
 				&caveatCtx,
+				&expiration,
 			)
 			if err != nil {
 				return fmt.Errorf(errUnableToQueryTuples, fmt.Errorf("scan err: %w", err))
@@ -63,6 +65,7 @@ func queryTuples(ctx context.Context, sqlStatement string, args []any, span trac
 			if err != nil {
 				return fmt.Errorf(errUnableToQueryTuples, fmt.Errorf("unable to fetch caveat context: %w", err))
 			}
+			nextTuple.OptionalExpirationTime = common.ExpirationTimestampFrom(expiration)
 			tuples = append(tuples, nextTuple)
 		}
 		if err := rows.Err(); err != nil {
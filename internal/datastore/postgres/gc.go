@@ -123,6 +123,21 @@ func (pgd *pgDatastore) DeleteBeforeTx(ctx context.Context, txID datastore.Revis
 	return removed, err
 }
 
+func (pgd *pgDatastore) DeleteExpiredRelationships(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	// Delete any relationship rows, living or dead, that expired before the GC window.
+	removed, err := pgd.batchDelete(
+		ctx,
+		tableTuple,
+		relationTuplePKCols,
+		sq.Lt{colExpiration: expiredBefore},
+	)
+	if err != nil {
+		return removed, fmt.Errorf("failed to GC expired relationships: %w", err)
+	}
+
+	return removed, nil
+}
+
 func (pgd *pgDatastore) batchDelete(
 	ctx context.Context,
 	tableName string,
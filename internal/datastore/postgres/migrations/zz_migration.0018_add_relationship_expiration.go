@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const addExpirationColumn = `ALTER TABLE relation_tuple
+	ADD COLUMN IF NOT EXISTS expiration TIMESTAMPTZ DEFAULT NULL;`
+
+func init() {
+	if err := DatabaseMigrations.Register("add-relationship-expiration", "add-rel-by-alive-resource-relation-subject",
+		noNonatomicMigration,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, addExpirationColumn)
+			return err
+		}); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const createExpirationGCIndex = `CREATE INDEX CONCURRENTLY
+	IF NOT EXISTS ix_relation_tuple_expired
+	ON relation_tuple (expiration)
+	WHERE expiration IS NOT NULL;`
+
+func init() {
+	if err := DatabaseMigrations.Register("add-expiration-gc-index", "add-relationship-expiration",
+		func(ctx context.Context, conn *pgx.Conn) error {
+			if _, err := conn.Exec(ctx, createExpirationGCIndex); err != nil {
+				return fmt.Errorf("failed to create index for expired relationships: %w", err)
+			}
+			return nil
+		},
+		noTxMigration); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
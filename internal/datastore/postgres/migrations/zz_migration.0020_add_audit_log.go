@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var addAuditLogStatements = []string{
+	`CREATE TABLE IF NOT EXISTS audit_log (
+		id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+		timestamp TIMESTAMPTZ NOT NULL,
+		record JSONB NOT NULL);`,
+	`CREATE INDEX IF NOT EXISTS ix_audit_log_by_timestamp ON audit_log (timestamp, id);`,
+}
+
+func init() {
+	if err := DatabaseMigrations.Register("add-audit-log", "add-expiration-gc-index",
+		noNonatomicMigration,
+		func(ctx context.Context, tx pgx.Tx) error {
+			for _, stmt := range addAuditLogStatements {
+				if _, err := tx.Exec(ctx, stmt); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
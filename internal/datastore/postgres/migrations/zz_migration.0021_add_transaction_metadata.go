@@ -0,0 +1,21 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const addTransactionMetadataColumn = `ALTER TABLE relation_tuple_transaction
+	ADD COLUMN IF NOT EXISTS metadata JSONB DEFAULT NULL;`
+
+func init() {
+	if err := DatabaseMigrations.Register("add-transaction-metadata", "add-audit-log",
+		noNonatomicMigration,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, addTransactionMetadataColumn)
+			return err
+		}); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
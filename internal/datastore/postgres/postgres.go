@@ -59,6 +59,8 @@ const (
 	colCaveatDefinition  = "definition"
 	colCaveatContextName = "caveat_name"
 	colCaveatContext     = "caveat_context"
+	colExpiration        = "expiration"
+	colMetadata          = "metadata"
 
 	errUnableToInstantiate = "unable to instantiate datastore"
 
@@ -68,6 +70,15 @@ const (
 	// The placeholders are the snapshot and the expected boolean value respectively.
 	snapshotAlive = "pg_visible_in_snapshot(%[1]s, ?) = ?"
 
+	// The time of a snapshot is the time at which the latest transaction visible in it started.
+	// The parameters to this format string are:
+	// 1: the timestamp column name
+	// 2: the transaction table name
+	// 3: the xid column name
+	//
+	// The placeholders are the xmax and the snapshot respectively.
+	snapshotTime = "(SELECT %[1]s AT TIME ZONE 'UTC' FROM %[2]s WHERE %[3]s < ? AND pg_visible_in_snapshot(%[3]s, ?) ORDER BY %[3]s DESC LIMIT 1)"
+
 	// This is the largest positive integer possible in postgresql
 	liveDeletedTxnID = uint64(9223372036854775807)
 
@@ -92,8 +103,9 @@ var (
 			Limit(1)
 
 	createTxn = fmt.Sprintf(
-		"INSERT INTO %s DEFAULT VALUES RETURNING %s, %s",
+		"INSERT INTO %s (%s) VALUES ($1) RETURNING %s, %s",
 		tableTransaction,
+		colMetadata,
 		colXID,
 		colSnapshot,
 	)
@@ -318,6 +330,7 @@ func (pgd *pgDatastore) SnapshotReader(revRaw datastore.Revision) datastore.Read
 		queryFuncs,
 		executor,
 		buildLivingObjectFilterForRevision(rev),
+		buildTimeForRevision(rev),
 	}
 }
 
@@ -336,7 +349,7 @@ func (pgd *pgDatastore) ReadWriteTx(
 		var newSnapshot pgSnapshot
 		err = wrapError(pgx.BeginTxFunc(ctx, pgd.writePool, pgx.TxOptions{IsoLevel: pgx.Serializable}, func(tx pgx.Tx) error {
 			var err error
-			newXID, newSnapshot, err = createNewTransaction(ctx, tx)
+			newXID, newSnapshot, err = createNewTransaction(ctx, tx, config.Metadata)
 			if err != nil {
 				return err
 			}
@@ -351,6 +364,7 @@ func (pgd *pgDatastore) ReadWriteTx(
 					queryFuncs,
 					executor,
 					currentlyLivingObjects,
+					currentTime,
 				},
 				tx,
 				newXID,
@@ -586,6 +600,21 @@ func buildLivingObjectFilterForRevision(revision postgresRevision) queryFilterer
 	}
 }
 
+// buildTimeForRevision returns an expression for the time of the revision, at which the expiration
+// of relationships read at the revision is evaluated.
+func buildTimeForRevision(revision postgresRevision) sq.Sqlizer {
+	return sq.Expr(fmt.Sprintf(
+		snapshotTime,
+		colTimestamp,
+		tableTransaction,
+		colXID,
+	), newXid8(revision.snapshot.xmax), revision.snapshot)
+}
+
+// currentTime is the time of the revision being written by a transaction: the time at which the
+// transaction started, as recorded in the transaction table.
+var currentTime = sq.Expr("NOW()")
+
 func currentlyLivingObjects(original sq.SelectBuilder) sq.SelectBuilder {
 	return original.Where(sq.Eq{colDeletedXid: liveDeletedTxnID})
 }
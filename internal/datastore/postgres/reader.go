@@ -16,9 +16,10 @@ import (
 )
 
 type pgReader struct {
-	query    pgxcommon.DBFuncQuerier
-	executor common.QueryExecutor
-	filterer queryFilterer
+	query        pgxcommon.DBFuncQuerier
+	executor     common.QueryExecutor
+	filterer     queryFilterer
+	revisionTime sq.Sqlizer
 }
 
 type queryFilterer func(original sq.SelectBuilder) sq.SelectBuilder
@@ -33,6 +34,7 @@ var (
 		colUsersetRelation,
 		colCaveatContextName,
 		colCaveatContext,
+		colExpiration,
 	).From(tableTuple)
 
 	schema = common.NewSchemaInformation(
@@ -43,6 +45,7 @@ var (
 		colUsersetObjectID,
 		colUsersetRelation,
 		colCaveatContextName,
+		colExpiration,
 		common.TupleComparison,
 	)
 
@@ -61,7 +64,7 @@ func (r *pgReader) QueryRelationships(
 	filter datastore.RelationshipsFilter,
 	opts ...options.QueryOptionsOption,
 ) (iter datastore.RelationshipIterator, err error) {
-	qBuilder, err := common.NewSchemaQueryFilterer(schema, r.filterer(queryTuples)).FilterWithRelationshipsFilter(filter)
+	qBuilder, err := common.NewSchemaQueryFilterer(schema, r.filterer(queryTuples), r.revisionTime).FilterWithRelationshipsFilter(filter)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +77,7 @@ func (r *pgReader) ReverseQueryRelationships(
 	subjectsFilter datastore.SubjectsFilter,
 	opts ...options.ReverseQueryOptionsOption,
 ) (iter datastore.RelationshipIterator, err error) {
-	qBuilder, err := common.NewSchemaQueryFilterer(schema, r.filterer(queryTuples)).
+	qBuilder, err := common.NewSchemaQueryFilterer(schema, r.filterer(queryTuples), r.revisionTime).
 		FilterWithSubjectsSelectors(subjectsFilter.AsSelector())
 	if err != nil {
 		return nil, err
@@ -15,6 +15,7 @@ import (
 	"github.com/jzelinskie/stringz"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/authzed/spicedb/internal/datastore/common"
 	pgxcommon "github.com/authzed/spicedb/internal/datastore/postgres/common"
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
@@ -47,6 +48,7 @@ var (
 		colUsersetRelation,
 		colCaveatContextName,
 		colCaveatContext,
+		colExpiration,
 	)
 
 	deleteTuple     = psql.Update(tableTuple).Where(sq.Eq{colDeletedXid: liveDeletedTxnID})
@@ -83,6 +85,7 @@ func appendForInsertion(builder sq.InsertBuilder, tpl *core.RelationTuple) sq.In
 		tpl.Subject.Relation,
 		caveatName,
 		caveatContext, // PGX driver serializes map[string]any to JSONB type columns
+		common.ExpirationFrom(tpl),
 	}
 
 	return builder.Values(valuesToWrite...)
@@ -95,6 +98,7 @@ func (rwt *pgReadWriteTXN) WriteRelationships(ctx context.Context, mutations []*
 	createInserts := writeTuple
 	touchInserts := writeTuple
 	deleteClauses := sq.Or{}
+	createClauses := sq.Or{}
 
 	// Parse the updates, building inserts for CREATE/TOUCH and deletes for DELETE.
 	for _, mut := range mutations {
@@ -103,6 +107,7 @@ func (rwt *pgReadWriteTXN) WriteRelationships(ctx context.Context, mutations []*
 		switch mut.Operation {
 		case core.RelationTupleUpdate_CREATE:
 			createInserts = appendForInsertion(createInserts, tpl)
+			createClauses = append(createClauses, exactRelationshipClause(tpl))
 			hasCreateInserts = true
 
 		case core.RelationTupleUpdate_TOUCH:
@@ -119,6 +124,13 @@ func (rwt *pgReadWriteTXN) WriteRelationships(ctx context.Context, mutations []*
 
 	// Run CREATE insertions, if any.
 	if hasCreateInserts {
+		// Expired relationships are no longer visible, so a CREATE over one must succeed. Mark
+		// any such rows as deleted before inserting, to avoid violating the uniqueness constraint.
+		// Watch does not report the deletion of rows which had already expired.
+		if err := rwt.deleteExpiredForCreate(ctx, createClauses); err != nil {
+			return err
+		}
+
 		sql, args, err := createInserts.ToSql()
 		if err != nil {
 			return fmt.Errorf(errUnableToWriteRelationships, err)
@@ -278,6 +290,23 @@ func (rwt *pgReadWriteTXN) WriteRelationships(ctx context.Context, mutations []*
 	return nil
 }
 
+func (rwt *pgReadWriteTXN) deleteExpiredForCreate(ctx context.Context, createClauses sq.Or) error {
+	sql, args, err := deleteTuple.
+		Where(createClauses).
+		Where(sq.Expr(colExpiration+" <= NOW()")).
+		Set(colDeletedXid, rwt.newXID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf(errUnableToWriteRelationships, err)
+	}
+
+	if _, err := rwt.tx.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf(errUnableToWriteRelationships, err)
+	}
+
+	return nil
+}
+
 func (rwt *pgReadWriteTXN) DeleteRelationships(ctx context.Context, filter *v1.RelationshipFilter, opts ...options.DeleteOptionsOption) (bool, error) {
 	delOpts := options.NewDeleteOptionsWithOptionsAndDefaults(opts...)
 	if delOpts.DeleteLimit != nil && *delOpts.DeleteLimit > 0 {
@@ -497,6 +526,7 @@ var copyCols = []string{
 	colUsersetRelation,
 	colCaveatContextName,
 	colCaveatContext,
+	colExpiration,
 }
 
 func (rwt *pgReadWriteTXN) BulkLoad(ctx context.Context, iter datastore.BulkWriteRelationshipSource) (uint64, error) {
@@ -538,6 +568,7 @@ func exactRelationshipDifferentCaveatClause(r *core.RelationTuple) sq.And {
 			sq.NotEq{
 				colCaveatContext: caveatContext,
 			},
+			sq.Expr(colExpiration+" IS DISTINCT FROM ?", common.ExpirationFrom(r)),
 		},
 	}
 }
@@ -44,10 +44,16 @@ var (
 		colUsersetRelation,
 		colCaveatContextName,
 		colCaveatContext,
+		colExpiration,
 		colCreatedXid,
 		colDeletedXid,
 	).From(tableTuple)
 
+	queryTransactionMetadata = psql.Select(
+		colXID,
+		colMetadata,
+	).From(tableTransaction)
+
 	queryChangedNamespaces = psql.Select(
 		colConfig,
 		colCreatedXid,
@@ -117,6 +123,15 @@ func (pgd *pgDatastore) Watch(
 		defer close(updates)
 		defer close(errs)
 
+		if err := common.CheckWatchStartRevision(ctx, pgd, afterRevision); err != nil {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				errs <- datastore.NewWatchCanceledErr()
+			} else {
+				errs <- err
+			}
+			return
+		}
+
 		currentTxn := afterRevision
 
 		for {
@@ -245,6 +260,11 @@ func (pgd *pgDatastore) loadChanges(ctx context.Context, revisions []revisionWit
 		if err != nil {
 			return nil, err
 		}
+
+		err = pgd.loadTransactionMetadata(ctx, xmin, xmax, txidToRevision, filter, tracked)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Load namespace changes.
@@ -271,7 +291,15 @@ func (pgd *pgDatastore) loadChanges(ctx context.Context, revisions []revisionWit
 }
 
 func (pgd *pgDatastore) loadRelationshipChanges(ctx context.Context, xmin uint64, xmax uint64, txidToRevision map[uint64]revisionWithXid, filter map[uint64]int, tracked *common.Changes[revisionWithXid, uint64]) error {
-	sql, args, err := queryChangedTuples.Where(sq.Or{
+	// Relationships replaced by a CREATE after they expired are marked as deleted by the
+	// replacing transaction, but they were no longer visible at that point, so their deletion
+	// is not a change. The cast to xid is safe for the same reason as in newRevisionsQuery.
+	expiredWhenDeleted := sq.Expr(fmt.Sprintf(
+		"CASE WHEN %[1]s >= ? AND %[1]s <= ? THEN COALESCE(%[2]s <= pg_xact_commit_timestamp(%[1]s::xid), false) ELSE false END",
+		colDeletedXid, colExpiration,
+	), xmin, xmax)
+
+	sql, args, err := queryChangedTuples.Column(expiredWhenDeleted).Where(sq.Or{
 		sq.And{
 			sq.LtOrEq{colCreatedXid: xmax},
 			sq.GtOrEq{colCreatedXid: xmin},
@@ -301,6 +329,8 @@ func (pgd *pgDatastore) loadRelationshipChanges(ctx context.Context, xmin uint64
 		var createdXID, deletedXID xid8
 		var caveatName *string
 		var caveatContext map[string]any
+		var expiration *time.Time
+		var wasExpired bool
 		if err := changes.Scan(
 			&nextTuple.ResourceAndRelation.Namespace,
 			&nextTuple.ResourceAndRelation.ObjectId,
@@ -310,8 +340,10 @@ func (pgd *pgDatastore) loadRelationshipChanges(ctx context.Context, xmin uint64
 			&nextTuple.Subject.Relation,
 			&caveatName,
 			&caveatContext,
+			&expiration,
 			&createdXID,
 			&deletedXID,
+			&wasExpired,
 		); err != nil {
 			return fmt.Errorf("unable to parse changed tuple: %w", err)
 		}
@@ -327,12 +359,14 @@ func (pgd *pgDatastore) loadRelationshipChanges(ctx context.Context, xmin uint64
 			}
 		}
 
+		nextTuple.OptionalExpirationTime = common.ExpirationTimestampFrom(expiration)
+
 		if _, found := filter[createdXID.Uint64]; found {
 			if err := tracked.AddRelationshipChange(ctx, txidToRevision[createdXID.Uint64], nextTuple, core.RelationTupleUpdate_TOUCH); err != nil {
 				return err
 			}
 		}
-		if _, found := filter[deletedXID.Uint64]; found {
+		if _, found := filter[deletedXID.Uint64]; found && !wasExpired {
 			if err := tracked.AddRelationshipChange(ctx, txidToRevision[deletedXID.Uint64], nextTuple, core.RelationTupleUpdate_DELETE); err != nil {
 				return err
 			}
@@ -344,6 +378,42 @@ func (pgd *pgDatastore) loadRelationshipChanges(ctx context.Context, xmin uint64
 	return nil
 }
 
+func (pgd *pgDatastore) loadTransactionMetadata(ctx context.Context, xmin uint64, xmax uint64, txidToRevision map[uint64]revisionWithXid, filter map[uint64]int, tracked *common.Changes[revisionWithXid, uint64]) error {
+	sql, args, err := queryTransactionMetadata.Where(sq.And{
+		sq.LtOrEq{colXID: xmax},
+		sq.GtOrEq{colXID: xmin},
+		sq.NotEq{colMetadata: nil},
+	}).ToSql()
+	if err != nil {
+		return fmt.Errorf("unable to prepare transaction metadata SQL: %w", err)
+	}
+
+	rows, err := pgd.readPool.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("unable to load transaction metadata: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var xid xid8
+		var metadata map[string]any
+		if err := rows.Scan(&xid, &metadata); err != nil {
+			return fmt.Errorf("unable to parse transaction metadata: %w", err)
+		}
+
+		if _, found := filter[xid.Uint64]; found {
+			if err := tracked.SetRevisionMetadata(ctx, txidToRevision[xid.Uint64], metadata); err != nil {
+				return err
+			}
+		}
+	}
+	if rows.Err() != nil {
+		return fmt.Errorf("unable to load transaction metadata: %w", rows.Err())
+	}
+	return nil
+}
+
 func (pgd *pgDatastore) loadNamespaceChanges(ctx context.Context, xmin uint64, xmax uint64, txidToRevision map[uint64]revisionWithXid, filter map[uint64]int, tracked *common.Changes[revisionWithXid, uint64]) error {
 	sql, args, err := queryChangedNamespaces.Where(sq.Or{
 		sq.And{
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"context"
+	"time"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/prometheus/client_golang/prometheus"
@@ -315,7 +316,22 @@ func (rwt *observableRWT) BulkLoad(ctx context.Context, iter datastore.BulkWrite
 	return rwt.delegate.BulkLoad(ctx, iter)
 }
 
+// LatencyObserver is invoked with the latency of the datastore operations made with a
+// context it was added to.
+type LatencyObserver func(latency time.Duration)
+
+type latencyObserverKey struct{}
+
+// ContextWithLatencyObserver returns a context which reports the latency of the datastore
+// operations made with it, through an observable proxy, to the given observer. Any observer
+// previously added to the context is replaced.
+func ContextWithLatencyObserver(ctx context.Context, observer LatencyObserver) context.Context {
+	return context.WithValue(ctx, latencyObserverKey{}, observer)
+}
+
 func observe(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, func()) {
+	observer, _ := ctx.Value(latencyObserverKey{}).(LatencyObserver)
+
 	ctx, span := tracer.Start(ctx, name, opts...)
 	timer := prometheus.NewTimer(queryLatency.WithLabelValues(name))
 	closed := false
@@ -326,7 +342,10 @@ func observe(ctx context.Context, name string, opts ...trace.SpanStartOption) (c
 		}
 
 		closed = true
-		timer.ObserveDuration()
+		latency := timer.ObserveDuration()
+		if observer != nil {
+			observer(latency)
+		}
 		span.End()
 	}
 }
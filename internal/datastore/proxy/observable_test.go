@@ -1,9 +1,12 @@
 package proxy
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/authzed/spicedb/internal/datastore/memdb"
 	"github.com/authzed/spicedb/pkg/datastore"
 	"github.com/authzed/spicedb/pkg/datastore/test"
@@ -26,3 +29,29 @@ func TestObservableProxy(t *testing.T) {
 func (p *observableProxy) ExampleRetryableError() error {
 	return memdb.ErrSerialization
 }
+
+func TestObservableProxyLatencyObserver(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+	ds := NewObservableDatastoreProxy(db)
+
+	var observed []time.Duration
+	ctx := ContextWithLatencyObserver(context.Background(), func(latency time.Duration) {
+		observed = append(observed, latency)
+	})
+
+	rev, err := ds.HeadRevision(ctx)
+	require.NoError(t, err)
+	require.Len(t, observed, 1)
+
+	iter, err := ds.SnapshotReader(rev).QueryRelationships(ctx, datastore.RelationshipsFilter{OptionalResourceType: "document"})
+	require.NoError(t, err)
+	require.Len(t, observed, 1)
+
+	iter.Close()
+	require.Len(t, observed, 2)
+
+	_, err = ds.HeadRevision(context.Background())
+	require.NoError(t, err)
+	require.Len(t, observed, 2)
+}
@@ -0,0 +1,659 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/datastore/options"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/spiceerrors"
+)
+
+// maxScopedNameBytes is the maximum length of the names of definitions in the datastore.
+const maxScopedNameBytes = 128
+
+// TenantScopedName returns the name under which the definition of a tenant with the given name
+// is stored in the datastore underlying a tenant proxy. The tenant ID is added as one more
+// prefix, so definitions whose names already have a prefix, such as `prefix/document`, are
+// stored as `tenant/prefix/document`.
+func TenantScopedName(tenant string, name string) string {
+	return tenant + "/" + name
+}
+
+// NewTenantDatastoreProxy creates a proxy which scopes the definitions and relationships read and
+// written through it to a single tenant. Definitions are stored in the delegate datastore under
+// names prefixed with the ID of the tenant, as are the object types and caveat names of
+// relationships; the proxy adds the prefix to everything written and strips it from everything
+// read, and hides anything stored outside of the tenant, so that each tenant sees and manages
+// its own schema and relationships as though it had its own datastore.
+//
+// As the names of definitions in the datastore are limited to 128 bytes, the names of the
+// definitions of a tenant are limited to 128 bytes less the length of the tenant ID and its `/`;
+// writing a longer definition fails with INVALID_ARGUMENT.
+func NewTenantDatastoreProxy(d datastore.Datastore, tenant string) datastore.Datastore {
+	return &tenantProxy{Datastore: d, scope: tenantScope{prefix: TenantScopedName(tenant, "")}}
+}
+
+// UnscopedDatastore returns the datastore underlying the tenant proxy, if the datastore is one,
+// or else the datastore itself.
+func UnscopedDatastore(ds datastore.Datastore) datastore.Datastore {
+	if p, ok := ds.(*tenantProxy); ok {
+		return p.Datastore
+	}
+	return ds
+}
+
+type tenantProxy struct {
+	datastore.Datastore
+	scope tenantScope
+}
+
+func (p *tenantProxy) SnapshotReader(rev datastore.Revision) datastore.Reader {
+	return &tenantReader{p.scope, p.Datastore.SnapshotReader(rev)}
+}
+
+func (p *tenantProxy) ReadWriteTx(
+	ctx context.Context,
+	f datastore.TxUserFunc,
+	opts ...options.RWTOptionsOption,
+) (datastore.Revision, error) {
+	revision, err := p.Datastore.ReadWriteTx(ctx, func(ctx context.Context, delegateRWT datastore.ReadWriteTransaction) error {
+		return f(ctx, &tenantRWT{&tenantReader{p.scope, delegateRWT}, delegateRWT})
+	}, opts...)
+	return revision, p.scope.unscopedError(err)
+}
+
+func (p *tenantProxy) Watch(ctx context.Context, afterRevision datastore.Revision, options datastore.WatchOptions) (<-chan *datastore.RevisionChanges, <-chan error) {
+	delegateChanges, errs := p.Datastore.Watch(ctx, afterRevision, options)
+	changes := make(chan *datastore.RevisionChanges, cap(delegateChanges))
+
+	go func() {
+		defer close(changes)
+
+		for {
+			select {
+			case change, ok := <-delegateChanges:
+				if !ok {
+					return
+				}
+
+				unscoped, ok := p.scope.unscopedChanges(change)
+				if !ok {
+					continue
+				}
+
+				select {
+				case changes <- unscoped:
+				case <-ctx.Done():
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes, errs
+}
+
+// Statistics returns the statistics of the delegate datastore, with the object type statistics
+// of the tenant.
+//
+// Datastores only estimate the number of relationships across all tenants, so that of the tenant
+// is estimated as the share of it in proportion to the relations defined by the tenant, out of
+// all those defined in the datastore.
+func (p *tenantProxy) Statistics(ctx context.Context) (datastore.Stats, error) {
+	stats, err := p.Datastore.Statistics(ctx)
+	if err != nil {
+		return datastore.Stats{}, err
+	}
+
+	headRevision, err := p.HeadRevision(ctx)
+	if err != nil {
+		return datastore.Stats{}, err
+	}
+
+	namespaces, err := p.SnapshotReader(headRevision).ListAllNamespaces(ctx)
+	if err != nil {
+		return datastore.Stats{}, err
+	}
+
+	tenantStats := datastore.ComputeObjectTypeStats(namespaces)
+	tenantRelations, allRelations := relationCount(tenantStats), relationCount(stats.ObjectTypeStatistics)
+	if allRelations == 0 {
+		stats.EstimatedRelationshipCount = 0
+	} else {
+		stats.EstimatedRelationshipCount = uint64(float64(stats.EstimatedRelationshipCount) * float64(tenantRelations) / float64(allRelations))
+	}
+
+	stats.ObjectTypeStatistics = tenantStats
+	return stats, nil
+}
+
+func relationCount(objectTypeStats []datastore.ObjectTypeStat) uint64 {
+	var count uint64
+	for _, stat := range objectTypeStats {
+		count += uint64(stat.NumRelations)
+	}
+	return count
+}
+
+func (p *tenantProxy) Unwrap() datastore.Datastore {
+	return p.Datastore
+}
+
+type tenantReader struct {
+	scope    tenantScope
+	delegate datastore.Reader
+}
+
+func (r *tenantReader) ReadCaveatByName(ctx context.Context, name string) (*core.CaveatDefinition, datastore.Revision, error) {
+	caveat, lastWritten, err := r.delegate.ReadCaveatByName(ctx, r.scope.scopedName(name))
+	if err != nil {
+		return nil, lastWritten, r.scope.unscopedError(err)
+	}
+	return r.scope.unscopedCaveat(caveat), lastWritten, nil
+}
+
+func (r *tenantReader) LookupCaveatsWithNames(ctx context.Context, caveatNames []string) ([]datastore.RevisionedCaveat, error) {
+	caveats, err := r.delegate.LookupCaveatsWithNames(ctx, r.scope.scopedNames(caveatNames))
+	if err != nil {
+		return nil, r.scope.unscopedError(err)
+	}
+	return r.scope.unscopedCaveats(caveats), nil
+}
+
+func (r *tenantReader) ListAllCaveats(ctx context.Context) ([]datastore.RevisionedCaveat, error) {
+	caveats, err := r.delegate.ListAllCaveats(ctx)
+	if err != nil {
+		return nil, r.scope.unscopedError(err)
+	}
+	return r.scope.unscopedCaveats(caveats), nil
+}
+
+func (r *tenantReader) ReadNamespaceByName(ctx context.Context, nsName string) (*core.NamespaceDefinition, datastore.Revision, error) {
+	ns, lastWritten, err := r.delegate.ReadNamespaceByName(ctx, r.scope.scopedName(nsName))
+	if err != nil {
+		return nil, lastWritten, r.scope.unscopedError(err)
+	}
+	return r.scope.unscopedNamespace(ns), lastWritten, nil
+}
+
+func (r *tenantReader) LookupNamespacesWithNames(ctx context.Context, nsNames []string) ([]datastore.RevisionedNamespace, error) {
+	namespaces, err := r.delegate.LookupNamespacesWithNames(ctx, r.scope.scopedNames(nsNames))
+	if err != nil {
+		return nil, r.scope.unscopedError(err)
+	}
+	return r.scope.unscopedNamespaces(namespaces), nil
+}
+
+func (r *tenantReader) ListAllNamespaces(ctx context.Context) ([]datastore.RevisionedNamespace, error) {
+	namespaces, err := r.delegate.ListAllNamespaces(ctx)
+	if err != nil {
+		return nil, r.scope.unscopedError(err)
+	}
+	return r.scope.unscopedNamespaces(namespaces), nil
+}
+
+// QueryRelationships reads the relationships of the tenant. A filter without a resource type
+// may match the relationships of other tenants in the delegate datastore; these are skipped.
+func (r *tenantReader) QueryRelationships(ctx context.Context, filter datastore.RelationshipsFilter, opts ...options.QueryOptionsOption) (datastore.RelationshipIterator, error) {
+	filter.OptionalResourceType = r.scope.scopedName(filter.OptionalResourceType)
+	filter.OptionalCaveatName = r.scope.scopedName(filter.OptionalCaveatName)
+	filter.OptionalSubjectsSelectors = r.scope.scopedSubjectsSelectors(filter.OptionalSubjectsSelectors)
+
+	queryOpts := options.NewQueryOptionsWithOptions(opts...)
+	queryOpts.After = r.scope.scopedTuple(queryOpts.After)
+
+	iterator, err := r.delegate.QueryRelationships(ctx, filter, queryOpts.ToOption())
+	if err != nil {
+		return nil, r.scope.unscopedError(err)
+	}
+	return &tenantRelationshipIterator{scope: r.scope, delegate: iterator}, nil
+}
+
+func (r *tenantReader) ReverseQueryRelationships(ctx context.Context, subjectsFilter datastore.SubjectsFilter, opts ...options.ReverseQueryOptionsOption) (datastore.RelationshipIterator, error) {
+	subjectsFilter.SubjectType = r.scope.scopedName(subjectsFilter.SubjectType)
+
+	queryOpts := options.NewReverseQueryOptionsWithOptions(opts...)
+	queryOpts.AfterForReverse = r.scope.scopedTuple(queryOpts.AfterForReverse)
+	if queryOpts.ResRelation != nil {
+		queryOpts.ResRelation = &options.ResourceRelation{
+			Namespace: r.scope.scopedName(queryOpts.ResRelation.Namespace),
+			Relation:  queryOpts.ResRelation.Relation,
+		}
+	}
+
+	iterator, err := r.delegate.ReverseQueryRelationships(ctx, subjectsFilter, queryOpts.ToOption())
+	if err != nil {
+		return nil, r.scope.unscopedError(err)
+	}
+	return &tenantRelationshipIterator{scope: r.scope, delegate: iterator}, nil
+}
+
+type tenantRelationshipIterator struct {
+	scope    tenantScope
+	delegate datastore.RelationshipIterator
+	last     *core.RelationTuple
+}
+
+func (i *tenantRelationshipIterator) Next() *core.RelationTuple {
+	for next := i.delegate.Next(); next != nil; next = i.delegate.Next() {
+		if unscoped, ok := i.scope.unscopedTuple(next); ok {
+			i.last = unscoped
+			return unscoped
+		}
+	}
+	return nil
+}
+
+func (i *tenantRelationshipIterator) Err() error { return i.scope.unscopedError(i.delegate.Err()) }
+
+// Cursor returns the last relationship of the tenant returned by the iterator. Resuming from it
+// may read again the relationships of other tenants which followed it, which are skipped again.
+func (i *tenantRelationshipIterator) Cursor() (options.Cursor, error) {
+	if _, err := i.delegate.Cursor(); err != nil {
+		return nil, err
+	}
+
+	if i.last == nil {
+		return nil, datastore.ErrCursorEmpty
+	}
+	return i.last, nil
+}
+
+func (i *tenantRelationshipIterator) Close() { i.delegate.Close() }
+
+type tenantRWT struct {
+	*tenantReader
+	delegate datastore.ReadWriteTransaction
+}
+
+func (rwt *tenantRWT) WriteCaveats(ctx context.Context, caveats []*core.CaveatDefinition) error {
+	scoped := make([]*core.CaveatDefinition, 0, len(caveats))
+	for _, caveat := range caveats {
+		if err := rwt.scope.validateName(caveat.Name); err != nil {
+			return err
+		}
+		scoped = append(scoped, rwt.scope.scopedCaveat(caveat))
+	}
+	return rwt.scope.unscopedError(rwt.delegate.WriteCaveats(ctx, scoped))
+}
+
+func (rwt *tenantRWT) DeleteCaveats(ctx context.Context, names []string) error {
+	return rwt.scope.unscopedError(rwt.delegate.DeleteCaveats(ctx, rwt.scope.scopedNames(names)))
+}
+
+func (rwt *tenantRWT) WriteRelationships(ctx context.Context, mutations []*core.RelationTupleUpdate) error {
+	scoped := make([]*core.RelationTupleUpdate, 0, len(mutations))
+	for _, mutation := range mutations {
+		scoped = append(scoped, &core.RelationTupleUpdate{
+			Operation: mutation.Operation,
+			Tuple:     rwt.scope.scopedTuple(mutation.Tuple),
+		})
+	}
+	return rwt.scope.unscopedError(rwt.delegate.WriteRelationships(ctx, scoped))
+}
+
+func (rwt *tenantRWT) WriteNamespaces(ctx context.Context, newConfigs ...*core.NamespaceDefinition) error {
+	scoped := make([]*core.NamespaceDefinition, 0, len(newConfigs))
+	for _, ns := range newConfigs {
+		if err := rwt.scope.validateName(ns.Name); err != nil {
+			return err
+		}
+		scoped = append(scoped, rwt.scope.scopedNamespace(ns))
+	}
+	return rwt.scope.unscopedError(rwt.delegate.WriteNamespaces(ctx, scoped...))
+}
+
+func (rwt *tenantRWT) DeleteNamespaces(ctx context.Context, nsNames ...string) error {
+	return rwt.scope.unscopedError(rwt.delegate.DeleteNamespaces(ctx, rwt.scope.scopedNames(nsNames)...))
+}
+
+// DeleteRelationships deletes the relationships of the tenant matching the filter. A filter
+// without a resource type is applied to each of the object types of the tenant in turn.
+func (rwt *tenantRWT) DeleteRelationships(ctx context.Context, filter *v1.RelationshipFilter, opts ...options.DeleteOptionsOption) (bool, error) {
+	if filter.ResourceType != "" {
+		limitReached, err := rwt.delegate.DeleteRelationships(ctx, rwt.scope.scopedFilter(filter, filter.ResourceType), opts...)
+		return limitReached, rwt.scope.unscopedError(err)
+	}
+
+	namespaces, err := rwt.ListAllNamespaces(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	limit := options.NewDeleteOptionsWithOptions(opts...).DeleteLimit
+	if limit == nil || *limit == 0 {
+		for _, ns := range namespaces {
+			if _, err := rwt.delegate.DeleteRelationships(ctx, rwt.scope.scopedFilter(filter, ns.Definition.Name)); err != nil {
+				return false, rwt.scope.unscopedError(err)
+			}
+		}
+		return false, nil
+	}
+
+	// The limit applies to all the object types, so the relationships matched for each type
+	// are counted before being deleted.
+	remaining := *limit
+	for _, ns := range namespaces {
+		typeFilter := filter.CloneVT()
+		typeFilter.ResourceType = ns.Definition.Name
+
+		count, err := rwt.countRelationships(ctx, typeFilter, remaining)
+		if err != nil {
+			return false, err
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		if _, err := rwt.delegate.DeleteRelationships(ctx, rwt.scope.scopedFilter(typeFilter, typeFilter.ResourceType), options.WithDeleteLimit(&count)); err != nil {
+			return false, rwt.scope.unscopedError(err)
+		}
+
+		remaining -= count
+		if remaining == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// countRelationships counts the relationships of the tenant matching the filter, up to the limit.
+func (rwt *tenantRWT) countRelationships(ctx context.Context, filter *v1.RelationshipFilter, limit uint64) (uint64, error) {
+	relationshipsFilter, err := datastore.RelationshipsFilterFromPublicFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	iter, err := rwt.QueryRelationships(ctx, relationshipsFilter, options.WithLimit(&limit))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for tpl := iter.Next(); tpl != nil; tpl = iter.Next() {
+		count++
+	}
+	return count, iter.Err()
+}
+
+func (rwt *tenantRWT) BulkLoad(ctx context.Context, iter datastore.BulkWriteRelationshipSource) (uint64, error) {
+	loaded, err := rwt.delegate.BulkLoad(ctx, &tenantBulkSource{rwt.scope, iter})
+	return loaded, rwt.scope.unscopedError(err)
+}
+
+type tenantBulkSource struct {
+	scope    tenantScope
+	delegate datastore.BulkWriteRelationshipSource
+}
+
+func (s *tenantBulkSource) Next(ctx context.Context) (*core.RelationTuple, error) {
+	next, err := s.delegate.Next(ctx)
+	if next == nil || err != nil {
+		return next, err
+	}
+	return s.scope.scopedTuple(next), nil
+}
+
+// tenantScope converts definitions and relationships between their names within a tenant and
+// their names in the underlying datastore. Definitions and relationships are always copied
+// before being converted, as they may be shared with caches.
+type tenantScope struct {
+	prefix string
+}
+
+func (s tenantScope) scopedName(name string) string {
+	if name == "" {
+		return ""
+	}
+	return s.prefix + name
+}
+
+// validateName returns an INVALID_ARGUMENT error if the definition with the given name cannot be
+// stored under its scoped name.
+func (s tenantScope) validateName(name string) error {
+	if len(s.scopedName(name)) > maxScopedNameBytes {
+		return spiceerrors.WithCodeAndDetailsAsError(
+			fmt.Errorf("definition `%s` is too long to be stored for tenant `%s`: in multi-tenant mode, the tenant ID, a `/` and the name of each definition may not exceed %d bytes together", name, strings.TrimSuffix(s.prefix, "/"), maxScopedNameBytes),
+			codes.InvalidArgument,
+		)
+	}
+	return nil
+}
+
+func (s tenantScope) unscopedName(name string) (string, bool) {
+	return strings.CutPrefix(name, s.prefix)
+}
+
+func (s tenantScope) scopedNames(names []string) []string {
+	scoped := make([]string, 0, len(names))
+	for _, name := range names {
+		scoped = append(scoped, s.scopedName(name))
+	}
+	return scoped
+}
+
+func (s tenantScope) scopedNamespace(ns *core.NamespaceDefinition) *core.NamespaceDefinition {
+	return s.convertNamespace(ns, func(name string) string { return s.scopedName(name) })
+}
+
+func (s tenantScope) unscopedNamespace(ns *core.NamespaceDefinition) *core.NamespaceDefinition {
+	return s.convertNamespace(ns, func(name string) string {
+		unscoped, _ := s.unscopedName(name)
+		return unscoped
+	})
+}
+
+func (s tenantScope) convertNamespace(ns *core.NamespaceDefinition, convert func(string) string) *core.NamespaceDefinition {
+	converted := ns.CloneVT()
+	converted.Name = convert(converted.Name)
+	for _, relation := range converted.Relation {
+		for _, allowed := range relation.GetTypeInformation().GetAllowedDirectRelations() {
+			allowed.Namespace = convert(allowed.Namespace)
+			if allowed.RequiredCaveat != nil {
+				allowed.RequiredCaveat.CaveatName = convert(allowed.RequiredCaveat.CaveatName)
+			}
+		}
+	}
+	return converted
+}
+
+func (s tenantScope) unscopedNamespaces(namespaces []datastore.RevisionedNamespace) []datastore.RevisionedNamespace {
+	unscoped := make([]datastore.RevisionedNamespace, 0, len(namespaces))
+	for _, ns := range namespaces {
+		if _, ok := s.unscopedName(ns.Definition.Name); !ok {
+			continue
+		}
+
+		unscoped = append(unscoped, datastore.RevisionedNamespace{
+			Definition:          s.unscopedNamespace(ns.Definition),
+			LastWrittenRevision: ns.LastWrittenRevision,
+		})
+	}
+	return unscoped
+}
+
+func (s tenantScope) scopedCaveat(caveat *core.CaveatDefinition) *core.CaveatDefinition {
+	scoped := caveat.CloneVT()
+	scoped.Name = s.scopedName(scoped.Name)
+	return scoped
+}
+
+func (s tenantScope) unscopedCaveat(caveat *core.CaveatDefinition) *core.CaveatDefinition {
+	unscoped := caveat.CloneVT()
+	unscoped.Name, _ = s.unscopedName(unscoped.Name)
+	return unscoped
+}
+
+func (s tenantScope) unscopedCaveats(caveats []datastore.RevisionedCaveat) []datastore.RevisionedCaveat {
+	unscoped := make([]datastore.RevisionedCaveat, 0, len(caveats))
+	for _, caveat := range caveats {
+		if _, ok := s.unscopedName(caveat.Definition.Name); !ok {
+			continue
+		}
+
+		unscoped = append(unscoped, datastore.RevisionedCaveat{
+			Definition:          s.unscopedCaveat(caveat.Definition),
+			LastWrittenRevision: caveat.LastWrittenRevision,
+		})
+	}
+	return unscoped
+}
+
+// scopedTuple returns a copy of the relationship of the tenant as stored in the datastore. A nil
+// relationship is returned as is, to allow converting optional cursors.
+func (s tenantScope) scopedTuple(tpl *core.RelationTuple) *core.RelationTuple {
+	if tpl == nil {
+		return nil
+	}
+
+	scoped := tpl.CloneVT()
+	scoped.ResourceAndRelation.Namespace = s.scopedName(scoped.ResourceAndRelation.Namespace)
+	scoped.Subject.Namespace = s.scopedName(scoped.Subject.Namespace)
+	if scoped.Caveat != nil {
+		scoped.Caveat.CaveatName = s.scopedName(scoped.Caveat.CaveatName)
+	}
+	return scoped
+}
+
+// unscopedTuple returns a copy of the relationship stored in the datastore as seen by the tenant,
+// or false if the relationship belongs to another tenant.
+func (s tenantScope) unscopedTuple(tpl *core.RelationTuple) (*core.RelationTuple, bool) {
+	resourceType, ok := s.unscopedName(tpl.ResourceAndRelation.Namespace)
+	if !ok {
+		return nil, false
+	}
+
+	unscoped := tpl.CloneVT()
+	unscoped.ResourceAndRelation.Namespace = resourceType
+	unscoped.Subject.Namespace, _ = s.unscopedName(unscoped.Subject.Namespace)
+	if unscoped.Caveat != nil {
+		unscoped.Caveat.CaveatName, _ = s.unscopedName(unscoped.Caveat.CaveatName)
+	}
+	return unscoped, true
+}
+
+func (s tenantScope) scopedSubjectsSelectors(selectors []datastore.SubjectsSelector) []datastore.SubjectsSelector {
+	if selectors == nil {
+		return nil
+	}
+
+	scoped := make([]datastore.SubjectsSelector, 0, len(selectors))
+	for _, selector := range selectors {
+		selector.OptionalSubjectType = s.scopedName(selector.OptionalSubjectType)
+		scoped = append(scoped, selector)
+	}
+	return scoped
+}
+
+func (s tenantScope) scopedFilter(filter *v1.RelationshipFilter, resourceType string) *v1.RelationshipFilter {
+	scoped := filter.CloneVT()
+	scoped.ResourceType = s.scopedName(resourceType)
+	if scoped.OptionalSubjectFilter != nil {
+		scoped.OptionalSubjectFilter.SubjectType = s.scopedName(scoped.OptionalSubjectFilter.SubjectType)
+	}
+	return scoped
+}
+
+// unscopedChanges returns the changes of the tenant in the changes made to the datastore, or
+// false if there are none and the changes are not a checkpoint.
+func (s tenantScope) unscopedChanges(changes *datastore.RevisionChanges) (*datastore.RevisionChanges, bool) {
+	unscoped := &datastore.RevisionChanges{
+		Revision:     changes.Revision,
+		IsCheckpoint: changes.IsCheckpoint,
+	}
+
+	for _, update := range changes.RelationshipChanges {
+		if tpl, ok := s.unscopedTuple(update.Tuple); ok {
+			unscoped.RelationshipChanges = append(unscoped.RelationshipChanges, &core.RelationTupleUpdate{
+				Operation: update.Operation,
+				Tuple:     tpl,
+			})
+		}
+	}
+
+	// Transactions are made by a single tenant, so the metadata of a transaction which changed
+	// relationships of the tenant is its own.
+	if len(unscoped.RelationshipChanges) > 0 {
+		unscoped.Metadata = changes.Metadata
+	}
+
+	for _, definition := range changes.ChangedDefinitions {
+		if _, ok := s.unscopedName(definition.GetName()); !ok {
+			continue
+		}
+
+		switch t := definition.(type) {
+		case *core.NamespaceDefinition:
+			unscoped.ChangedDefinitions = append(unscoped.ChangedDefinitions, s.unscopedNamespace(t))
+		case *core.CaveatDefinition:
+			unscoped.ChangedDefinitions = append(unscoped.ChangedDefinitions, s.unscopedCaveat(t))
+		}
+	}
+
+	for _, name := range changes.DeletedNamespaces {
+		if unscopedName, ok := s.unscopedName(name); ok {
+			unscoped.DeletedNamespaces = append(unscoped.DeletedNamespaces, unscopedName)
+		}
+	}
+
+	for _, name := range changes.DeletedCaveats {
+		if unscopedName, ok := s.unscopedName(name); ok {
+			unscoped.DeletedCaveats = append(unscoped.DeletedCaveats, unscopedName)
+		}
+	}
+
+	hasChanges := len(unscoped.RelationshipChanges) > 0 || len(unscoped.ChangedDefinitions) > 0 ||
+		len(unscoped.DeletedNamespaces) > 0 || len(unscoped.DeletedCaveats) > 0
+	return unscoped, hasChanges || unscoped.IsCheckpoint
+}
+
+// unscopedError converts the errors of the datastore which name definitions or relationships
+// to the names seen by the tenant.
+func (s tenantScope) unscopedError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var nsNotFound datastore.ErrNamespaceNotFound
+	if errors.As(err, &nsNotFound) {
+		if name, ok := s.unscopedName(nsNotFound.NotFoundNamespaceName()); ok {
+			return datastore.NewNamespaceNotFoundErr(name)
+		}
+	}
+
+	var caveatNotFound datastore.ErrCaveatNameNotFound
+	if errors.As(err, &caveatNotFound) {
+		if name, ok := s.unscopedName(caveatNotFound.CaveatName()); ok {
+			return datastore.NewCaveatNameNotFoundErr(name)
+		}
+	}
+
+	var relationshipExists common.CreateRelationshipExistsError
+	if errors.As(err, &relationshipExists) && relationshipExists.Relationship != nil {
+		if tpl, ok := s.unscopedTuple(relationshipExists.Relationship); ok {
+			return common.NewCreateRelationshipExistsError(tpl)
+		}
+	}
+
+	return err
+}
+
+var (
+	_ datastore.Datastore            = (*tenantProxy)(nil)
+	_ datastore.Reader               = (*tenantReader)(nil)
+	_ datastore.ReadWriteTransaction = (*tenantRWT)(nil)
+	_ datastore.RelationshipIterator = (*tenantRelationshipIterator)(nil)
+)
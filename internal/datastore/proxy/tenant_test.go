@@ -0,0 +1,281 @@
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/pkg/caveats"
+	"github.com/authzed/spicedb/pkg/caveats/types"
+	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/datastore/test"
+	ns "github.com/authzed/spicedb/pkg/namespace"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+type tenantTest struct{}
+
+func (tt tenantTest) New(revisionQuantization, _, gcWindow time.Duration, watchBufferLength uint16) (datastore.Datastore, error) {
+	db, err := memdb.NewMemdbDatastore(watchBufferLength, revisionQuantization, gcWindow)
+	if err != nil {
+		return nil, err
+	}
+	return NewTenantDatastoreProxy(db, "sometenant"), nil
+}
+
+func TestTenantProxy(t *testing.T) {
+	test.All(t, tenantTest{})
+}
+
+func (p *tenantProxy) ExampleRetryableError() error {
+	return memdb.ErrSerialization
+}
+
+func writeTenantSchema(t *testing.T, ds datastore.Datastore, relationships ...string) datastore.Revision {
+	revision, err := ds.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		if err := rwt.WriteCaveats(ctx, []*core.CaveatDefinition{
+			ns.MustCaveatDefinition(caveats.MustEnvForVariables(map[string]types.VariableType{"allowed": types.BooleanType}), "somecaveat", "allowed"),
+		}); err != nil {
+			return err
+		}
+
+		if err := rwt.WriteNamespaces(ctx,
+			ns.Namespace("user"),
+			ns.Namespace("document",
+				ns.MustRelation("viewer", nil,
+					ns.AllowedRelation("user", "..."),
+					ns.AllowedRelationWithCaveat("user", "...", ns.AllowedCaveat("somecaveat")),
+				),
+			),
+		); err != nil {
+			return err
+		}
+
+		mutations := make([]*core.RelationTupleUpdate, 0, len(relationships))
+		for _, relationship := range relationships {
+			mutations = append(mutations, tuple.Create(tuple.MustParse(relationship)))
+		}
+		return rwt.WriteRelationships(ctx, mutations)
+	})
+	require.NoError(t, err)
+	return revision
+}
+
+func readTenantRelationships(t *testing.T, reader datastore.Reader) []string {
+	iter, err := reader.QueryRelationships(context.Background(), datastore.RelationshipsFilter{OptionalResourceType: "document"})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	var relationships []string
+	for tpl := iter.Next(); tpl != nil; tpl = iter.Next() {
+		relationships = append(relationships, tuple.MustString(tpl))
+	}
+	require.NoError(t, iter.Err())
+	return relationships
+}
+
+func TestTenantProxyIsolation(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	first := NewTenantDatastoreProxy(db, "first")
+	second := NewTenantDatastoreProxy(db, "second")
+
+	writeTenantSchema(t, first, "document:doc1#viewer@user:alice[somecaveat]")
+	revision := writeTenantSchema(t, second, "document:doc1#viewer@user:bob")
+
+	// Each tenant sees its own schema and relationships, under their unscoped names.
+	namespaces, err := first.SnapshotReader(revision).ListAllNamespaces(context.Background())
+	require.NoError(t, err)
+	require.Len(t, namespaces, 2)
+
+	document, _, err := first.SnapshotReader(revision).ReadNamespaceByName(context.Background(), "document")
+	require.NoError(t, err)
+	require.Equal(t, "document", document.Name)
+	require.Equal(t, "user", document.Relation[0].TypeInformation.AllowedDirectRelations[0].Namespace)
+	require.Equal(t, "somecaveat", document.Relation[0].TypeInformation.AllowedDirectRelations[1].RequiredCaveat.CaveatName)
+
+	caveat, _, err := second.SnapshotReader(revision).ReadCaveatByName(context.Background(), "somecaveat")
+	require.NoError(t, err)
+	require.Equal(t, "somecaveat", caveat.Name)
+
+	require.Equal(t, []string{"document:doc1#viewer@user:alice[somecaveat]"}, readTenantRelationships(t, first.SnapshotReader(revision)))
+	require.Equal(t, []string{"document:doc1#viewer@user:bob"}, readTenantRelationships(t, second.SnapshotReader(revision)))
+
+	// The definitions of the tenants are stored under their scoped names.
+	namespaces, err = db.SnapshotReader(revision).ListAllNamespaces(context.Background())
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		names = append(names, namespace.Definition.Name)
+	}
+	require.ElementsMatch(t, []string{"first/user", "first/document", "second/user", "second/document"}, names)
+
+	// Definitions of the tenant are not found under their scoped names, nor those of others.
+	_, _, err = first.SnapshotReader(revision).ReadNamespaceByName(context.Background(), "unknown")
+	require.ErrorAs(t, err, &datastore.ErrNamespaceNotFound{})
+	require.Contains(t, err.Error(), "`unknown`")
+
+	_, _, err = first.SnapshotReader(revision).ReadNamespaceByName(context.Background(), "second/document")
+	require.ErrorAs(t, err, &datastore.ErrNamespaceNotFound{})
+
+	// Deleting relationships without a resource type only deletes those of the tenant.
+	revision, err = first.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		_, err := rwt.DeleteRelationships(ctx, &v1.RelationshipFilter{
+			OptionalSubjectFilter: &v1.SubjectFilter{SubjectType: "user"},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	require.Empty(t, readTenantRelationships(t, first.SnapshotReader(revision)))
+	require.Equal(t, []string{"document:doc1#viewer@user:bob"}, readTenantRelationships(t, second.SnapshotReader(revision)))
+}
+
+func TestTenantProxyStatistics(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	first := NewTenantDatastoreProxy(db, "first")
+	second := NewTenantDatastoreProxy(db, "second")
+	empty := NewTenantDatastoreProxy(db, "empty")
+
+	writeTenantSchema(t, first, "document:doc1#viewer@user:alice", "document:doc2#viewer@user:alice", "document:doc3#viewer@user:alice")
+	writeTenantSchema(t, second, "document:doc1#viewer@user:bob")
+
+	// The relationships of the datastore are shared out between the tenants by their relations.
+	stats, err := first.Statistics(context.Background())
+	require.NoError(t, err)
+	require.Len(t, stats.ObjectTypeStatistics, 2)
+	require.Equal(t, uint64(2), stats.EstimatedRelationshipCount)
+
+	stats, err = empty.Statistics(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, stats.ObjectTypeStatistics)
+	require.Zero(t, stats.EstimatedRelationshipCount)
+}
+
+func TestTenantProxyWatch(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	first := NewTenantDatastoreProxy(db, "first")
+	second := NewTenantDatastoreProxy(db, "second")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	headRevision, err := first.HeadRevision(ctx)
+	require.NoError(t, err)
+
+	changes, errs := first.Watch(ctx, headRevision, datastore.WatchOptions{
+		Content: datastore.WatchRelationships | datastore.WatchSchema,
+	})
+
+	writeTenantSchema(t, second, "document:doc1#viewer@user:bob")
+	writeTenantSchema(t, first, "document:doc1#viewer@user:alice")
+
+	select {
+	case change := <-changes:
+		require.Len(t, change.RelationshipChanges, 1)
+		require.Equal(t, "document:doc1#viewer@user:alice", tuple.MustString(change.RelationshipChanges[0].Tuple))
+
+		names := make([]string, 0, len(change.ChangedDefinitions))
+		for _, definition := range change.ChangedDefinitions {
+			names = append(names, definition.GetName())
+		}
+		require.ElementsMatch(t, []string{"somecaveat", "user", "document"}, names)
+	case err := <-errs:
+		require.FailNow(t, "unexpected watch error", err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the changes of the tenant")
+	}
+}
+
+func TestTenantProxyPrefixedDefinitions(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	tenant := NewTenantDatastoreProxy(db, "sometenant")
+
+	revision, err := tenant.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		if err := rwt.WriteCaveats(ctx, []*core.CaveatDefinition{
+			ns.MustCaveatDefinition(caveats.MustEnvForVariables(map[string]types.VariableType{"allowed": types.BooleanType}), "prefix/somecaveat", "allowed"),
+		}); err != nil {
+			return err
+		}
+
+		if err := rwt.WriteNamespaces(ctx,
+			ns.Namespace("prefix/user"),
+			ns.Namespace("prefix/document",
+				ns.MustRelation("viewer", nil,
+					ns.AllowedRelationWithCaveat("prefix/user", "...", ns.AllowedCaveat("prefix/somecaveat")),
+				),
+			),
+		); err != nil {
+			return err
+		}
+
+		return rwt.WriteRelationships(ctx, []*core.RelationTupleUpdate{
+			tuple.Create(tuple.MustParse("prefix/document:doc1#viewer@prefix/user:alice[prefix/somecaveat]")),
+		})
+	})
+	require.NoError(t, err)
+
+	// Definitions with a prefix are stored under valid names, with the tenant as another prefix.
+	namespaces, err := db.SnapshotReader(revision).ListAllNamespaces(context.Background())
+	require.NoError(t, err)
+	require.Len(t, namespaces, 2)
+	for _, namespace := range namespaces {
+		require.NoError(t, namespace.Definition.Validate())
+	}
+
+	document, _, err := db.SnapshotReader(revision).ReadNamespaceByName(context.Background(), "sometenant/prefix/document")
+	require.NoError(t, err)
+	require.Equal(t, "sometenant/prefix/user", document.Relation[0].TypeInformation.AllowedDirectRelations[0].Namespace)
+
+	// The tenant reads them back under their unscoped names.
+	document, _, err = tenant.SnapshotReader(revision).ReadNamespaceByName(context.Background(), "prefix/document")
+	require.NoError(t, err)
+	require.Equal(t, "prefix/document", document.Name)
+	require.Equal(t, "prefix/somecaveat", document.Relation[0].TypeInformation.AllowedDirectRelations[0].RequiredCaveat.CaveatName)
+
+	iter, err := tenant.SnapshotReader(revision).QueryRelationships(context.Background(), datastore.RelationshipsFilter{OptionalResourceType: "prefix/document"})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	tpl := iter.Next()
+	require.NotNil(t, tpl)
+	require.NoError(t, tpl.Validate())
+	require.Equal(t, "prefix/document:doc1#viewer@prefix/user:alice[prefix/somecaveat]", tuple.MustString(tpl))
+	require.Nil(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
+
+func TestTenantProxyRejectsDefinitionNamesTooLongForTheTenant(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	tenant := NewTenantDatastoreProxy(db, "sometenant")
+
+	// The name is valid on its own, but not once prefixed by the tenant.
+	name := "prefix/" + strings.Repeat("a", 64) + "/" + strings.Repeat("b", 50)
+	require.LessOrEqual(t, len(name), maxScopedNameBytes)
+	require.NoError(t, ns.Namespace(name).Validate())
+
+	_, err = tenant.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		return rwt.WriteNamespaces(ctx, ns.Namespace(name))
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "sometenant")
+}
@@ -0,0 +1,53 @@
+package spanner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+var queryAuditRecords = fmt.Sprintf(
+	"SELECT %s, %s FROM %s WHERE %s >= @since ORDER BY %s, %s LIMIT @limit",
+	colTimestamp, colAuditRecord, tableAuditLog, colTimestamp, colTimestamp, colID,
+)
+
+func (sd *spannerDatastore) WriteAuditRecord(ctx context.Context, record datastore.AuditRecord) error {
+	_, err := sd.client.Apply(ctx, []*spanner.Mutation{
+		spanner.Insert(tableAuditLog, []string{colID, colTimestamp, colAuditRecord}, []any{
+			uuid.NewString(),
+			record.Timestamp.UTC(),
+			string(record.Data),
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to write audit record: %w", err)
+	}
+	return nil
+}
+
+func (sd *spannerDatastore) ReadAuditRecords(ctx context.Context, since time.Time, limit uint64) ([]datastore.AuditRecord, error) {
+	var records []datastore.AuditRecord
+	if err := sd.client.Single().Query(ctx, spanner.Statement{
+		SQL:    queryAuditRecords,
+		Params: map[string]any{"since": since.UTC(), "limit": int64(limit)},
+	}).Do(func(r *spanner.Row) error {
+		var record datastore.AuditRecord
+		var data string
+		if err := r.Columns(&record.Timestamp, &data); err != nil {
+			return err
+		}
+		record.Data = []byte(data)
+		records = append(records, record)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("unable to read audit records: %w", err)
+	}
+	return records, nil
+}
+
+var _ datastore.AuditLogDatastore = &spannerDatastore{}
@@ -0,0 +1,73 @@
+package spanner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	"github.com/authzed/spicedb/internal/datastore/revisions"
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+var _ common.GarbageCollector = spannerDatastore{}
+
+func (sd spannerDatastore) HasGCRun() bool {
+	return sd.gcHasRun.Load()
+}
+
+func (sd spannerDatastore) MarkGCCompleted() {
+	sd.gcHasRun.Store(true)
+}
+
+func (sd spannerDatastore) ResetGCCompleted() {
+	sd.gcHasRun.Store(false)
+}
+
+func (sd spannerDatastore) Now(ctx context.Context) (time.Time, error) {
+	// Retrieve the `now` time from the database.
+	now, err := sd.now(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return now.UTC(), nil
+}
+
+func (sd spannerDatastore) TxIDBefore(_ context.Context, before time.Time) (datastore.Revision, error) {
+	return revisions.NewForTime(before), nil
+}
+
+func (sd spannerDatastore) DeleteBeforeTx(_ context.Context, _ datastore.Revision) (common.DeletionCounts, error) {
+	// Deleted relationships, namespaces and caveats are removed by Spanner's own garbage
+	// collection of old row versions, once they are older than its version retention period.
+	return common.DeletionCounts{}, nil
+}
+
+func (sd spannerDatastore) DeleteExpiredRelationships(ctx context.Context, expiredBefore time.Time) (int64, error) {
+	// Delete any relationship rows that expired before the GC window.
+	delSQL, args, err := sql.Delete(tableRelationship).
+		Where(sq.Lt{colExpiration: expiredBefore}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := sd.client.PartitionedUpdate(ctx, statementFromSQL(delSQL, args))
+	if err != nil {
+		return 0, fmt.Errorf("failed to GC expired relationships: %w", err)
+	}
+
+	if deleted > 0 && !sd.config.disableStats {
+		if _, err := sd.client.ReadWriteTransaction(ctx, func(ctx context.Context, rwt *spanner.ReadWriteTransaction) error {
+			return updateCounter(ctx, rwt, -deleted)
+		}); err != nil {
+			return deleted, fmt.Errorf("failed to update relationship count: %w", err)
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+)
+
+const (
+	addRelationshipExpiration = `ALTER TABLE relation_tuple
+		ADD COLUMN expiration TIMESTAMP`
+
+	// The index only covers relationships with an expiration, which the garbage collector
+	// scans for those that have expired.
+	addExpiredRelationshipsIndex = `CREATE NULL_FILTERED INDEX ix_relation_tuple_expired ON relation_tuple (expiration)`
+)
+
+func init() {
+	if err := SpannerMigrations.Register("add-relationship-expiration", "delete-older-changestreams", func(ctx context.Context, w Wrapper) error {
+		updateOp, err := w.adminClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+			Database: w.client.DatabaseName(),
+			Statements: []string{
+				addRelationshipExpiration,
+				addExpiredRelationshipsIndex,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return updateOp.Wait(ctx)
+	}, nil); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
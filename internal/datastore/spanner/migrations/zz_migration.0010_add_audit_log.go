@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+)
+
+const (
+	createAuditLog = `CREATE TABLE audit_log (
+		id STRING(36) NOT NULL,
+		timestamp TIMESTAMP NOT NULL,
+		record STRING(MAX) NOT NULL
+	) PRIMARY KEY (id)`
+
+	createAuditLogTimestampIndex = `CREATE INDEX ix_audit_log_by_timestamp ON audit_log (timestamp)`
+)
+
+func init() {
+	if err := SpannerMigrations.Register("add-audit-log", "add-relationship-expiration", func(ctx context.Context, w Wrapper) error {
+		updateOp, err := w.adminClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+			Database: w.client.DatabaseName(),
+			Statements: []string{
+				createAuditLog,
+				createAuditLogTimestampIndex,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return updateOp.Wait(ctx)
+	}, nil); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
+)
+
+const (
+	// Metadata rows are only read through the change stream, so they are kept no longer than
+	// the changes they are attached to can be watched.
+	createTransactionMetadata = `CREATE TABLE transaction_metadata (
+		id STRING(36) NOT NULL,
+		timestamp TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+		metadata JSON
+	) PRIMARY KEY (id),
+	ROW DELETION POLICY (OLDER_THAN(timestamp, INTERVAL 2 DAY))`
+
+	addTransactionMetadataToChangeStream = `ALTER CHANGE STREAM combined_change_stream SET FOR namespace_config,caveat,relation_tuple,transaction_metadata`
+)
+
+func init() {
+	if err := SpannerMigrations.Register("add-transaction-metadata", "add-audit-log", func(ctx context.Context, w Wrapper) error {
+		updateOp, err := w.adminClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+			Database: w.client.DatabaseName(),
+			Statements: []string{
+				createTransactionMetadata,
+				addTransactionMetadataToChangeStream,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		return updateOp.Wait(ctx)
+	}, nil); err != nil {
+		panic("failed to register migration: " + err.Error())
+	}
+}
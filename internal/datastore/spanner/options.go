@@ -21,6 +21,9 @@ type spannerOptions struct {
 	minSessions                 uint64
 	maxSessions                 uint64
 	migrationPhase              string
+	gcInterval                  time.Duration
+	gcMaxOperationTime          time.Duration
+	gcEnabled                   bool
 }
 
 type migrationPhase uint8
@@ -43,6 +46,10 @@ const (
 	defaultWatchBufferWriteTimeout     = 1 * time.Second
 	defaultDisableStats                = false
 	maxRevisionQuantization            = 24 * time.Hour
+
+	defaultGarbageCollectionInterval         = time.Minute * 3
+	defaultGarbageCollectionMaxOperationTime = time.Minute
+	defaultGCEnabled                         = true
 )
 
 // Option provides the facility to configure how clients within the Spanner
@@ -65,6 +72,9 @@ func generateConfig(options []Option) (spannerOptions, error) {
 		minSessions:                 100,
 		maxSessions:                 400,
 		migrationPhase:              "", // no migration
+		gcInterval:                  defaultGarbageCollectionInterval,
+		gcMaxOperationTime:          defaultGarbageCollectionMaxOperationTime,
+		gcEnabled:                   defaultGCEnabled,
 	}
 
 	for _, option := range options {
@@ -193,3 +203,27 @@ func MaxSessionCount(maxSessions uint64) Option {
 func MigrationPhase(phase string) Option {
 	return func(po *spannerOptions) { po.migrationPhase = phase }
 }
+
+// GCInterval is the the interval at which garbage collection of expired
+// relationships will occur.
+//
+// This value defaults to 3 minutes.
+func GCInterval(interval time.Duration) Option {
+	return func(po *spannerOptions) { po.gcInterval = interval }
+}
+
+// GCMaxOperationTime is the maximum operation time of a garbage collection
+// pass before it times out.
+//
+// This value defaults to 1 minute.
+func GCMaxOperationTime(time time.Duration) Option {
+	return func(po *spannerOptions) { po.gcMaxOperationTime = time }
+}
+
+// GCEnabled indicates whether garbage collection of expired relationships is
+// enabled. Other old data is removed by the garbage collection of Spanner.
+//
+// GC is enabled by default.
+func GCEnabled(isGCEnabled bool) Option {
+	return func(po *spannerOptions) { po.gcEnabled = isGCEnabled }
+}
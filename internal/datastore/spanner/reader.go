@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	sq "github.com/Masterminds/squirrel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/authzed/spicedb/internal/datastore/common"
 	"github.com/authzed/spicedb/internal/datastore/revisions"
@@ -29,8 +31,9 @@ type readTX interface {
 type txFactory func() readTX
 
 type spannerReader struct {
-	executor common.QueryExecutor
-	txSource txFactory
+	executor     common.QueryExecutor
+	txSource     txFactory
+	revisionTime sq.Sqlizer
 }
 
 func (sr spannerReader) QueryRelationships(
@@ -38,7 +41,7 @@ func (sr spannerReader) QueryRelationships(
 	filter datastore.RelationshipsFilter,
 	opts ...options.QueryOptionsOption,
 ) (iter datastore.RelationshipIterator, err error) {
-	qBuilder, err := common.NewSchemaQueryFilterer(schema, queryTuples).FilterWithRelationshipsFilter(filter)
+	qBuilder, err := common.NewSchemaQueryFilterer(schema, queryTuples, sr.revisionTime).FilterWithRelationshipsFilter(filter)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +54,7 @@ func (sr spannerReader) ReverseQueryRelationships(
 	subjectsFilter datastore.SubjectsFilter,
 	opts ...options.ReverseQueryOptionsOption,
 ) (iter datastore.RelationshipIterator, err error) {
-	qBuilder, err := common.NewSchemaQueryFilterer(schema, queryTuples).
+	qBuilder, err := common.NewSchemaQueryFilterer(schema, queryTuples, sr.revisionTime).
 		FilterWithSubjectsSelectors(subjectsFilter.AsSelector())
 	if err != nil {
 		return nil, err
@@ -90,6 +93,7 @@ func queryExecutor(txSource txFactory) common.ExecuteQueryFunc {
 			}
 			var caveatName spanner.NullString
 			var caveatCtx spanner.NullJSON
+			var expiration spanner.NullTime
 			err := row.Columns(
 				&nextTuple.ResourceAndRelation.Namespace,
 				&nextTuple.ResourceAndRelation.ObjectId,
@@ -99,6 +103,7 @@ func queryExecutor(txSource txFactory) common.ExecuteQueryFunc {
 				&nextTuple.Subject.Relation,
 				&caveatName,
 				&caveatCtx,
+				&expiration,
 			)
 			if err != nil {
 				return err
@@ -109,6 +114,10 @@ func queryExecutor(txSource txFactory) common.ExecuteQueryFunc {
 				return err
 			}
 
+			if expiration.Valid {
+				nextTuple.OptionalExpirationTime = timestamppb.New(expiration.Time)
+			}
+
 			tuples = append(tuples, nextTuple)
 
 			return nil
@@ -232,6 +241,7 @@ var queryTuples = sql.Select(
 	colUsersetRelation,
 	colCaveatName,
 	colCaveatContext,
+	colExpiration,
 ).From(tableRelationship)
 
 var queryTuplesForDelete = sql.Select(
@@ -251,6 +261,7 @@ var schema = common.NewSchemaInformation(
 	colUsersetObjectID,
 	colUsersetRelation,
 	colCaveatName,
+	colExpiration,
 	common.ExpandedLogicComparison,
 )
 
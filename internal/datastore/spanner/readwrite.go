@@ -27,6 +27,10 @@ type spannerReadWriteTXN struct {
 const inLimit = 10_000 // https://cloud.google.com/spanner/quotas#query-limits
 
 func (rwt spannerReadWriteTXN) WriteRelationships(ctx context.Context, mutations []*core.RelationTupleUpdate) error {
+	if err := rwt.deleteExpiredForCreate(ctx, mutations); err != nil {
+		return fmt.Errorf(errUnableToWriteRelationships, err)
+	}
+
 	var rowCountChange int64
 	for _, mutation := range mutations {
 		txnMut, countChange, err := spannerMutation(ctx, mutation.Operation, mutation.Tuple)
@@ -49,6 +53,50 @@ func (rwt spannerReadWriteTXN) WriteRelationships(ctx context.Context, mutations
 	return nil
 }
 
+// deleteExpiredForCreate removes any expired relationships that are about to be replaced
+// by a CREATE. Expired relationships are no longer visible, so a CREATE over one must succeed.
+func (rwt spannerReadWriteTXN) deleteExpiredForCreate(ctx context.Context, mutations []*core.RelationTupleUpdate) error {
+	createClauses := sq.Or{}
+	for _, mutation := range mutations {
+		if mutation.Operation != core.RelationTupleUpdate_CREATE {
+			continue
+		}
+
+		tpl := mutation.Tuple
+		createClauses = append(createClauses, sq.Eq{
+			colNamespace:        tpl.ResourceAndRelation.Namespace,
+			colObjectID:         tpl.ResourceAndRelation.ObjectId,
+			colRelation:         tpl.ResourceAndRelation.Relation,
+			colUsersetNamespace: tpl.Subject.Namespace,
+			colUsersetObjectID:  tpl.Subject.ObjectId,
+			colUsersetRelation:  tpl.Subject.Relation,
+		})
+	}
+
+	if len(createClauses) == 0 {
+		return nil
+	}
+
+	delSQL, args, err := sql.Delete(tableRelationship).
+		Where(createClauses).
+		Where(sq.Expr(colExpiration + " <= CURRENT_TIMESTAMP()")).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := rwt.spannerRWT.Update(ctx, statementFromSQL(delSQL, args))
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 && !rwt.disableStats {
+		return updateCounter(ctx, rwt.spannerRWT, -deleted)
+	}
+
+	return nil
+}
+
 func spannerMutation(
 	ctx context.Context,
 	operation core.RelationTupleUpdate_Operation,
@@ -230,6 +278,7 @@ func upsertVals(r *core.RelationTuple) []any {
 	key := keyFromRelationship(r)
 	key = append(key, spanner.CommitTimestamp)
 	key = append(key, caveatVals(r)...)
+	key = append(key, expirationVal(r))
 	return key
 }
 
@@ -257,6 +306,13 @@ func caveatVals(r *core.RelationTuple) []any {
 	return vals
 }
 
+func expirationVal(r *core.RelationTuple) spanner.NullTime {
+	if r.OptionalExpirationTime == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: r.OptionalExpirationTime.AsTime(), Valid: true}
+}
+
 func (rwt spannerReadWriteTXN) WriteNamespaces(_ context.Context, newConfigs ...*core.NamespaceDefinition) error {
 	mutations := make([]*spanner.Mutation, 0, len(newConfigs))
 	for _, newConfig := range newConfigs {
@@ -16,6 +16,7 @@ const (
 	colTimestamp        = "timestamp"
 	colCaveatName       = "caveat_name"
 	colCaveatContext    = "caveat_context"
+	colExpiration       = "expiration"
 
 	tableCaveat         = "caveat"
 	colName             = "name"
@@ -28,6 +29,12 @@ const (
 	tableCounters = "relationship_estimate_counters"
 	colID         = "id"
 	colCount      = "count"
+
+	tableAuditLog  = "audit_log"
+	colAuditRecord = "record"
+
+	tableTransactionMetadata = "transaction_metadata"
+	colTransactionMetadata   = "metadata"
 )
 
 var allRelationshipCols = []string{
@@ -40,4 +47,5 @@ var allRelationshipCols = []string{
 	colTimestamp,
 	colCaveatName,
 	colCaveatContext,
+	colExpiration,
 }
@@ -6,11 +6,13 @@ import (
 	"os"
 	"regexp"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	ocprom "contrib.go.opencensus.io/exporter/prometheus"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"go.opencensus.io/stats/view"
@@ -18,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -78,6 +81,10 @@ type spannerDatastore struct {
 	client   *spanner.Client
 	config   spannerOptions
 	database string
+
+	gcGroup  *errgroup.Group
+	cancelGc context.CancelFunc
+	gcHasRun *atomic.Bool
 }
 
 // NewSpannerDatastore returns a datastore backed by cloud spanner
@@ -158,9 +165,30 @@ func NewSpannerDatastore(ctx context.Context, database string, opts ...Option) (
 		database:                database,
 		watchBufferWriteTimeout: config.watchBufferWriteTimeout,
 		watchBufferLength:       config.watchBufferLength,
+		gcHasRun:                &atomic.Bool{},
 	}
 	ds.RemoteClockRevisions.SetNowFunc(ds.headRevisionInternal)
 
+	// Start a goroutine for garbage collection of expired relationships. Relationships can
+	// be read at revisions as old as the change stream retention, so only those that expired
+	// before it are removed.
+	var gcCtx context.Context
+	gcCtx, ds.cancelGc = context.WithCancel(context.Background())
+	if config.gcInterval > 0*time.Minute && config.gcEnabled {
+		ds.gcGroup, gcCtx = errgroup.WithContext(gcCtx)
+		ds.gcGroup.Go(func() error {
+			return common.StartGarbageCollector(
+				gcCtx,
+				ds,
+				config.gcInterval,
+				defaultChangeStreamRetention,
+				config.gcMaxOperationTime,
+			)
+		})
+	} else {
+		log.Warn().Msg("datastore background garbage collection disabled")
+	}
+
 	return ds, nil
 }
 
@@ -202,7 +230,9 @@ func (sd spannerDatastore) SnapshotReader(revisionRaw datastore.Revision) datast
 		return &traceableRTX{delegate: sd.client.Single().WithTimestampBound(spanner.ReadTimestamp(r.Time()))}
 	}
 	executor := common.QueryExecutor{Executor: queryExecutor(txSource)}
-	return spannerReader{executor, txSource}
+
+	// Expiration is evaluated at the time of the revision being read.
+	return spannerReader{executor, txSource, sq.Expr("?", r.Time())}
 }
 
 func (sd spannerDatastore) ReadWriteTx(ctx context.Context, fn datastore.TxUserFunc, opts ...options.RWTOptionsOption) (datastore.Revision, error) {
@@ -219,7 +249,7 @@ func (sd spannerDatastore) ReadWriteTx(ctx context.Context, fn datastore.TxUserF
 
 		executor := common.QueryExecutor{Executor: queryExecutor(txSource)}
 		rwt := spannerReadWriteTXN{
-			spannerReader{executor, txSource},
+			spannerReader{executor, txSource, sq.Expr("CURRENT_TIMESTAMP()")},
 			spannerRWT,
 			sd.config.disableStats,
 		}
@@ -236,6 +266,18 @@ func (sd spannerDatastore) ReadWriteTx(ctx context.Context, fn datastore.TxUserF
 			return err
 		}
 
+		// The metadata is written in the transaction, so that it is reported by the change
+		// stream at the same commit timestamp as the changes it is attached to.
+		if config.Metadata != nil {
+			return spannerRWT.BufferWrite([]*spanner.Mutation{
+				spanner.Insert(tableTransactionMetadata, []string{colID, colTimestamp, colTransactionMetadata}, []any{
+					uuid.NewString(),
+					spanner.CommitTimestamp,
+					spanner.NullJSON{Value: config.Metadata.AsMap(), Valid: true},
+				}),
+			})
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -280,6 +322,13 @@ func (sd spannerDatastore) Features(_ context.Context) (*datastore.Features, err
 }
 
 func (sd spannerDatastore) Close() error {
+	sd.cancelGc()
+
+	if sd.gcGroup != nil {
+		err := sd.gcGroup.Wait()
+		log.Warn().Err(err).Msg("completed shutdown of spanner datastore")
+	}
+
 	sd.client.Close()
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/cloudspannerecosystem/spanner-change-streams-tail/changestreams"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/authzed/spicedb/internal/datastore/common"
 	"github.com/authzed/spicedb/internal/datastore/revisions"
@@ -129,6 +130,11 @@ func (sd spannerDatastore) watch(
 		return
 	}
 
+	if err := common.CheckWatchStartRevision(ctx, sd, afterRevision); err != nil {
+		sendError(err)
+		return
+	}
+
 	reader, err := changestreams.NewReaderWithConfig(
 		ctx,
 		project,
@@ -182,12 +188,22 @@ func (sd spannerDatastore) watch(
 								return spiceerrors.MustBugf("error converting old values map")
 							}
 
+							// Rows removed by the garbage collector, or replaced by a CREATE, after they
+							// expired had already been excluded from reads, so their removal is not a change.
+							expiration, err := expirationFromValues(oldValues)
+							if err != nil {
+								return err
+							}
+							if expiration != nil && !expiration.AsTime().After(dcr.CommitTimestamp) {
+								continue
+							}
+
 							relationTuple.Caveat, err = contextualizedCaveatFromValues(oldValues)
 							if err != nil {
 								return err
 							}
 
-							err := tracked.AddRelationshipChange(ctx, changeRevision, relationTuple, core.RelationTupleUpdate_DELETE)
+							err = tracked.AddRelationshipChange(ctx, changeRevision, relationTuple, core.RelationTupleUpdate_DELETE)
 							if err != nil {
 								return err
 							}
@@ -218,6 +234,9 @@ func (sd spannerDatastore) watch(
 
 							tracked.AddDeletedCaveat(ctx, changeRevision, caveatName)
 
+						case tableTransactionMetadata:
+							// Metadata rows expire once their changes can no longer be watched.
+
 						default:
 							return spiceerrors.MustBugf("unknown table name %s in delete of change stream", dcr.TableName)
 						}
@@ -249,7 +268,9 @@ func (sd spannerDatastore) watch(
 								return spiceerrors.MustBugf("error converting new values map")
 							}
 
-							if oldValues[colCaveatName] == newValues[colCaveatName] && oldValues[colCaveatContext] == newValues[colCaveatContext] {
+							if oldValues[colCaveatName] == newValues[colCaveatName] &&
+								oldValues[colCaveatContext] == newValues[colCaveatContext] &&
+								oldValues[colExpiration] == newValues[colExpiration] {
 								continue
 							}
 
@@ -258,6 +279,11 @@ func (sd spannerDatastore) watch(
 								return err
 							}
 
+							relationTuple.OptionalExpirationTime, err = expirationFromValues(newValues)
+							if err != nil {
+								return err
+							}
+
 							err := tracked.AddRelationshipChange(ctx, changeRevision, relationTuple, core.RelationTupleUpdate_TOUCH)
 							if err != nil {
 								return err
@@ -289,6 +315,16 @@ func (sd spannerDatastore) watch(
 
 							tracked.AddChangedDefinition(ctx, changeRevision, caveat)
 
+						case tableTransactionMetadata:
+							metadata, err := transactionMetadataFromValues(newValues)
+							if err != nil {
+								return err
+							}
+
+							if err := tracked.SetRevisionMetadata(ctx, changeRevision, metadata); err != nil {
+								return err
+							}
+
 						default:
 							return spiceerrors.MustBugf("unknown table name %s in delete of change stream", dcr.TableName)
 						}
@@ -364,6 +400,34 @@ func relationTupleFromPrimaryKey(primaryKeyColumnValues map[string]any) *core.Re
 	}
 }
 
+func expirationFromValues(values map[string]any) (*timestamppb.Timestamp, error) {
+	// NOTE: spanner returns the TIMESTAMP field as an RFC3339 string here.
+	expirationString, ok := values[colExpiration].(string)
+	if !ok || expirationString == "" {
+		return nil, nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339Nano, expirationString)
+	if err != nil {
+		return nil, fmt.Errorf("malformed relationship expiration: %w", err)
+	}
+	return timestamppb.New(expiration), nil
+}
+
+func transactionMetadataFromValues(values map[string]any) (map[string]any, error) {
+	metadataString, ok := values[colTransactionMetadata].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	// NOTE: spanner returns the JSON field as a string here.
+	var metadata map[string]any
+	if err := json.Unmarshal([]byte(metadataString), &metadata); err != nil {
+		return nil, fmt.Errorf("could not unmarshal transaction metadata: %w", err)
+	}
+	return metadata, nil
+}
+
 func contextualizedCaveatFromValues(values map[string]any) (*core.ContextualizedCaveat, error) {
 	name := values[colCaveatName].(string)
 	if name != "" {
@@ -0,0 +1,42 @@
+package dispatch
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// AdmittedDispatchHeader is the metadata header marking dispatches made for the subproblems of a
+// dispatch which was already admitted by a node of the cluster.
+const AdmittedDispatchHeader = "spicedb-dispatch-admitted"
+
+type admittedDispatchKey struct{}
+
+// ContextWithAdmittedDispatch returns the context marked as that of an admitted dispatch, so that
+// the dispatches of its subproblems, whether made locally or on other nodes, are not shed.
+func ContextWithAdmittedDispatch(ctx context.Context) context.Context {
+	return context.WithValue(ctx, admittedDispatchKey{}, struct{}{})
+}
+
+// IsAdmittedDispatch returns whether the context is that of an admitted dispatch.
+func IsAdmittedDispatch(ctx context.Context) bool {
+	return ctx.Value(admittedDispatchKey{}) != nil
+}
+
+// OutgoingAdmittedContext adds the AdmittedDispatchHeader to the outgoing metadata of the
+// context, if it is that of an admitted dispatch.
+func OutgoingAdmittedContext(ctx context.Context) context.Context {
+	if !IsAdmittedDispatch(ctx) {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, AdmittedDispatchHeader, "true")
+}
+
+// IncomingAdmittedContext marks the context as that of an admitted dispatch if the request was
+// made with the AdmittedDispatchHeader.
+func IncomingAdmittedContext(ctx context.Context) context.Context {
+	if len(metadata.ValueFromIncomingContext(ctx, AdmittedDispatchHeader)) == 0 {
+		return ctx
+	}
+	return ContextWithAdmittedDispatch(ctx)
+}
@@ -28,9 +28,11 @@ const (
 
 // Dispatcher is a dispatcher with cacheInst-in caching.
 type Dispatcher struct {
-	d          dispatch.Dispatcher
-	c          cache.Cache
-	keyHandler keys.Handler
+	d             dispatch.Dispatcher
+	c             cache.Cache
+	secondTier    SecondTierCache
+	changeTracker *ChangeTracker
+	keyHandler    keys.Handler
 
 	checkTotalCounter                  prometheus.Counter
 	checkFromCacheCounter              prometheus.Counter
@@ -165,23 +167,29 @@ func (cd *Dispatcher) SetDelegate(delegate dispatch.Dispatcher) {
 func (cd *Dispatcher) DispatchCheck(ctx context.Context, req *v1.DispatchCheckRequest) (*v1.DispatchCheckResponse, error) {
 	cd.checkTotalCounter.Inc()
 
-	requestKey, err := cd.keyHandler.CheckCacheKey(ctx, req)
+	keyReq := req
+	if stableRevision, ok := cd.stableRevision(ctx, req.Metadata, req.ResourceRelation); ok {
+		keyReq = req.CloneVT()
+		keyReq.Metadata.AtRevision = stableRevision
+	}
+
+	requestKey, err := cd.keyHandler.CheckCacheKey(ctx, keyReq)
 	if err != nil {
 		return &v1.DispatchCheckResponse{Metadata: &v1.ResponseMeta{}}, err
 	}
 
-	// Disable caching when debugging is enabled.
 	span := trace.SpanFromContext(ctx)
-	if cachedResultRaw, found := cd.c.Get(requestKey); found {
+	if cachedResult, found := cd.getCachedBytes(requestKey); found {
 		var response v1.DispatchCheckResponse
-		if err := response.UnmarshalVT(cachedResultRaw.([]byte)); err != nil {
+		if err := response.UnmarshalVT(cachedResult); err != nil {
 			return &v1.DispatchCheckResponse{Metadata: &v1.ResponseMeta{}}, err
 		}
 
 		if req.Metadata.DepthRemaining >= response.Metadata.DepthRequired {
 			cd.checkFromCacheCounter.Inc()
-			// If debugging is requested, add the req and the response to the trace.
-			if req.Debug == v1.DispatchCheckRequest_ENABLE_BASIC_DEBUGGING {
+			// If debugging is requested, add the req and the response to the trace, marked as
+			// cached so that the missing subproblems can be told apart from a leaf.
+			if req.Debug != v1.DispatchCheckRequest_NO_DEBUG {
 				response.Metadata.DebugInfo = &v1.DebugInformation{
 					Check: &v1.CheckDebugTrace{
 						Request:        req,
@@ -210,7 +218,7 @@ func (cd *Dispatcher) DispatchCheck(ctx context.Context, req *v1.DispatchCheckRe
 			return &v1.DispatchCheckResponse{Metadata: &v1.ResponseMeta{}}, err
 		}
 
-		cd.c.Set(requestKey, adjustedBytes, sliceSize(adjustedBytes))
+		cd.setCachedBytes(requestKey, adjustedBytes)
 	}
 
 	// Return both the computed and err in ALL cases: computed contains resolved
@@ -228,14 +236,20 @@ func (cd *Dispatcher) DispatchExpand(ctx context.Context, req *v1.DispatchExpand
 func (cd *Dispatcher) DispatchReachableResources(req *v1.DispatchReachableResourcesRequest, stream dispatch.ReachableResourcesStream) error {
 	cd.reachableResourcesTotalCounter.Inc()
 
-	requestKey, err := cd.keyHandler.ReachableResourcesCacheKey(stream.Context(), req)
+	keyReq := req
+	if stableRevision, ok := cd.stableRevision(stream.Context(), req.Metadata, req.ResourceRelation); ok {
+		keyReq = req.CloneVT()
+		keyReq.Metadata.AtRevision = stableRevision
+	}
+
+	requestKey, err := cd.keyHandler.ReachableResourcesCacheKey(stream.Context(), keyReq)
 	if err != nil {
 		return err
 	}
 
-	if cachedResultRaw, found := cd.c.Get(requestKey); found {
+	if cachedResults, found := cd.getCachedResults(requestKey); found {
 		cd.reachableResourcesFromCacheCounter.Inc()
-		for _, slice := range cachedResultRaw.([][]byte) {
+		for _, slice := range cachedResults {
 			var response v1.DispatchReachableResourcesResponse
 			if err := response.UnmarshalVT(slice); err != nil {
 				return fmt.Errorf("could not publish cached reachable resources result: %w", err)
@@ -278,12 +292,7 @@ func (cd *Dispatcher) DispatchReachableResources(req *v1.DispatchReachableResour
 		return err
 	}
 
-	var size int64
-	for _, slice := range toCacheResults {
-		size += sliceSize(slice)
-	}
-
-	cd.c.Set(requestKey, toCacheResults, size)
+	cd.setCachedResults(requestKey, toCacheResults)
 	return nil
 }
 
@@ -296,14 +305,20 @@ func sliceSize(xs []byte) int64 {
 func (cd *Dispatcher) DispatchLookupResources(req *v1.DispatchLookupResourcesRequest, stream dispatch.LookupResourcesStream) error {
 	cd.lookupResourcesTotalCounter.Inc()
 
-	requestKey, err := cd.keyHandler.LookupResourcesCacheKey(stream.Context(), req)
+	keyReq := req
+	if stableRevision, ok := cd.stableRevision(stream.Context(), req.Metadata, req.ObjectRelation); ok {
+		keyReq = req.CloneVT()
+		keyReq.Metadata.AtRevision = stableRevision
+	}
+
+	requestKey, err := cd.keyHandler.LookupResourcesCacheKey(stream.Context(), keyReq)
 	if err != nil {
 		return err
 	}
 
-	if cachedResultRaw, found := cd.c.Get(requestKey); found {
+	if cachedResults, found := cd.getCachedResults(requestKey); found {
 		cd.lookupResourcesFromCacheCounter.Inc()
-		for _, slice := range cachedResultRaw.([][]byte) {
+		for _, slice := range cachedResults {
 			var response v1.DispatchLookupResourcesResponse
 			if err := response.UnmarshalVT(slice); err != nil {
 				return err
@@ -348,12 +363,7 @@ func (cd *Dispatcher) DispatchLookupResources(req *v1.DispatchLookupResourcesReq
 		return err
 	}
 
-	var size int64
-	for _, slice := range toCacheResults {
-		size += sliceSize(slice)
-	}
-
-	cd.c.Set(requestKey, toCacheResults, size)
+	cd.setCachedResults(requestKey, toCacheResults)
 	return nil
 }
 
@@ -361,14 +371,20 @@ func (cd *Dispatcher) DispatchLookupResources(req *v1.DispatchLookupResourcesReq
 func (cd *Dispatcher) DispatchLookupSubjects(req *v1.DispatchLookupSubjectsRequest, stream dispatch.LookupSubjectsStream) error {
 	cd.lookupSubjectsTotalCounter.Inc()
 
-	requestKey, err := cd.keyHandler.LookupSubjectsCacheKey(stream.Context(), req)
+	keyReq := req
+	if stableRevision, ok := cd.stableRevision(stream.Context(), req.Metadata, req.ResourceRelation); ok {
+		keyReq = req.CloneVT()
+		keyReq.Metadata.AtRevision = stableRevision
+	}
+
+	requestKey, err := cd.keyHandler.LookupSubjectsCacheKey(stream.Context(), keyReq)
 	if err != nil {
 		return err
 	}
 
-	if cachedResultRaw, found := cd.c.Get(requestKey); found {
+	if cachedResults, found := cd.getCachedResults(requestKey); found {
 		cd.lookupSubjectsFromCacheCounter.Inc()
-		for _, slice := range cachedResultRaw.([][]byte) {
+		for _, slice := range cachedResults {
 			var response v1.DispatchLookupSubjectsResponse
 			if err := response.UnmarshalVT(slice); err != nil {
 				return err
@@ -413,12 +429,7 @@ func (cd *Dispatcher) DispatchLookupSubjects(req *v1.DispatchLookupSubjectsReque
 		return err
 	}
 
-	var size int64
-	for _, slice := range toCacheResults {
-		size += sliceSize(slice)
-	}
-
-	cd.c.Set(requestKey, toCacheResults, size)
+	cd.setCachedResults(requestKey, toCacheResults)
 	return nil
 }
 
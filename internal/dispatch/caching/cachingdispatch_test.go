@@ -146,6 +146,56 @@ func TestMaxDepthCaching(t *testing.T) {
 	}
 }
 
+func TestTraceDebuggingReadsCache(t *testing.T) {
+	require := require.New(t)
+
+	parsed := tuple.ParseONR("document:doc1#read")
+	req := &v1.DispatchCheckRequest{
+		ResourceRelation: RR(parsed.Namespace, parsed.Relation),
+		ResourceIds:      []string{parsed.ObjectId},
+		Subject:          tuple.ParseSubjectONR("user:user1#..."),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     decimal.Zero.String(),
+			DepthRemaining: 50,
+		},
+	}
+
+	delegate := delegateDispatchMock{&mock.Mock{}}
+	delegate.On("DispatchCheck", req).Return(&v1.DispatchCheckResponse{
+		ResultsByResourceId: map[string]*v1.ResourceCheckResult{
+			parsed.ObjectId: {
+				Membership: v1.ResourceCheckResult_MEMBER,
+			},
+		},
+		Metadata: &v1.ResponseMeta{
+			DispatchCount: 1,
+			DepthRequired: 1,
+		},
+	}, nil).Times(1)
+
+	dispatch, err := NewCachingDispatcher(DispatchTestCache(t), false, "", nil)
+	dispatch.SetDelegate(delegate)
+	require.NoError(err)
+	defer dispatch.Close()
+
+	_, err = dispatch.DispatchCheck(context.Background(), req)
+	require.NoError(err)
+
+	// We have to sleep a while to let the cache converge.
+	time.Sleep(10 * time.Millisecond)
+
+	traced := req.CloneVT()
+	traced.Debug = v1.DispatchCheckRequest_ENABLE_TRACE_DEBUGGING
+	resp, err := dispatch.DispatchCheck(context.Background(), traced)
+	require.NoError(err)
+	require.Equal(v1.ResourceCheckResult_MEMBER, resp.ResultsByResourceId[parsed.ObjectId].Membership)
+	require.NotNil(resp.Metadata.DebugInfo)
+	require.True(resp.Metadata.DebugInfo.Check.IsCachedResult)
+	require.Equal(traced, resp.Metadata.DebugInfo.Check.Request)
+
+	delegate.AssertExpectations(t)
+}
+
 type delegateDispatchMock struct {
 	*mock.Mock
 }
@@ -0,0 +1,472 @@
+package caching
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	log "github.com/authzed/spicedb/internal/logging"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
+	"github.com/authzed/spicedb/pkg/datastore"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+const maxChangeTrackerRetryDelay = 30 * time.Second
+
+// ChangeTracker follows the changes made to a datastore via its Watch API, recording the latest
+// revision at which the relationships of each (namespace, relation) pair were changed.
+//
+// The Dispatcher uses the tracker to compute the cache keys of requests at the *stable revision*
+// of the request: the latest revision at or before the request's revision at which the schema or
+// any relationship that can be reached from the resource relation of the request was changed.
+// As the result of the request is the same at every revision between the stable revision and the
+// revision of the request, results cached at older revisions remain hits for as long as the parts
+// of the graph they were computed over do not change, rather than only until the quantized
+// revision moves forward.
+//
+// Revisions of some datastores (such as postgres) are only partially ordered: two revisions may be
+// concurrent, with neither preceding the other. The tracker therefore only relies upon a change
+// being known to be at or before a revision, and keeps every change to a relation which is not
+// known to precede a later one.
+//
+// Requests at revisions which are not yet covered by a checkpoint of the watch, or which were made
+// before the tracker started, are cached at their own revision. Cached entries continue to expire
+// with the TTL of the cache, so relationship expiration is observed no later than it would be
+// without the tracker.
+type ChangeTracker struct {
+	ds             datastore.Datastore
+	watchHeartbeat time.Duration
+
+	lock sync.RWMutex
+
+	// active indicates whether the watch is running and the fields below are in use.
+	active bool
+
+	// startRevision is the revision after which all changes are tracked.
+	startRevision datastore.Revision
+
+	// checkpointRevision is the revision up to which all changes have been received.
+	checkpointRevision datastore.Revision
+
+	// schemaChangedAt are the latest revisions at which the schema changed, or the start revision.
+	schemaChangedAt []datastore.Revision
+
+	// changedAt are the latest revisions at which the relationships for each relation changed,
+	// keyed by `namespace#relation`.
+	changedAt map[string][]datastore.Revision
+
+	// reachable are the relations whose relationships can be reached from a relation, in the
+	// current schema, keyed by `namespace#relation`. It is cleared whenever the schema changes.
+	reachable map[string][]string
+
+	// generation is incremented every time the schema changes or the watch restarts, so that
+	// computations made against an older schema are not recorded.
+	generation uint64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewChangeTracker creates a new ChangeTracker for the datastore. The tracker does nothing until
+// started.
+func NewChangeTracker(ds datastore.Datastore, watchHeartbeat time.Duration) *ChangeTracker {
+	return &ChangeTracker{
+		ds:             ds,
+		watchHeartbeat: watchHeartbeat,
+	}
+}
+
+// Start starts watching the datastore in the background, restarting the watch if it fails.
+func (ct *ChangeTracker) Start(ctx context.Context) error {
+	features, err := ct.ds.Features(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !features.Watch.Enabled {
+		log.Warn().Str("reason", features.Watch.Reason).Msg("datastore does not support watch; dispatch results will be cached at their own revisions")
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	ct.cancel = cancel
+	ct.done = make(chan struct{})
+
+	go ct.run(ctx)
+	return nil
+}
+
+// Close stops watching the datastore.
+func (ct *ChangeTracker) Close() error {
+	if ct.cancel != nil {
+		ct.cancel()
+		<-ct.done
+	}
+	return nil
+}
+
+func (ct *ChangeTracker) run(ctx context.Context) {
+	defer close(ct.done)
+	defer ct.deactivate()
+
+	var retries uint
+	for {
+		err := ct.watch(ctx)
+		ct.deactivate()
+
+		var disabled datastore.ErrWatchDisabled
+		switch {
+		case ctx.Err() != nil:
+			return
+
+		case errors.As(err, &disabled):
+			log.Warn().Err(err).Msg("watch is disabled; dispatch results will be cached at their own revisions")
+			return
+		}
+
+		retries++
+		delay := min(retry.BackoffExponentialWithJitter(100*time.Millisecond, 0.5)(ctx, retries), maxChangeTrackerRetryDelay)
+		log.Warn().Err(err).Dur("after", delay).Msg("dispatch cache change tracker watch failed; restarting")
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// watch tracks changes from the current head revision until the watch fails.
+func (ct *ChangeTracker) watch(ctx context.Context) error {
+	headRevision, err := ct.ds.HeadRevision(ctx)
+	if err != nil {
+		return err
+	}
+
+	changes, errs := ct.ds.Watch(ctx, headRevision, datastore.WatchOptions{
+		Content:            datastore.WatchRelationships | datastore.WatchSchema | datastore.WatchCheckpoints,
+		CheckpointInterval: ct.watchHeartbeat,
+	})
+
+	ct.lock.Lock()
+	ct.active = true
+	ct.startRevision = headRevision
+	ct.checkpointRevision = headRevision
+	ct.schemaChangedAt = []datastore.Revision{headRevision}
+	ct.changedAt = map[string][]datastore.Revision{}
+	ct.reachable = map[string][]string{}
+	ct.generation++
+	ct.lock.Unlock()
+
+	log.Debug().Str("revision", headRevision.String()).Msg("started dispatch cache change tracker")
+
+	for {
+		select {
+		case change, ok := <-changes:
+			if !ok {
+				return <-errs
+			}
+			ct.applyChange(change)
+
+		case err := <-errs:
+			return err
+		}
+	}
+}
+
+func (ct *ChangeTracker) deactivate() {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	ct.active = false
+	ct.changedAt = nil
+	ct.reachable = nil
+	ct.generation++
+}
+
+func (ct *ChangeTracker) applyChange(change *datastore.RevisionChanges) {
+	ct.lock.Lock()
+	defer ct.lock.Unlock()
+
+	if change.IsCheckpoint {
+		// Checkpoints are emitted in order, each covering every change at or before it.
+		if !change.Revision.LessThan(ct.checkpointRevision) {
+			ct.checkpointRevision = change.Revision
+		}
+		return
+	}
+
+	for _, update := range change.RelationshipChanges {
+		key := relationKey(update.Tuple.ResourceAndRelation.Namespace, update.Tuple.ResourceAndRelation.Relation)
+		ct.changedAt[key] = withChange(ct.changedAt[key], change.Revision)
+	}
+
+	if len(change.ChangedDefinitions) > 0 || len(change.DeletedNamespaces) > 0 || len(change.DeletedCaveats) > 0 {
+		ct.schemaChangedAt = withChange(ct.schemaChangedAt, change.Revision)
+		ct.reachable = map[string][]string{}
+		ct.generation++
+	}
+}
+
+// StableRevision returns the stable revision for a request for the resource relation at the
+// given revision, if it can be determined.
+func (ct *ChangeTracker) StableRevision(ctx context.Context, revisionStr string, resourceRelation *core.RelationReference) (string, bool) {
+	if tenant := tenantmw.FromContext(ctx); tenant != "" {
+		// The tracker watches the underlying datastore, in which the definitions of the tenant
+		// of the request are stored under their scoped names.
+		resourceRelation = &core.RelationReference{
+			Namespace: proxy.TenantScopedName(tenant, resourceRelation.Namespace),
+			Relation:  resourceRelation.Relation,
+		}
+	}
+
+	revision, err := ct.ds.RevisionFromString(revisionStr)
+	if err != nil {
+		return "", false
+	}
+
+	ct.lock.RLock()
+	if !ct.active || !atOrBefore(ct.startRevision, revision) || !atOrBefore(revision, ct.checkpointRevision) {
+		ct.lock.RUnlock()
+		return "", false
+	}
+
+	reachable, found := ct.reachable[relationKey(resourceRelation.Namespace, resourceRelation.Relation)]
+	generation := ct.generation
+	ct.lock.RUnlock()
+
+	if !found {
+		// The schema is unchanged between the stable revision and the revision, so it can be read
+		// at the revision.
+		reachable, err = reachableRelations(ctx, ct.ds.SnapshotReader(revision), resourceRelation)
+		if err != nil {
+			return "", false
+		}
+
+		ct.lock.Lock()
+		if ct.generation == generation {
+			ct.reachable[relationKey(resourceRelation.Namespace, resourceRelation.Relation)] = reachable
+		}
+		ct.lock.Unlock()
+	}
+
+	ct.lock.RLock()
+	defer ct.lock.RUnlock()
+
+	if ct.generation != generation {
+		return "", false
+	}
+
+	changes := slices.Clone(ct.schemaChangedAt)
+	for _, key := range reachable {
+		changes = append(changes, ct.changedAt[key]...)
+	}
+
+	stable, ok := latestChange(revision, changes)
+	if !ok {
+		return "", false
+	}
+	return stable.String(), true
+}
+
+// atOrBefore returns whether the revision is known to be at or before the other revision.
+func atOrBefore(revision datastore.Revision, other datastore.Revision) bool {
+	return revision.LessThan(other) || revision.Equal(other)
+}
+
+// withChange adds the revision of a change to the latest revisions of changes, dropping those
+// known to be at or before it. Revisions concurrent with it are kept, as a request may observe
+// either change without the other.
+func withChange(latest []datastore.Revision, revision datastore.Revision) []datastore.Revision {
+	kept := make([]datastore.Revision, 0, len(latest)+1)
+	for _, changedAt := range latest {
+		if !atOrBefore(changedAt, revision) {
+			kept = append(kept, changedAt)
+		}
+	}
+	return append(kept, revision)
+}
+
+// latestChange returns the revision of the change which is known to be at or after all the other
+// changes, if every change is known to be at or before the revision. Otherwise, the changes
+// observed at the revision cannot be told apart from those observed at any single one of them.
+func latestChange(revision datastore.Revision, changes []datastore.Revision) (datastore.Revision, bool) {
+	var latest datastore.Revision
+	for _, changedAt := range changes {
+		// A change after or concurrent with the revision means the last change at or before the
+		// revision is unknown.
+		if !atOrBefore(changedAt, revision) {
+			return nil, false
+		}
+
+		switch {
+		case latest == nil || atOrBefore(latest, changedAt):
+			latest = changedAt
+		case !atOrBefore(changedAt, latest):
+			return nil, false
+		}
+	}
+	return latest, latest != nil
+}
+
+// SetChangeTracker sets the tracker used to compute the cache keys of requests at their stable
+// revisions. The tracker is not closed when the dispatcher is closed, as it may be shared between
+// dispatchers.
+func (cd *Dispatcher) SetChangeTracker(changeTracker *ChangeTracker) {
+	cd.changeTracker = changeTracker
+}
+
+// stableRevision returns the stable revision at which to compute the cache key for a request
+// made at the revision for the resource relation, if it differs from the revision.
+func (cd *Dispatcher) stableRevision(ctx context.Context, metadata *v1.ResolverMeta, resourceRelation *core.RelationReference) (string, bool) {
+	if cd.changeTracker == nil {
+		return "", false
+	}
+
+	stableRevision, ok := cd.changeTracker.StableRevision(ctx, metadata.AtRevision, resourceRelation)
+	if !ok || stableRevision == metadata.AtRevision {
+		return "", false
+	}
+	return stableRevision, true
+}
+
+func relationKey(namespaceName string, relationName string) string {
+	return tuple.JoinRelRef(namespaceName, relationName)
+}
+
+// reachableRelations returns the keys of all relations whose relationships can affect the results
+// computed for the resource relation.
+func reachableRelations(ctx context.Context, reader datastore.Reader, resourceRelation *core.RelationReference) ([]string, error) {
+	walker := &relationWalker{
+		ctx:        ctx,
+		reader:     reader,
+		namespaces: map[string]*core.NamespaceDefinition{},
+		visited:    map[string]struct{}{},
+	}
+
+	if err := walker.visit(resourceRelation.Namespace, resourceRelation.Relation); err != nil {
+		return nil, err
+	}
+	return walker.relations, nil
+}
+
+type relationWalker struct {
+	ctx        context.Context
+	reader     datastore.Reader
+	namespaces map[string]*core.NamespaceDefinition
+	visited    map[string]struct{}
+	relations  []string
+}
+
+func (rw *relationWalker) visit(namespaceName string, relationName string) error {
+	key := relationKey(namespaceName, relationName)
+	if _, ok := rw.visited[key]; ok {
+		return nil
+	}
+	rw.visited[key] = struct{}{}
+
+	relation, err := rw.lookupRelation(namespaceName, relationName)
+	if err != nil || relation == nil {
+		return err
+	}
+
+	if relation.UsersetRewrite == nil {
+		return rw.visitDirect(namespaceName, relation)
+	}
+	return rw.visitRewrite(namespaceName, relation, relation.UsersetRewrite)
+}
+
+// visitDirect records the relation as holding relationships, and visits the relations of the
+// subjects allowed on it.
+func (rw *relationWalker) visitDirect(namespaceName string, relation *core.Relation) error {
+	rw.relations = append(rw.relations, relationKey(namespaceName, relation.Name))
+
+	for _, allowed := range relation.GetTypeInformation().GetAllowedDirectRelations() {
+		if subjectRelation := allowed.GetRelation(); subjectRelation != "" && subjectRelation != tuple.Ellipsis {
+			if err := rw.visit(allowed.Namespace, subjectRelation); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (rw *relationWalker) visitRewrite(namespaceName string, relation *core.Relation, rewrite *core.UsersetRewrite) error {
+	var operation *core.SetOperation
+	switch op := rewrite.RewriteOperation.(type) {
+	case *core.UsersetRewrite_Union:
+		operation = op.Union
+	case *core.UsersetRewrite_Intersection:
+		operation = op.Intersection
+	case *core.UsersetRewrite_Exclusion:
+		operation = op.Exclusion
+	}
+
+	for _, child := range operation.GetChild() {
+		var err error
+		switch child := child.ChildType.(type) {
+		case *core.SetOperation_Child_XThis:
+			err = rw.visitDirect(namespaceName, relation)
+
+		case *core.SetOperation_Child_ComputedUserset:
+			err = rw.visit(namespaceName, child.ComputedUserset.Relation)
+
+		case *core.SetOperation_Child_TupleToUserset:
+			err = rw.visitArrow(namespaceName, child.TupleToUserset)
+
+		case *core.SetOperation_Child_UsersetRewrite:
+			err = rw.visitRewrite(namespaceName, relation, child.UsersetRewrite)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visitArrow visits the tupleset relation of the arrow, and the computed relation on each of the
+// subject types allowed on the tupleset relation.
+func (rw *relationWalker) visitArrow(namespaceName string, arrow *core.TupleToUserset) error {
+	if err := rw.visit(namespaceName, arrow.Tupleset.Relation); err != nil {
+		return err
+	}
+
+	tupleset, err := rw.lookupRelation(namespaceName, arrow.Tupleset.Relation)
+	if err != nil || tupleset == nil {
+		return err
+	}
+
+	for _, allowed := range tupleset.GetTypeInformation().GetAllowedDirectRelations() {
+		if err := rw.visit(allowed.Namespace, arrow.ComputedUserset.Relation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupRelation returns the relation in the namespace, or nil if it does not exist.
+func (rw *relationWalker) lookupRelation(namespaceName string, relationName string) (*core.Relation, error) {
+	nsDef, ok := rw.namespaces[namespaceName]
+	if !ok {
+		var err error
+		nsDef, _, err = rw.reader.ReadNamespaceByName(rw.ctx, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+		rw.namespaces[namespaceName] = nsDef
+	}
+
+	for _, relation := range nsDef.Relation {
+		if relation.Name == relationName {
+			return relation, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,335 @@
+package caching
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
+	"github.com/authzed/spicedb/internal/testfixtures"
+	"github.com/authzed/spicedb/pkg/datastore"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+const changeTrackerTestSchema = `
+	definition user {}
+
+	definition group {
+		relation member: user | group#member
+	}
+
+	definition folder {
+		relation viewer: user
+		relation banned: user
+		permission view = viewer - banned
+	}
+
+	definition document {
+		relation parent: folder
+		relation viewer: user | group#member
+		relation editor: user
+		permission edit = editor
+		permission view = viewer + edit + parent->view
+	}
+
+	definition unrelated {
+		relation viewer: user
+	}
+`
+
+func TestReachableRelations(t *testing.T) {
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	ds, revision := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, changeTrackerTestSchema, nil, require.New(t))
+
+	tcs := []struct {
+		resourceRelation *core.RelationReference
+		expected         []string
+	}{
+		{RR("document", "edit"), []string{"document#editor"}},
+		{RR("document", "view"), []string{
+			"document#editor",
+			"document#parent",
+			"document#viewer",
+			"folder#banned",
+			"folder#viewer",
+			"group#member",
+		}},
+		{RR("folder", "view"), []string{"folder#banned", "folder#viewer"}},
+		{RR("group", "member"), []string{"group#member"}},
+		{RR("unrelated", "viewer"), []string{"unrelated#viewer"}},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tuple.StringRR(tc.resourceRelation), func(t *testing.T) {
+			reachable, err := reachableRelations(context.Background(), ds.SnapshotReader(revision), tc.resourceRelation)
+			require.NoError(t, err)
+
+			slices.Sort(reachable)
+			require.Equal(t, tc.expected, reachable)
+		})
+	}
+}
+
+func startedChangeTracker(t *testing.T) (*ChangeTracker, datastore.Datastore, datastore.Revision) {
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	ds, revision := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, changeTrackerTestSchema, nil, require.New(t))
+
+	tracker := NewChangeTracker(ds, 0)
+	require.NoError(t, tracker.Start(context.Background()))
+	t.Cleanup(func() {
+		require.NoError(t, tracker.Close())
+	})
+
+	requireStableRevision(t, tracker, revision, RR("document", "view"), revision)
+	return tracker, ds, revision
+}
+
+func requireStableRevision(t *testing.T, tracker *ChangeTracker, revision datastore.Revision, resourceRelation *core.RelationReference, expected datastore.Revision) {
+	require.Eventually(t, func() bool {
+		stableRevision, ok := tracker.StableRevision(context.Background(), revision.String(), resourceRelation)
+		return ok && stableRevision == expected.String()
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func writeRelationship(t *testing.T, ds datastore.Datastore, relationship string) datastore.Revision {
+	revision, err := common.WriteTuples(context.Background(), ds, core.RelationTupleUpdate_TOUCH, tuple.MustParse(relationship))
+	require.NoError(t, err)
+	return revision
+}
+
+func TestChangeTrackerStableRevision(t *testing.T) {
+	tracker, ds, startRevision := startedChangeTracker(t)
+
+	// Changes to relations which cannot be reached do not move the stable revision.
+	unrelatedRevision := writeRelationship(t, ds, "unrelated:first#viewer@user:tom")
+	requireStableRevision(t, tracker, unrelatedRevision, RR("document", "view"), startRevision)
+	requireStableRevision(t, tracker, unrelatedRevision, RR("unrelated", "viewer"), unrelatedRevision)
+
+	// Changes to relations reached via subject relations and arrows do.
+	groupRevision := writeRelationship(t, ds, "group:first#member@user:tom")
+	requireStableRevision(t, tracker, groupRevision, RR("document", "view"), groupRevision)
+	requireStableRevision(t, tracker, groupRevision, RR("document", "edit"), startRevision)
+
+	folderRevision := writeRelationship(t, ds, "folder:first#banned@user:tom")
+	requireStableRevision(t, tracker, folderRevision, RR("document", "view"), folderRevision)
+	requireStableRevision(t, tracker, folderRevision, RR("folder", "view"), folderRevision)
+	requireStableRevision(t, tracker, folderRevision, RR("group", "member"), groupRevision)
+
+	// Requests at revisions before the latest relevant change are not mapped.
+	_, ok := tracker.StableRevision(context.Background(), groupRevision.String(), RR("folder", "view"))
+	require.False(t, ok)
+
+	// Nor are requests at revisions before the tracker started.
+	_, ok = tracker.StableRevision(context.Background(), "1", RR("document", "view"))
+	require.False(t, ok)
+}
+
+func TestChangeTrackerTenants(t *testing.T) {
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	first, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(proxy.NewTenantDatastoreProxy(rawDS, "first"), changeTrackerTestSchema, nil, require.New(t))
+	second, startRevision := testfixtures.DatastoreFromSchemaAndTestRelationships(proxy.NewTenantDatastoreProxy(rawDS, "second"), changeTrackerTestSchema, nil, require.New(t))
+
+	tracker := NewChangeTracker(rawDS, 0)
+	require.NoError(t, tracker.Start(context.Background()))
+	t.Cleanup(func() {
+		require.NoError(t, tracker.Close())
+	})
+
+	firstCtx := tenantmw.ContextWithTenant(context.Background(), "first")
+	secondCtx := tenantmw.ContextWithTenant(context.Background(), "second")
+
+	requireStableRevisionForTenant := func(ctx context.Context, revision datastore.Revision, expected datastore.Revision) {
+		require.Eventually(t, func() bool {
+			stableRevision, ok := tracker.StableRevision(ctx, revision.String(), RR("document", "view"))
+			return ok && stableRevision == expected.String()
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	requireStableRevisionForTenant(firstCtx, startRevision, startRevision)
+
+	// Changes to the relationships of a tenant only move the stable revisions of that tenant.
+	secondRevision := writeRelationship(t, second, "document:somedoc#viewer@user:tom")
+	requireStableRevisionForTenant(firstCtx, secondRevision, startRevision)
+	requireStableRevisionForTenant(secondCtx, secondRevision, secondRevision)
+
+	firstRevision := writeRelationship(t, first, "document:somedoc#viewer@user:tom")
+	requireStableRevisionForTenant(firstCtx, firstRevision, firstRevision)
+	requireStableRevisionForTenant(secondCtx, firstRevision, secondRevision)
+}
+
+func TestChangeTrackerSchemaChange(t *testing.T) {
+	tracker, ds, _ := startedChangeTracker(t)
+
+	schemaRevision, err := ds.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		return rwt.WriteNamespaces(ctx, &core.NamespaceDefinition{Name: "another"})
+	})
+	require.NoError(t, err)
+
+	requireStableRevision(t, tracker, schemaRevision, RR("document", "view"), schemaRevision)
+	requireStableRevision(t, tracker, schemaRevision, RR("unrelated", "viewer"), schemaRevision)
+}
+
+func TestChangeTrackerCachedAcrossRevisions(t *testing.T) {
+	require := require.New(t)
+
+	tracker, ds, startRevision := startedChangeTracker(t)
+	unrelatedRevision := writeRelationship(t, ds, "unrelated:first#viewer@user:tom")
+	requireStableRevision(t, tracker, unrelatedRevision, RR("document", "view"), startRevision)
+
+	requestAt := func(revision datastore.Revision) *v1.DispatchCheckRequest {
+		return &v1.DispatchCheckRequest{
+			ResourceRelation: RR("document", "view"),
+			ResourceIds:      []string{"first"},
+			Subject:          tuple.ParseSubjectONR("user:tom"),
+			Metadata: &v1.ResolverMeta{
+				AtRevision:     revision.String(),
+				DepthRemaining: 50,
+			},
+		}
+	}
+
+	delegate := delegateDispatchMock{&mock.Mock{}}
+	delegate.On("DispatchCheck", requestAt(startRevision)).Return(&v1.DispatchCheckResponse{
+		ResultsByResourceId: map[string]*v1.ResourceCheckResult{
+			"first": {Membership: v1.ResourceCheckResult_MEMBER},
+		},
+		Metadata: &v1.ResponseMeta{DispatchCount: 1, DepthRequired: 1},
+	}, nil).Times(1)
+
+	dispatcher, err := NewCachingDispatcher(DispatchTestCache(t), false, "", nil)
+	require.NoError(err)
+	dispatcher.SetDelegate(delegate)
+	dispatcher.SetChangeTracker(tracker)
+	defer dispatcher.Close()
+
+	_, err = dispatcher.DispatchCheck(context.Background(), requestAt(startRevision))
+	require.NoError(err)
+
+	// Wait for the cache to converge.
+	time.Sleep(10 * time.Millisecond)
+
+	// The result computed at the start revision remains valid at the later revision.
+	resp, err := dispatcher.DispatchCheck(context.Background(), requestAt(unrelatedRevision))
+	require.NoError(err)
+	require.Equal(v1.ResourceCheckResult_MEMBER, resp.ResultsByResourceId["first"].Membership)
+	require.Equal(uint32(1), resp.Metadata.CachedDispatchCount)
+
+	delegate.AssertExpectations(t)
+}
+
+// concurrentRevision is a partially ordered revision, like those of postgres: a revision is
+// before another only if both of its counters are at or before those of the other.
+type concurrentRevision struct {
+	first, second uint64
+}
+
+func (cr concurrentRevision) String() string {
+	return fmt.Sprintf("%d.%d", cr.first, cr.second)
+}
+
+func (cr concurrentRevision) Equal(rhs datastore.Revision) bool {
+	return cr == rhs
+}
+
+func (cr concurrentRevision) GreaterThan(rhs datastore.Revision) bool {
+	other, ok := rhs.(concurrentRevision)
+	return ok && other.LessThan(cr)
+}
+
+func (cr concurrentRevision) LessThan(rhs datastore.Revision) bool {
+	other, ok := rhs.(concurrentRevision)
+	return ok && cr != other && cr.first <= other.first && cr.second <= other.second
+}
+
+type concurrentRevisionDatastore struct {
+	datastore.Datastore
+}
+
+func (concurrentRevisionDatastore) RevisionFromString(serialized string) (datastore.Revision, error) {
+	var revision concurrentRevision
+	_, err := fmt.Sscanf(serialized, "%d.%d", &revision.first, &revision.second)
+	return revision, err
+}
+
+func TestChangeTrackerConcurrentRevisions(t *testing.T) {
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	tracker := NewChangeTracker(concurrentRevisionDatastore{rawDS}, 0)
+	start := concurrentRevision{1, 1}
+	tracker.active = true
+	tracker.startRevision = start
+	tracker.checkpointRevision = start
+	tracker.schemaChangedAt = []datastore.Revision{start}
+	tracker.changedAt = map[string][]datastore.Revision{}
+	tracker.reachable = map[string][]string{
+		"document#view":   {"document#viewer", "folder#viewer"},
+		"document#viewer": {"document#viewer"},
+	}
+
+	applyChanges := func(revision concurrentRevision, relationships ...string) {
+		change := &datastore.RevisionChanges{Revision: revision}
+		for _, relationship := range relationships {
+			change.RelationshipChanges = append(change.RelationshipChanges, &core.RelationTupleUpdate{
+				Operation: core.RelationTupleUpdate_TOUCH,
+				Tuple:     tuple.MustParse(relationship),
+			})
+		}
+		tracker.applyChange(change)
+		tracker.applyChange(&datastore.RevisionChanges{Revision: revision, IsCheckpoint: true})
+	}
+
+	stableRevision := func(revision concurrentRevision, resourceRelation *core.RelationReference) (string, bool) {
+		return tracker.StableRevision(context.Background(), revision.String(), resourceRelation)
+	}
+
+	// Two concurrent changes to the same relation, followed by a checkpoint covering both.
+	applyChanges(concurrentRevision{2, 1}, "document:first#viewer@user:tom")
+	applyChanges(concurrentRevision{1, 2}, "document:second#viewer@user:tom")
+	applyChanges(concurrentRevision{3, 3})
+
+	// A revision which observes only one of the changes is not mapped, as the other change is
+	// concurrent with it rather than after it.
+	_, ok := stableRevision(concurrentRevision{2, 1}, RR("document", "viewer"))
+	require.False(t, ok)
+
+	// Nor is a revision which observes both, as neither change is known to be the latest.
+	_, ok = stableRevision(concurrentRevision{3, 3}, RR("document", "viewer"))
+	require.False(t, ok)
+
+	// A change after both of them is known to be the latest.
+	applyChanges(concurrentRevision{4, 4}, "document:third#viewer@user:tom")
+	applyChanges(concurrentRevision{6, 6})
+
+	stable, ok := stableRevision(concurrentRevision{5, 5}, RR("document", "viewer"))
+	require.True(t, ok)
+	require.Equal(t, "4.4", stable)
+
+	// A change to a reachable relation concurrent with the latest change is not ordered with it.
+	applyChanges(concurrentRevision{5, 3}, "folder:first#viewer@user:tom")
+	applyChanges(concurrentRevision{6, 6})
+
+	_, ok = stableRevision(concurrentRevision{6, 6}, RR("document", "view"))
+	require.False(t, ok)
+
+	stable, ok = stableRevision(concurrentRevision{6, 6}, RR("document", "viewer"))
+	require.True(t, ok)
+	require.Equal(t, "4.4", stable)
+}
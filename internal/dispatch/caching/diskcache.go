@@ -0,0 +1,271 @@
+package caching
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+
+	log "github.com/authzed/spicedb/internal/logging"
+)
+
+const (
+	diskCacheTempPrefix      = ".tmp-"
+	diskCacheChecksumLength  = 8
+	diskCachePendingWrites   = 1024
+	minDiskCacheCleanupDelay = 30 * time.Second
+)
+
+// DiskCache is a SecondTierCache which stores entries as files in a directory, allowing the
+// entries to be shared by all processes on a node and to survive process restarts.
+//
+// Each entry holds a checksum, followed by the key it was set under and the value. Entries which
+// fail the checksum are removed, while entries under a different key are treated as missing.
+//
+// Entries are written asynchronously and are dropped if too many writes are pending. Entries
+// older than the maximum age are ignored, and are removed alongside the oldest entries beyond
+// the maximum size by a periodic cleanup.
+type DiskCache struct {
+	dir     string
+	maxAge  time.Duration
+	maxSize uint64
+
+	writes chan diskCacheWrite
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+type diskCacheWrite struct {
+	key   []byte
+	value []byte
+}
+
+// NewDiskCache creates a new DiskCache storing its entries in the given directory, which is
+// created if necessary. A maxSize of zero disables the size bound.
+func NewDiskCache(dir string, maxAge time.Duration, maxSize uint64) (*DiskCache, error) {
+	if maxAge <= 0 {
+		return nil, errors.New("disk cache requires a positive maximum entry age")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create disk cache directory: %w", err)
+	}
+
+	dc := &DiskCache{
+		dir:     dir,
+		maxAge:  maxAge,
+		maxSize: maxSize,
+		writes:  make(chan diskCacheWrite, diskCachePendingWrites),
+		closed:  make(chan struct{}),
+	}
+
+	dc.wg.Add(2)
+	go dc.runWriter()
+	go dc.runCleanup()
+	return dc, nil
+}
+
+// Get implements SecondTierCache.
+func (dc *DiskCache) Get(key []byte) ([]byte, bool) {
+	path := dc.pathFor(key)
+
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > dc.maxAge {
+		return nil, false
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil || len(contents) < diskCacheChecksumLength {
+		return nil, false
+	}
+
+	entry := contents[diskCacheChecksumLength:]
+	if binary.BigEndian.Uint64(contents) != xxhash.Sum64(entry) {
+		log.Warn().Str("path", path).Msg("removing corrupted dispatch cache entry")
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	keyLength, n := binary.Uvarint(entry)
+	if n <= 0 || keyLength > uint64(len(entry)-n) {
+		return nil, false
+	}
+
+	if !bytes.Equal(entry[n:n+int(keyLength)], key) {
+		return nil, false
+	}
+
+	return entry[n+int(keyLength):], true
+}
+
+// Set implements SecondTierCache.
+func (dc *DiskCache) Set(key []byte, value []byte) {
+	select {
+	case <-dc.closed:
+	case dc.writes <- diskCacheWrite{key, value}:
+	default:
+		// Drop the write rather than blocking the dispatch.
+	}
+}
+
+// Close implements SecondTierCache. Pending writes are dropped.
+func (dc *DiskCache) Close() error {
+	close(dc.closed)
+	dc.wg.Wait()
+	return nil
+}
+
+func (dc *DiskCache) pathFor(key []byte) string {
+	encoded := hex.EncodeToString(key)
+	return filepath.Join(dc.dir, encoded[:2], encoded)
+}
+
+func (dc *DiskCache) runWriter() {
+	defer dc.wg.Done()
+
+	for {
+		select {
+		case <-dc.closed:
+			return
+		case write := <-dc.writes:
+			if err := dc.write(write.key, write.value); err != nil {
+				log.Warn().Err(err).Msg("failed to write dispatch cache entry")
+			}
+		}
+	}
+}
+
+// write writes the entry into a temporary file, which is then renamed into place so that
+// readers never observe partially written entries.
+func (dc *DiskCache) write(key []byte, value []byte) error {
+	path := dc.pathFor(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), diskCacheTempPrefix+"*")
+	if err != nil {
+		return err
+	}
+
+	entry := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(key)+len(value)), uint64(len(key)))
+	entry = append(entry, key...)
+	entry = append(entry, value...)
+
+	contents := binary.BigEndian.AppendUint64(make([]byte, 0, diskCacheChecksumLength+len(entry)), xxhash.Sum64(entry))
+	contents = append(contents, entry...)
+
+	if _, err := file.Write(contents); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (dc *DiskCache) runCleanup() {
+	defer dc.wg.Done()
+
+	ticker := time.NewTicker(max(dc.maxAge, minDiskCacheCleanupDelay))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dc.closed:
+			return
+		case <-ticker.C:
+			if err := dc.cleanup(); err != nil {
+				log.Warn().Err(err).Msg("failed to clean up dispatch cache directory")
+			}
+		}
+	}
+}
+
+type diskCacheEntry struct {
+	path    string
+	modTime time.Time
+	size    uint64
+}
+
+// cleanup removes all expired entries and, if the remaining entries exceed the maximum size,
+// the oldest entries until they fit.
+func (dc *DiskCache) cleanup() error {
+	var (
+		entries   []diskCacheEntry
+		totalSize uint64
+	)
+	err := filepath.WalkDir(dc.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Removed by another process sharing the directory.
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if time.Since(info.ModTime()) > dc.maxAge {
+			_ = os.Remove(path)
+			return nil
+		}
+
+		if strings.HasPrefix(d.Name(), diskCacheTempPrefix) {
+			return nil
+		}
+
+		entries = append(entries, diskCacheEntry{path, info.ModTime(), uint64(info.Size())})
+		totalSize += uint64(info.Size())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if dc.maxSize == 0 || totalSize <= dc.maxSize {
+		return nil
+	}
+
+	slices.SortFunc(entries, func(a, b diskCacheEntry) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	for _, entry := range entries {
+		if totalSize <= dc.maxSize {
+			break
+		}
+
+		_ = os.Remove(entry.path)
+		totalSize -= entry.size
+	}
+
+	return nil
+}
+
+var _ SecondTierCache = (*DiskCache)(nil)
@@ -0,0 +1,154 @@
+package caching
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskCacheGetAndSet(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), time.Hour, 0)
+	require.NoError(t, err)
+	defer dc.Close()
+
+	_, found := dc.Get([]byte("somekey"))
+	require.False(t, found)
+
+	dc.Set([]byte("somekey"), []byte("somevalue"))
+	require.Eventually(t, func() bool {
+		_, found := dc.Get([]byte("somekey"))
+		return found
+	}, time.Second, 10*time.Millisecond)
+
+	value, _ := dc.Get([]byte("somekey"))
+	require.Equal(t, []byte("somevalue"), value)
+
+	_, found = dc.Get([]byte("anotherkey"))
+	require.False(t, found)
+}
+
+func TestDiskCacheSharedDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := NewDiskCache(dir, time.Hour, 0)
+	require.NoError(t, err)
+
+	first.Set([]byte("somekey"), []byte("somevalue"))
+	require.Eventually(t, func() bool {
+		_, found := first.Get([]byte("somekey"))
+		return found
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, first.Close())
+
+	second, err := NewDiskCache(dir, time.Hour, 0)
+	require.NoError(t, err)
+	defer second.Close()
+
+	value, found := second.Get([]byte("somekey"))
+	require.True(t, found)
+	require.Equal(t, []byte("somevalue"), value)
+}
+
+func TestDiskCacheExpiration(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), time.Hour, 0)
+	require.NoError(t, err)
+	defer dc.Close()
+
+	require.NoError(t, dc.write([]byte("somekey"), []byte("somevalue")))
+	_, found := dc.Get([]byte("somekey"))
+	require.True(t, found)
+
+	past := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(dc.pathFor([]byte("somekey")), past, past))
+
+	_, found = dc.Get([]byte("somekey"))
+	require.False(t, found)
+
+	require.NoError(t, dc.cleanup())
+	_, err = os.Stat(dc.pathFor([]byte("somekey")))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDiskCacheCorruptedEntry(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), time.Hour, 0)
+	require.NoError(t, err)
+	defer dc.Close()
+
+	require.NoError(t, dc.write([]byte("somekey"), []byte("somevalue")))
+
+	path := dc.pathFor([]byte("somekey"))
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	contents[len(contents)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+
+	_, found := dc.Get([]byte("somekey"))
+	require.False(t, found)
+
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDiskCacheMismatchedKey(t *testing.T) {
+	dc, err := NewDiskCache(t.TempDir(), time.Hour, 0)
+	require.NoError(t, err)
+	defer dc.Close()
+
+	require.NoError(t, dc.write([]byte("somekey"), []byte("somevalue")))
+
+	// Place the entry at the location of another key, as if their locations collided.
+	contents, err := os.ReadFile(dc.pathFor([]byte("somekey")))
+	require.NoError(t, err)
+
+	path := dc.pathFor([]byte("anotherkey"))
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, contents, 0o600))
+
+	_, found := dc.Get([]byte("anotherkey"))
+	require.False(t, found)
+
+	value, found := dc.Get([]byte("somekey"))
+	require.True(t, found)
+	require.Equal(t, []byte("somevalue"), value)
+}
+
+func TestDiskCacheMaxSize(t *testing.T) {
+	dir := t.TempDir()
+
+	// Each entry takes 23 bytes: an 8 byte checksum, a 1 byte key length, a 4 byte key and a 10
+	// byte value.
+	dc, err := NewDiskCache(dir, time.Hour, 50)
+	require.NoError(t, err)
+	defer dc.Close()
+
+	keys := []string{"key1", "key2", "key3", "key4"}
+	for index, key := range keys {
+		require.NoError(t, dc.write([]byte(key), []byte("0123456789")))
+
+		modTime := time.Now().Add(time.Duration(index-len(keys)) * time.Minute)
+		require.NoError(t, os.Chtimes(dc.pathFor([]byte(key)), modTime, modTime))
+	}
+
+	stale, err := os.Create(filepath.Join(dir, diskCacheTempPrefix+"stale"))
+	require.NoError(t, err)
+	require.NoError(t, stale.Close())
+	past := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(stale.Name(), past, past))
+
+	require.NoError(t, dc.cleanup())
+
+	for _, key := range keys[:2] {
+		_, found := dc.Get([]byte(key))
+		require.False(t, found, "expected %s to be removed", key)
+	}
+	for _, key := range keys[2:] {
+		_, found := dc.Get([]byte(key))
+		require.True(t, found, "expected %s to be kept", key)
+	}
+
+	_, err = os.Stat(stale.Name())
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,148 @@
+package caching
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/authzed/spicedb/internal/dispatch/keys"
+)
+
+// SecondTierCache is a cache of encoded dispatch results which is consulted by the Dispatcher
+// when a result is missing from its in-process cache. Unlike the in-process cache, a second tier
+// cache is expected to be shared between processes and to outlive them, so that a restarted
+// process does not start with a cold cache.
+//
+// Entries are keyed by the full stable dispatch cache key, which includes the revision at which
+// the dispatch was made, so entries remain valid for as long as they are retained. Implementations
+// must store the key alongside the value and treat an entry stored under a different key, such as
+// one whose hashed or truncated location collides, as missing.
+type SecondTierCache interface {
+	// Get returns the value for the given key, if it exists.
+	Get(key []byte) ([]byte, bool)
+
+	// Set sets the value for the given key. Implementations are free to drop values.
+	Set(key []byte, value []byte)
+
+	// Close closes the cache and its background workers (if any).
+	Close() error
+}
+
+// SetSecondTierCache sets the cache consulted when a result is missing from the in-process
+// cache of the dispatcher. The second tier cache is not closed when the dispatcher is closed,
+// as it may be shared between dispatchers.
+func (cd *Dispatcher) SetSecondTierCache(secondTier SecondTierCache) {
+	cd.secondTier = secondTier
+}
+
+// getCachedBytes returns the cached value for the key, falling back to the second tier cache (if
+// any). Values found in the second tier cache are added to the in-process cache.
+func (cd *Dispatcher) getCachedBytes(key keys.DispatchCacheKey) ([]byte, bool) {
+	if cachedResultRaw, found := cd.c.Get(key); found {
+		return cachedResultRaw.([]byte), true
+	}
+
+	if cd.secondTier == nil {
+		return nil, false
+	}
+
+	value, found := cd.secondTier.Get(key.StableKeyAsBytes())
+	if !found {
+		return nil, false
+	}
+
+	cd.c.Set(key, value, sliceSize(value))
+	return value, true
+}
+
+// setCachedBytes sets the value for the key in both the in-process and second tier caches.
+func (cd *Dispatcher) setCachedBytes(key keys.DispatchCacheKey, value []byte) {
+	cd.c.Set(key, value, sliceSize(value))
+	if cd.secondTier != nil {
+		cd.secondTier.Set(key.StableKeyAsBytes(), value)
+	}
+}
+
+// getCachedResults returns the cached streamed results for the key, falling back to the second
+// tier cache (if any). Results found in the second tier cache are added to the in-process cache.
+func (cd *Dispatcher) getCachedResults(key keys.DispatchCacheKey) ([][]byte, bool) {
+	if cachedResultRaw, found := cd.c.Get(key); found {
+		return cachedResultRaw.([][]byte), true
+	}
+
+	if cd.secondTier == nil {
+		return nil, false
+	}
+
+	value, found := cd.secondTier.Get(key.StableKeyAsBytes())
+	if !found {
+		return nil, false
+	}
+
+	results, err := decodeResults(value)
+	if err != nil {
+		return nil, false
+	}
+
+	cd.c.Set(key, results, resultsSize(results))
+	return results, true
+}
+
+// setCachedResults sets the streamed results for the key in both the in-process and second tier
+// caches.
+func (cd *Dispatcher) setCachedResults(key keys.DispatchCacheKey, results [][]byte) {
+	cd.c.Set(key, results, resultsSize(results))
+	if cd.secondTier != nil {
+		cd.secondTier.Set(key.StableKeyAsBytes(), encodeResults(results))
+	}
+}
+
+func resultsSize(results [][]byte) int64 {
+	var size int64
+	for _, slice := range results {
+		size += sliceSize(slice)
+	}
+	return size
+}
+
+var errMalformedResults = errors.New("malformed cached results")
+
+// encodeResults encodes streamed results as their count, followed by each result prefixed
+// with its length.
+func encodeResults(results [][]byte) []byte {
+	size := binary.MaxVarintLen64
+	for _, result := range results {
+		size += binary.MaxVarintLen64 + len(result)
+	}
+
+	encoded := binary.AppendUvarint(make([]byte, 0, size), uint64(len(results)))
+	for _, result := range results {
+		encoded = binary.AppendUvarint(encoded, uint64(len(result)))
+		encoded = append(encoded, result...)
+	}
+	return encoded
+}
+
+// decodeResults decodes streamed results encoded by encodeResults.
+func decodeResults(encoded []byte) ([][]byte, error) {
+	count, n := binary.Uvarint(encoded)
+	if n <= 0 || count > uint64(len(encoded)) {
+		return nil, errMalformedResults
+	}
+	encoded = encoded[n:]
+
+	results := make([][]byte, 0, count)
+	for i := uint64(0); i < count; i++ {
+		length, n := binary.Uvarint(encoded)
+		if n <= 0 || length > uint64(len(encoded)-n) {
+			return nil, errMalformedResults
+		}
+
+		results = append(results, encoded[n:n+int(length)])
+		encoded = encoded[n+int(length):]
+	}
+
+	if len(encoded) != 0 {
+		return nil, errMalformedResults
+	}
+	return results, nil
+}
@@ -0,0 +1,109 @@
+package caching
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+func TestEncodeDecodeResults(t *testing.T) {
+	for _, results := range [][][]byte{
+		{},
+		{{}},
+		{[]byte("first")},
+		{[]byte("first"), {}, []byte("third")},
+	} {
+		decoded, err := decodeResults(encodeResults(results))
+		require.NoError(t, err)
+		require.Equal(t, len(results), len(decoded))
+		for index, result := range results {
+			require.Equal(t, string(result), string(decoded[index]))
+		}
+	}
+
+	encoded := encodeResults([][]byte{[]byte("first"), []byte("second")})
+	_, err := decodeResults(encoded[:len(encoded)-1])
+	require.ErrorIs(t, err, errMalformedResults)
+
+	_, err = decodeResults(append(encoded, 0))
+	require.ErrorIs(t, err, errMalformedResults)
+}
+
+type mapSecondTierCache struct {
+	sync.Mutex
+	entries map[string][]byte
+}
+
+func (m *mapSecondTierCache) Get(key []byte) ([]byte, bool) {
+	m.Lock()
+	defer m.Unlock()
+	value, found := m.entries[string(key)]
+	return value, found
+}
+
+func (m *mapSecondTierCache) Set(key []byte, value []byte) {
+	m.Lock()
+	defer m.Unlock()
+	m.entries[string(key)] = value
+}
+
+func (m *mapSecondTierCache) Close() error {
+	return nil
+}
+
+func TestSecondTierCacheSharedBetweenDispatchers(t *testing.T) {
+	require := require.New(t)
+
+	secondTier := &mapSecondTierCache{entries: map[string][]byte{}}
+	req := &v1.DispatchCheckRequest{
+		ResourceRelation: RR("document", "read"),
+		ResourceIds:      []string{"doc1"},
+		Subject:          tuple.ParseSubjectONR("user:user1#..."),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     "1",
+			DepthRemaining: 50,
+		},
+	}
+
+	// The first dispatcher computes the result and stores it in both tiers.
+	delegate := delegateDispatchMock{&mock.Mock{}}
+	delegate.On("DispatchCheck", req).Return(&v1.DispatchCheckResponse{
+		ResultsByResourceId: map[string]*v1.ResourceCheckResult{
+			"doc1": {Membership: v1.ResourceCheckResult_MEMBER},
+		},
+		Metadata: &v1.ResponseMeta{DispatchCount: 1, DepthRequired: 1},
+	}, nil).Times(1)
+
+	first, err := NewCachingDispatcher(DispatchTestCache(t), false, "", nil)
+	require.NoError(err)
+	first.SetDelegate(delegate)
+	first.SetSecondTierCache(secondTier)
+	defer first.Close()
+
+	resp, err := first.DispatchCheck(context.Background(), req)
+	require.NoError(err)
+	require.Equal(v1.ResourceCheckResult_MEMBER, resp.ResultsByResourceId["doc1"].Membership)
+	require.Len(secondTier.entries, 1)
+
+	// The second dispatcher, such as one in a restarted process, has an empty in-process
+	// cache and must find the result in the second tier.
+	second, err := NewCachingDispatcher(DispatchTestCache(t), false, "", nil)
+	require.NoError(err)
+	second.SetDelegate(delegateDispatchMock{&mock.Mock{}})
+	second.SetSecondTierCache(secondTier)
+	defer second.Close()
+
+	resp, err = second.DispatchCheck(context.Background(), req)
+	require.NoError(err)
+	require.Equal(v1.ResourceCheckResult_MEMBER, resp.ResultsByResourceId["doc1"].Membership)
+	require.Equal(uint32(0), resp.Metadata.DispatchCount)
+	require.Equal(uint32(1), resp.Metadata.CachedDispatchCount)
+
+	delegate.AssertExpectations(t)
+}
@@ -17,6 +17,9 @@ type optionState struct {
 	metricsEnabled        bool
 	prometheusSubsystem   string
 	cache                 cache.Cache
+	secondTierCache       caching.SecondTierCache
+	changeTracker         *caching.ChangeTracker
+	adaptiveConcurrency   *graph.AdaptiveConcurrency
 	concurrencyLimits     graph.ConcurrencyLimits
 	remoteDispatchTimeout time.Duration
 }
@@ -42,6 +45,32 @@ func Cache(c cache.Cache) Option {
 	}
 }
 
+// SecondTierCache sets the cache consulted by the remote dispatcher when a result is
+// missing from its cache.
+func SecondTierCache(c caching.SecondTierCache) Option {
+	return func(state *optionState) {
+		state.secondTierCache = c
+	}
+}
+
+// ChangeTracker sets the tracker used by the remote dispatcher to keep cached results
+// valid across revisions for as long as the relationships they depend on are
+// unchanged.
+func ChangeTracker(ct *caching.ChangeTracker) Option {
+	return func(state *optionState) {
+		state.changeTracker = ct
+	}
+}
+
+// AdaptiveConcurrency sets the controller adjusting the number of goroutines
+// per operation, within the concurrency limits, and shedding load when the
+// node is saturated.
+func AdaptiveConcurrency(ac *graph.AdaptiveConcurrency) Option {
+	return func(state *optionState) {
+		state.adaptiveConcurrency = ac
+	}
+}
+
 // ConcurrencyLimits sets the max number of goroutines per operation
 func ConcurrencyLimits(limits graph.ConcurrencyLimits) Option {
 	return func(state *optionState) {
@@ -67,6 +96,9 @@ func NewClusterDispatcher(dispatch dispatch.Dispatcher, options ...Option) (disp
 	}
 
 	clusterDispatch := graph.NewDispatcher(dispatch, opts.concurrencyLimits)
+	if opts.adaptiveConcurrency != nil {
+		clusterDispatch = graph.NewAdaptiveDispatcher(dispatch, opts.adaptiveConcurrency)
+	}
 
 	if opts.prometheusSubsystem == "" {
 		opts.prometheusSubsystem = "dispatch"
@@ -76,6 +108,8 @@ func NewClusterDispatcher(dispatch dispatch.Dispatcher, options ...Option) (disp
 	if err != nil {
 		return nil, err
 	}
+	cachingClusterDispatch.SetSecondTierCache(opts.secondTierCache)
+	cachingClusterDispatch.SetChangeTracker(opts.changeTracker)
 	cachingClusterDispatch.SetDelegate(clusterDispatch)
 	return cachingClusterDispatch, nil
 }
@@ -32,6 +32,9 @@ type optionState struct {
 	grpcPresharedKey       string
 	grpcDialOpts           []grpc.DialOption
 	cache                  cache.Cache
+	secondTierCache        caching.SecondTierCache
+	changeTracker          *caching.ChangeTracker
+	adaptiveConcurrency    *graph.AdaptiveConcurrency
 	concurrencyLimits      graph.ConcurrencyLimits
 	remoteDispatchTimeout  time.Duration
 	secondaryUpstreamAddrs map[string]string
@@ -107,6 +110,32 @@ func Cache(c cache.Cache) Option {
 	}
 }
 
+// SecondTierCache sets the cache consulted by the dispatcher when a result is
+// missing from its cache.
+func SecondTierCache(c caching.SecondTierCache) Option {
+	return func(state *optionState) {
+		state.secondTierCache = c
+	}
+}
+
+// ChangeTracker sets the tracker used by the dispatcher to keep cached results
+// valid across revisions for as long as the relationships they depend on are
+// unchanged.
+func ChangeTracker(ct *caching.ChangeTracker) Option {
+	return func(state *optionState) {
+		state.changeTracker = ct
+	}
+}
+
+// AdaptiveConcurrency sets the controller adjusting the number of goroutines
+// per operation, within the concurrency limits, and shedding load when the
+// node is saturated.
+func AdaptiveConcurrency(ac *graph.AdaptiveConcurrency) Option {
+	return func(state *optionState) {
+		state.adaptiveConcurrency = ac
+	}
+}
+
 // ConcurrencyLimits sets the max number of goroutines per operation
 func ConcurrencyLimits(limits graph.ConcurrencyLimits) Option {
 	return func(state *optionState) {
@@ -139,8 +168,13 @@ func NewDispatcher(options ...Option) (dispatch.Dispatcher, error) {
 	if err != nil {
 		return nil, err
 	}
+	cachingRedispatch.SetSecondTierCache(opts.secondTierCache)
+	cachingRedispatch.SetChangeTracker(opts.changeTracker)
 
 	redispatch := graph.NewDispatcher(cachingRedispatch, opts.concurrencyLimits)
+	if opts.adaptiveConcurrency != nil {
+		redispatch = graph.NewAdaptiveDispatcher(cachingRedispatch, opts.adaptiveConcurrency)
+	}
 	redispatch = singleflight.New(redispatch, &keys.CanonicalKeyHandler{})
 
 	// If an upstream is specified, create a cluster dispatcher.
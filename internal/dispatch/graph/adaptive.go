@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	"github.com/authzed/spicedb/internal/dispatch"
+	"github.com/authzed/spicedb/internal/taskrunner"
+)
+
+const (
+	defaultLatencyTolerance = 2.0
+
+	// recentLatencyWeight is the weight of each sample in the moving average of the recent
+	// datastore latency.
+	recentLatencyWeight = 0.1
+
+	// baselineLatencyWeight is the weight of each sample above the baseline in the moving
+	// average of the baseline datastore latency, which therefore only grows slowly.
+	baselineLatencyWeight = 0.001
+
+	// limitDecreaseFactor is the factor applied to the limit when the node is congested.
+	limitDecreaseFactor = 0.9
+)
+
+var (
+	adaptiveConcurrencyLimitGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "spicedb",
+		Subsystem: "dispatch",
+		Name:      "adaptive_concurrency_limit",
+		Help:      "current adaptive concurrency limit of each dispatch operation",
+	}, []string{"operation"})
+
+	shedDispatchCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "spicedb",
+		Subsystem: "dispatch",
+		Name:      "shed_total",
+		Help:      "number of dispatches rejected because the node was saturated",
+	}, []string{"operation"})
+)
+
+// AdaptiveConcurrencyConfig configures the adaptive concurrency of dispatchers.
+type AdaptiveConcurrencyConfig struct {
+	// MinimumLimit is the lowest concurrency limit of any operation. Defaults to 1.
+	MinimumLimit uint16
+
+	// LatencyTolerance is the ratio between the recent and the baseline datastore latencies
+	// above which the node is considered congested. Defaults to 2.
+	LatencyTolerance float64
+
+	// MaxQueueDepth is the maximum number of top-level dispatches of an operation in progress
+	// on the node, beyond which further dispatches are rejected with RESOURCE_EXHAUSTED. The node
+	// is considered congested once half of it is reached. Zero disables load shedding.
+	MaxQueueDepth uint32
+}
+
+// AdaptiveConcurrency adjusts the concurrency limit of each dispatch operation between a
+// minimum and its configured ConcurrencyLimits, using an additive-increase/multiplicative-decrease
+// controller: the limit grows by one for every limit's worth of datastore operations completed
+// while the node is not congested, and shrinks by a tenth for every limit's worth of them
+// completed while it is. The node is considered congested when the recent datastore latency of
+// the operation rises above its baseline by more than the tolerance, or when too many dispatches
+// of the operation are in progress.
+//
+// An AdaptiveConcurrency is expected to be shared by all the dispatchers of a node, so that it
+// observes and sheds the load of the whole node.
+type AdaptiveConcurrency struct {
+	config AdaptiveConcurrencyConfig
+
+	check              *adaptiveLimiter
+	reachableResources *adaptiveLimiter
+	lookupResources    *adaptiveLimiter
+	lookupSubjects     *adaptiveLimiter
+}
+
+// NewAdaptiveConcurrency creates a new AdaptiveConcurrency, starting at and never exceeding
+// the given limits.
+func NewAdaptiveConcurrency(limits ConcurrencyLimits, config AdaptiveConcurrencyConfig) *AdaptiveConcurrency {
+	limits = limitsOrDefaults(limits, defaultConcurrencyLimit)
+
+	if config.MinimumLimit == 0 {
+		config.MinimumLimit = 1
+	}
+	if config.LatencyTolerance <= 0 {
+		config.LatencyTolerance = defaultLatencyTolerance
+	}
+
+	return &AdaptiveConcurrency{
+		config:             config,
+		check:              newAdaptiveLimiter("check", limits.Check, config),
+		reachableResources: newAdaptiveLimiter("reachable_resources", limits.ReachableResources, config),
+		lookupResources:    newAdaptiveLimiter("lookup_resources", limits.LookupResources, config),
+		lookupSubjects:     newAdaptiveLimiter("lookup_subjects", limits.LookupSubjects, config),
+	}
+}
+
+// Limits returns the current concurrency limits.
+func (ac *AdaptiveConcurrency) Limits() ConcurrencyLimits {
+	return ConcurrencyLimits{
+		Check:              ac.check.ConcurrencyLimit(),
+		ReachableResources: ac.reachableResources.ConcurrencyLimit(),
+		LookupResources:    ac.lookupResources.ConcurrencyLimit(),
+		LookupSubjects:     ac.lookupSubjects.ConcurrencyLimit(),
+	}
+}
+
+// MarshalZerologObject implements zerolog.LogObjectMarshaler
+func (ac *AdaptiveConcurrency) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint16("adaptive-concurrency-minimum-limit", ac.config.MinimumLimit)
+	e.Float64("adaptive-concurrency-latency-tolerance", ac.config.LatencyTolerance)
+	e.Uint32("adaptive-concurrency-max-queue-depth", ac.config.MaxQueueDepth)
+}
+
+// adaptiveLimiter adjusts the concurrency limit of a single dispatch operation.
+type adaptiveLimiter struct {
+	operation        string
+	minLimit         float64
+	maxLimit         float64
+	latencyTolerance float64
+	maxQueueDepth    int64
+
+	queueDepth   atomic.Int64
+	currentLimit atomic.Uint32
+
+	lock                 sync.Mutex
+	limit                float64
+	recentLatency        float64
+	baselineLatency      float64
+	samplesSinceDecrease float64
+}
+
+func newAdaptiveLimiter(operation string, maxLimit uint16, config AdaptiveConcurrencyConfig) *adaptiveLimiter {
+	al := &adaptiveLimiter{
+		operation:        operation,
+		minLimit:         float64(min(config.MinimumLimit, maxLimit)),
+		maxLimit:         float64(maxLimit),
+		latencyTolerance: config.LatencyTolerance,
+		maxQueueDepth:    int64(config.MaxQueueDepth),
+		limit:            float64(maxLimit),
+	}
+	al.currentLimit.Store(uint32(maxLimit))
+	adaptiveConcurrencyLimitGauge.WithLabelValues(operation).Set(float64(maxLimit))
+	return al
+}
+
+// ConcurrencyLimit implements taskrunner.ConcurrencyLimiter.
+func (al *adaptiveLimiter) ConcurrencyLimit() uint16 {
+	return uint16(al.currentLimit.Load())
+}
+
+// admit admits a dispatch of the operation, returning a context which reports the latency
+// of the datastore operations made by the dispatch and a function to call once the dispatch
+// completes. If the node is saturated, the dispatch is rejected with RESOURCE_EXHAUSTED.
+//
+// Only top-level dispatches, such as those made by the API or routed to this node for them, are
+// counted against the queue depth and shed. The dispatches of their subproblems, made within the
+// context of an admitted dispatch either locally or on another node of the cluster (which is told
+// of the admission by the AdmittedDispatchHeader), only report their latency, as shedding them
+// would fail work that has already been admitted.
+//
+// A nil limiter admits all dispatches.
+func (al *adaptiveLimiter) admit(ctx context.Context) (context.Context, func(), error) {
+	if al == nil {
+		return ctx, func() {}, nil
+	}
+
+	observedCtx := proxy.ContextWithLatencyObserver(ctx, al.observeLatency)
+	if dispatch.IsAdmittedDispatch(ctx) {
+		return observedCtx, func() {}, nil
+	}
+
+	if depth := al.queueDepth.Add(1); al.maxQueueDepth > 0 && depth > al.maxQueueDepth {
+		al.queueDepth.Add(-1)
+		shedDispatchCounter.WithLabelValues(al.operation).Inc()
+		return ctx, nil, status.Errorf(codes.ResourceExhausted, "too many %s dispatches in progress; please retry later", al.operation)
+	}
+
+	return dispatch.ContextWithAdmittedDispatch(observedCtx), func() { al.queueDepth.Add(-1) }, nil
+}
+
+// observeLatency adjusts the limit following the completion of a datastore operation.
+func (al *adaptiveLimiter) observeLatency(latency time.Duration) {
+	sample := float64(latency)
+
+	al.lock.Lock()
+	defer al.lock.Unlock()
+
+	if al.baselineLatency == 0 {
+		al.recentLatency = sample
+		al.baselineLatency = sample
+		return
+	}
+
+	al.recentLatency += (sample - al.recentLatency) * recentLatencyWeight
+	if al.recentLatency < al.baselineLatency {
+		al.baselineLatency = al.recentLatency
+	} else {
+		al.baselineLatency += (al.recentLatency - al.baselineLatency) * baselineLatencyWeight
+	}
+
+	congested := al.recentLatency > al.baselineLatency*al.latencyTolerance ||
+		(al.maxQueueDepth > 0 && al.queueDepth.Load() > al.maxQueueDepth/2)
+
+	al.samplesSinceDecrease++
+	if congested {
+		if al.samplesSinceDecrease < al.limit {
+			return
+		}
+
+		al.samplesSinceDecrease = 0
+		al.limit = math.Max(al.minLimit, al.limit*limitDecreaseFactor)
+	} else {
+		al.limit = math.Min(al.maxLimit, al.limit+1/al.limit)
+	}
+
+	if current := uint32(al.limit); current != al.currentLimit.Load() {
+		al.currentLimit.Store(current)
+		adaptiveConcurrencyLimitGauge.WithLabelValues(al.operation).Set(float64(current))
+	}
+}
+
+var _ taskrunner.ConcurrencyLimiter = (*adaptiveLimiter)(nil)
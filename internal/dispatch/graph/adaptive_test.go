@@ -0,0 +1,215 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	"github.com/authzed/spicedb/internal/dispatch"
+	"github.com/authzed/spicedb/internal/dispatch/caching"
+	"github.com/authzed/spicedb/internal/dispatch/keys"
+	"github.com/authzed/spicedb/internal/graph"
+	log "github.com/authzed/spicedb/internal/logging"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/internal/testfixtures"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+)
+
+func observeLatencies(al *adaptiveLimiter, latency time.Duration, count int) {
+	for i := 0; i < count; i++ {
+		al.observeLatency(latency)
+	}
+}
+
+func TestAdaptiveLimiterFollowsLatency(t *testing.T) {
+	al := newAdaptiveLimiter("test", 50, AdaptiveConcurrencyConfig{
+		MinimumLimit:     5,
+		LatencyTolerance: 2,
+	})
+	require.Equal(t, uint16(50), al.ConcurrencyLimit())
+
+	// A steady latency keeps the limit at its maximum.
+	observeLatencies(al, time.Millisecond, 1000)
+	require.Equal(t, uint16(50), al.ConcurrencyLimit())
+
+	// A latency well above the baseline lowers the limit.
+	observeLatencies(al, 10*time.Millisecond, 200)
+	lowered := al.ConcurrencyLimit()
+	require.Less(t, lowered, uint16(50))
+
+	// The limit never goes below the minimum.
+	observeLatencies(al, time.Second, 1000)
+	require.GreaterOrEqual(t, al.ConcurrencyLimit(), uint16(5))
+
+	// Once the latency recovers, so does the limit.
+	observeLatencies(al, time.Millisecond, 10000)
+	require.Equal(t, uint16(50), al.ConcurrencyLimit())
+}
+
+func TestAdaptiveLimiterFollowsQueueDepth(t *testing.T) {
+	al := newAdaptiveLimiter("test", 20, AdaptiveConcurrencyConfig{
+		MinimumLimit:     1,
+		LatencyTolerance: 2,
+		MaxQueueDepth:    4,
+	})
+
+	var releases []func()
+	for i := 0; i < 3; i++ {
+		_, release, err := al.admit(context.Background())
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+
+	// More than half of the maximum queue depth is in progress, so the limit is lowered
+	// even though the latency is steady.
+	observeLatencies(al, time.Millisecond, 200)
+	require.Less(t, al.ConcurrencyLimit(), uint16(20))
+
+	for _, release := range releases {
+		release()
+	}
+
+	observeLatencies(al, time.Millisecond, 2000)
+	require.Equal(t, uint16(20), al.ConcurrencyLimit())
+}
+
+func TestAdaptiveLimiterShedsLoad(t *testing.T) {
+	al := newAdaptiveLimiter("test", 10, AdaptiveConcurrencyConfig{
+		MinimumLimit:     1,
+		LatencyTolerance: 2,
+		MaxQueueDepth:    2,
+	})
+
+	_, release1, err := al.admit(context.Background())
+	require.NoError(t, err)
+
+	_, release2, err := al.admit(context.Background())
+	require.NoError(t, err)
+
+	_, _, err = al.admit(context.Background())
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	release1()
+	_, release3, err := al.admit(context.Background())
+	require.NoError(t, err)
+
+	release2()
+	release3()
+	require.Equal(t, int64(0), al.queueDepth.Load())
+}
+
+func TestAdaptiveLimiterAdmitsSubproblems(t *testing.T) {
+	al := newAdaptiveLimiter("test", 10, AdaptiveConcurrencyConfig{
+		MinimumLimit:     1,
+		LatencyTolerance: 2,
+		MaxQueueDepth:    1,
+	})
+
+	admittedCtx, release, err := al.admit(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), al.queueDepth.Load())
+
+	// Dispatches made within an admitted dispatch are neither counted nor shed.
+	var subproblemReleases []func()
+	for i := 0; i < 3; i++ {
+		_, releaseSubproblem, err := al.admit(admittedCtx)
+		require.NoError(t, err)
+		subproblemReleases = append(subproblemReleases, releaseSubproblem)
+	}
+	require.Equal(t, int64(1), al.queueDepth.Load())
+
+	// Another top-level dispatch is still shed.
+	_, _, err = al.admit(context.Background())
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	for _, releaseSubproblem := range subproblemReleases {
+		releaseSubproblem()
+	}
+	release()
+	require.Equal(t, int64(0), al.queueDepth.Load())
+}
+
+func TestAdaptiveLimiterAdmitsSubproblemsFromOtherNodes(t *testing.T) {
+	al := newAdaptiveLimiter("test", 10, AdaptiveConcurrencyConfig{
+		MinimumLimit:     1,
+		LatencyTolerance: 2,
+		MaxQueueDepth:    1,
+	})
+
+	admittedCtx, release, err := al.admit(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	// The admission is forwarded with the dispatches of subproblems to other nodes, which
+	// neither count nor shed them.
+	outgoing, ok := metadata.FromOutgoingContext(dispatch.OutgoingAdmittedContext(admittedCtx))
+	require.True(t, ok)
+
+	receivedCtx := dispatch.IncomingAdmittedContext(metadata.NewIncomingContext(context.Background(), outgoing))
+	_, releaseSubproblem, err := al.admit(receivedCtx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), al.queueDepth.Load())
+	releaseSubproblem()
+
+	// Top-level dispatches received from other nodes are still shed.
+	receivedCtx = dispatch.IncomingAdmittedContext(metadata.NewIncomingContext(context.Background(), metadata.MD{}))
+	_, _, err = al.admit(receivedCtx)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAdaptiveLimiterNilAdmitsAll(t *testing.T) {
+	var al *adaptiveLimiter
+
+	ctx := context.Background()
+	admittedCtx, release, err := al.admit(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ctx, admittedCtx)
+	release()
+}
+
+func TestAdaptiveDispatcherObservesDatastore(t *testing.T) {
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	ds, revision := testfixtures.StandardDatastoreWithData(rawDS, require.New(t))
+
+	adaptive := NewAdaptiveConcurrency(SharedConcurrencyLimits(10), AdaptiveConcurrencyConfig{MaxQueueDepth: 100})
+	require.Equal(t, SharedConcurrencyLimits(10), adaptive.Limits())
+
+	cachingDispatcher, err := caching.NewCachingDispatcher(caching.DispatchTestCache(t), false, "", &keys.CanonicalKeyHandler{})
+	require.NoError(t, err)
+	cachingDispatcher.SetDelegate(NewAdaptiveDispatcher(cachingDispatcher, adaptive))
+
+	ctx := log.Logger.WithContext(datastoremw.ContextWithHandle(context.Background()))
+	require.NoError(t, datastoremw.SetInContext(ctx, proxy.NewObservableDatastoreProxy(ds)))
+
+	checkResult, err := cachingDispatcher.DispatchCheck(ctx, &v1.DispatchCheckRequest{
+		ResourceRelation: RR("document", "view"),
+		ResourceIds:      []string{"masterplan"},
+		ResultsSetting:   v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT,
+		Subject:          ONR("user", "product_manager", graph.Ellipsis),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     revision.String(),
+			DepthRemaining: 50,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ResourceCheckResult_MEMBER, checkResult.ResultsByResourceId["masterplan"].Membership)
+
+	// Dispatches canceled after the result was found may still be completing.
+	require.Eventually(t, func() bool {
+		return adaptive.check.queueDepth.Load() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	adaptive.check.lock.Lock()
+	defer adaptive.check.lock.Unlock()
+	require.NotZero(t, adaptive.check.baselineLatency)
+}
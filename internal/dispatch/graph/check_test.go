@@ -3,6 +3,7 @@ package graph
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/authzed/spicedb/internal/dispatch/caching"
 	"github.com/authzed/spicedb/internal/dispatch/keys"
 	"github.com/authzed/spicedb/internal/graph"
+	"github.com/authzed/spicedb/internal/graph/computed"
 	log "github.com/authzed/spicedb/internal/logging"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/testfixtures"
@@ -410,6 +412,391 @@ func TestCheckDebugging(t *testing.T) {
 	}
 }
 
+func TestCheckAdditionalSubjects(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	schema := `
+		definition user {}
+
+		caveat testcaveat(somecondition int) {
+			somecondition == 42
+		}
+
+		definition group {
+			relation member: user | user with testcaveat | group#member
+		}
+
+		definition folder {
+			relation viewer: user | group#member
+			relation reviewer: user
+		}
+
+		definition document {
+			relation folder: folder
+			relation viewer: user | user:* | group#member
+			relation editor: user | user with testcaveat
+			relation banned: user
+			permission edit = editor
+			permission view = viewer + edit + folder->viewer
+			permission view_unbanned = view - banned
+			permission review = view & folder.all(reviewer)
+		}
+	`
+
+	rels := []*core.RelationTuple{
+		tuple.MustParse("group:eng#member@user:alice"),
+		tuple.MustWithCaveat(tuple.MustParse("group:eng#member@user:bob"), "testcaveat"),
+		tuple.MustParse("group:staff#member@group:eng#member"),
+		tuple.MustParse("group:staff#member@user:carl"),
+		tuple.MustParse("folder:plans#viewer@group:staff#member"),
+		tuple.MustParse("folder:plans#reviewer@user:alice"),
+		tuple.MustParse("folder:plans#reviewer@user:dana"),
+		tuple.MustParse("folder:public#reviewer@user:alice"),
+		tuple.MustParse("document:specs#folder@folder:plans"),
+		tuple.MustParse("document:specs#viewer@user:erin"),
+		tuple.MustWithCaveat(tuple.MustParse("document:specs#editor@user:dana"), "testcaveat"),
+		tuple.MustParse("document:specs#banned@user:carl"),
+		tuple.MustParse("document:readme#viewer@user:*"),
+		tuple.MustParse("document:readme#folder@folder:plans"),
+		tuple.MustParse("document:readme#folder@folder:public"),
+		tuple.MustParse("document:readme#banned@user:erin"),
+	}
+
+	subjects := []*core.ObjectAndRelation{
+		ONR("user", "alice", "..."),
+		ONR("user", "bob", "..."),
+		ONR("user", "carl", "..."),
+		ONR("user", "dana", "..."),
+		ONR("user", "erin", "..."),
+		ONR("user", "unknown", "..."),
+		ONR("group", "eng", "member"),
+		ONR("group", "staff", "member"),
+	}
+
+	for _, tc := range []struct {
+		resourceType string
+		resourceIDs  []string
+		permission   string
+	}{
+		{"group", []string{"eng", "staff"}, "member"},
+		{"folder", []string{"plans", "public"}, "viewer"},
+		{"document", []string{"specs"}, "edit"},
+		{"document", []string{"specs"}, "view"},
+		{"document", []string{"specs", "readme"}, "view"},
+		{"document", []string{"specs", "readme"}, "view_unbanned"},
+		{"document", []string{"specs", "readme"}, "review"},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%s:%s#%s", tc.resourceType, strings.Join(tc.resourceIDs, ","), tc.permission), func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, dispatch, revision := newLocalDispatcherWithSchemaAndRels(t, schema, rels)
+
+			// Check each subject on its own, for comparison.
+			expectedResults := make(map[string]map[string]*v1.ResourceCheckResult, len(subjects))
+			for _, subject := range subjects {
+				checkResult, err := dispatch.DispatchCheck(ctx, &v1.DispatchCheckRequest{
+					ResourceRelation: RR(tc.resourceType, tc.permission),
+					ResourceIds:      tc.resourceIDs,
+					ResultsSetting:   v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS,
+					Subject:          subject,
+					Metadata: &v1.ResolverMeta{
+						AtRevision:     revision.String(),
+						DepthRemaining: 50,
+					},
+				})
+				require.NoError(err)
+
+				if len(checkResult.ResultsByResourceId) > 0 {
+					expectedResults[tuple.StringONR(subject)] = checkResult.ResultsByResourceId
+				}
+			}
+
+			// Check the subjects together, twice to exercise the cache.
+			for i := 0; i < 2; i++ {
+				checkResult, err := dispatch.DispatchCheck(ctx, &v1.DispatchCheckRequest{
+					ResourceRelation:   RR(tc.resourceType, tc.permission),
+					ResourceIds:        tc.resourceIDs,
+					ResultsSetting:     v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS,
+					Subject:            subjects[0],
+					AdditionalSubjects: subjects[1:],
+					Metadata: &v1.ResolverMeta{
+						AtRevision:     revision.String(),
+						DepthRemaining: 50,
+					},
+				})
+				require.NoError(err)
+				require.Empty(checkResult.ResultsByResourceId)
+
+				foundResults := make(map[string]map[string]*v1.ResourceCheckResult, len(checkResult.ResultsBySubject))
+				for subjectKey, subjectResults := range checkResult.ResultsBySubject {
+					foundResults[subjectKey] = subjectResults.ResultsByResourceId
+				}
+
+				require.Equal(len(expectedResults), len(foundResults))
+				for subjectKey, expected := range expectedResults {
+					found, ok := foundResults[subjectKey]
+					require.True(ok, "missing results for subject %s", subjectKey)
+					require.Equal(len(expected), len(found), "for subject %s", subjectKey)
+					for resourceID, expectedResult := range expected {
+						foundResult, ok := found[resourceID]
+						require.True(ok, "missing result for %s and subject %s", resourceID, subjectKey)
+						require.Equal(expectedResult.Membership, foundResult.Membership, "for %s and subject %s", resourceID, subjectKey)
+						require.Equal(expectedResult.Expression == nil, foundResult.Expression == nil, "for %s and subject %s", resourceID, subjectKey)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCheckPlanning(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	schema := `
+		definition user {}
+
+		definition group {
+			relation member: user
+		}
+
+		definition organization {
+			relation member: user | group#member
+		}
+
+		definition document {
+			relation org: organization | group
+			relation viewer: user
+			relation banned: user
+			permission view = org->member & viewer
+			permission view_unbanned = org->member - banned
+		}
+	`
+
+	rels := []*core.RelationTuple{
+		tuple.MustParse("organization:acme#member@user:tom"),
+		tuple.MustParse("organization:acme#member@user:sarah"),
+		tuple.MustParse("organization:acme#member@user:fred"),
+		tuple.MustParse("document:plan#org@organization:acme"),
+		tuple.MustParse("document:plan#viewer@user:tom"),
+		tuple.MustParse("document:plan#banned@user:sarah"),
+	}
+
+	testCases := []struct {
+		permission            string
+		subject               *core.ObjectAndRelation
+		expectedMembership    v1.ResourceCheckResult_Membership
+		expectedOperation     v1.CheckPlanDecision_Operation
+		expectedBranches      []string
+		expectedShortCircuit  bool
+		expectedSkippedBranch string
+	}{
+		{
+			"view",
+			ONR("user", "tom", "..."),
+			v1.ResourceCheckResult_MEMBER,
+			v1.CheckPlanDecision_INTERSECTION,
+			[]string{"viewer", "org->member"},
+			false,
+			"",
+		},
+		{
+			"view",
+			ONR("user", "fred", "..."),
+			v1.ResourceCheckResult_NOT_MEMBER,
+			v1.CheckPlanDecision_INTERSECTION,
+			[]string{"viewer", "org->member"},
+			true,
+			"org->member",
+		},
+		{
+			"view_unbanned",
+			ONR("user", "tom", "..."),
+			v1.ResourceCheckResult_MEMBER,
+			v1.CheckPlanDecision_EXCLUSION,
+			[]string{"banned", "org->member"},
+			false,
+			"",
+		},
+		{
+			"view_unbanned",
+			ONR("user", "sarah", "..."),
+			v1.ResourceCheckResult_NOT_MEMBER,
+			v1.CheckPlanDecision_EXCLUSION,
+			[]string{"banned", "org->member"},
+			true,
+			"org->member",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s@%s", tc.permission, tuple.StringONR(tc.subject)), func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, dispatch, revision := newLocalDispatcherWithSchemaAndRels(t, schema, rels)
+
+			checkResult, err := dispatch.DispatchCheck(ctx, &v1.DispatchCheckRequest{
+				ResourceRelation: RR("document", tc.permission),
+				ResourceIds:      []string{"plan"},
+				ResultsSetting:   v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT,
+				Subject:          tc.subject,
+				Metadata: &v1.ResolverMeta{
+					AtRevision:     revision.String(),
+					DepthRemaining: 50,
+				},
+				Debug: v1.DispatchCheckRequest_ENABLE_BASIC_DEBUGGING,
+			})
+			require.NoError(err)
+
+			membership := v1.ResourceCheckResult_NOT_MEMBER
+			if found, ok := checkResult.ResultsByResourceId["plan"]; ok {
+				membership = found.Membership
+			}
+			require.Equal(tc.expectedMembership, membership)
+
+			require.NotNil(checkResult.Metadata.DebugInfo)
+			decisions := checkResult.Metadata.DebugInfo.Check.PlanDecisions
+			require.Len(decisions, 1)
+
+			decision := decisions[0]
+			require.Equal(tc.expectedOperation, decision.Operation)
+			require.Equal(v1.CheckPlanDecision_CHEAPEST_FIRST, decision.Strategy)
+			require.Equal(tc.expectedShortCircuit, decision.ShortCircuited)
+
+			branches := make([]string, 0, len(decision.Branches))
+			for _, branch := range decision.Branches {
+				branches = append(branches, branch.Name)
+				require.Equal(branch.Name == tc.expectedSkippedBranch, branch.Skipped, "for branch %s", branch.Name)
+			}
+			require.Equal(tc.expectedBranches, branches)
+		})
+	}
+}
+
+func TestCheckWildcardSubject(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	schema := `
+		definition user {}
+
+		definition group {
+			relation member: user | user:*
+		}
+
+		definition document {
+			relation viewer: user | user:*
+			relation group: group
+			relation banned: user | user:*
+			permission view = (viewer + group->member) - banned
+		}
+	`
+
+	rels := []*core.RelationTuple{
+		tuple.MustParse("document:public#viewer@user:*"),
+		tuple.MustParse("document:private#viewer@user:tom"),
+		tuple.MustParse("document:viagroup#group@group:everyone"),
+		tuple.MustParse("group:everyone#member@user:*"),
+		tuple.MustParse("document:withdrawn#viewer@user:*"),
+		tuple.MustParse("document:withdrawn#banned@user:*"),
+	}
+
+	ctx, dispatch, revision := newLocalDispatcherWithSchemaAndRels(t, schema, rels)
+
+	request := &v1.DispatchCheckRequest{
+		ResourceRelation: RR("document", "view"),
+		ResourceIds:      []string{"public", "private", "viagroup", "withdrawn"},
+		ResultsSetting:   v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS,
+		Subject:          ONR("user", "*", "..."),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     revision.String(),
+			DepthRemaining: 50,
+		},
+	}
+
+	_, err := dispatch.DispatchCheck(ctx, request)
+	require.ErrorContains(t, err, "cannot perform check on wildcard")
+
+	request.AllowWildcardSubject = true
+	checkResult, err := dispatch.DispatchCheck(ctx, request)
+	require.NoError(t, err)
+
+	members := make([]string, 0, len(checkResult.ResultsByResourceId))
+	for resourceID, result := range checkResult.ResultsByResourceId {
+		if result.Membership == v1.ResourceCheckResult_MEMBER {
+			members = append(members, resourceID)
+		}
+	}
+	slices.Sort(members)
+	require.Equal(t, []string{"public", "viagroup"}, members)
+}
+
+func TestCheckAllArrowWithCaveatedTuplesetRelationships(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	schema := `
+		definition user {}
+
+		caveat onlyon(day int) {
+			day == 1
+		}
+
+		definition team {
+			relation member: user
+		}
+
+		definition document {
+			relation team: team | team with onlyon
+			permission view = team.all(member)
+		}
+	`
+
+	rels := []*core.RelationTuple{
+		tuple.MustParse("team:first#member@user:tom"),
+		tuple.MustParse("team:first#member@user:sarah"),
+		tuple.MustParse("team:second#member@user:tom"),
+		tuple.MustParse("document:mixed#team@team:first"),
+		tuple.MustWithCaveat(tuple.MustParse("document:mixed#team@team:second"), "onlyon"),
+		tuple.MustWithCaveat(tuple.MustParse("document:caveatedonly#team@team:second"), "onlyon"),
+	}
+
+	ctx, dispatch, revision := newLocalDispatcherWithSchemaAndRels(t, schema, rels)
+
+	for _, tc := range []struct {
+		resourceID         string
+		subjectID          string
+		context            map[string]any
+		expectedMembership v1.ResourceCheckResult_Membership
+	}{
+		// tom is a member of both teams, so the caveat on the second is irrelevant.
+		{"mixed", "tom", nil, v1.ResourceCheckResult_MEMBER},
+
+		// sarah is only a member of the first team, so she can view only when the second does not apply.
+		{"mixed", "sarah", nil, v1.ResourceCheckResult_CAVEATED_MEMBER},
+		{"mixed", "sarah", map[string]any{"day": int64(2)}, v1.ResourceCheckResult_MEMBER},
+		{"mixed", "sarah", map[string]any{"day": int64(1)}, v1.ResourceCheckResult_NOT_MEMBER},
+
+		// With only caveated tupleset relationships, at least one must apply.
+		{"caveatedonly", "tom", nil, v1.ResourceCheckResult_CAVEATED_MEMBER},
+		{"caveatedonly", "tom", map[string]any{"day": int64(1)}, v1.ResourceCheckResult_MEMBER},
+		{"caveatedonly", "tom", map[string]any{"day": int64(2)}, v1.ResourceCheckResult_NOT_MEMBER},
+		{"caveatedonly", "sarah", nil, v1.ResourceCheckResult_NOT_MEMBER},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%s@%s/%v", tc.resourceID, tc.subjectID, tc.context), func(t *testing.T) {
+			result, _, err := computed.ComputeCheck(ctx, dispatch, computed.CheckParameters{
+				ResourceType:  RR("document", "view"),
+				Subject:       ONR("user", tc.subjectID, "..."),
+				CaveatContext: tc.context,
+				AtRevision:    revision,
+				MaximumDepth:  50,
+			}, tc.resourceID)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMembership, result.Membership)
+		})
+	}
+}
+
 func newLocalDispatcherWithConcurrencyLimit(t testing.TB, concurrencyLimit uint16) (context.Context, dispatch.Dispatcher, datastore.Revision) {
 	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
 	require.NoError(t, err)
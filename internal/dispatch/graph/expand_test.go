@@ -305,6 +305,208 @@ func TestMaxDepthExpand(t *testing.T) {
 	require.Error(err)
 }
 
+func TestExpandOptionalMaxDepth(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	require := require.New(t)
+
+	ctx, dispatch, revision := newLocalDispatcher(t)
+
+	expandResult, err := dispatch.DispatchExpand(ctx, &v1.DispatchExpandRequest{
+		ResourceAndRelation: ONR("folder", "company", "edit"),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     revision.String(),
+			DepthRemaining: 50,
+		},
+		ExpansionMode:    v1.DispatchExpandRequest_SHALLOW,
+		OptionalMaxDepth: 1,
+	})
+	require.NoError(err)
+	require.Equal(1, int(expandResult.Metadata.DispatchCount))
+
+	expected := graph.Union(ONR("folder", "company", "edit"),
+		graph.Leaf(ONR("folder", "company", "editor")),
+		graph.Leaf(ONR("folder", "company", "owner")),
+	)
+	require.Empty(cmp.Diff(expected, expandResult.TreeNode, protocmp.Transform()))
+	require.Empty(cmp.Diff([]*v1.TruncatedExpandNode{
+		{Expanded: ONR("folder", "company", "editor")},
+		{Expanded: ONR("folder", "company", "owner")},
+	}, expandResult.TruncatedNodes, protocmp.Transform()))
+
+	// Expanding a truncated node opens the next level of the tree.
+	expandResult, err = dispatch.DispatchExpand(ctx, &v1.DispatchExpandRequest{
+		ResourceAndRelation: ONR("folder", "company", "owner"),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     revision.String(),
+			DepthRemaining: 50,
+		},
+		ExpansionMode:    v1.DispatchExpandRequest_SHALLOW,
+		OptionalMaxDepth: 1,
+	})
+	require.NoError(err)
+	require.Empty(expandResult.TruncatedNodes)
+	require.Empty(cmp.Diff(companyOwner, expandResult.TreeNode, protocmp.Transform()))
+}
+
+func TestExpandOptionalSubjectLimit(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	var rels []*core.RelationTuple
+	for i := 0; i < 5; i++ {
+		rels = append(rels, tuple.MustParse(fmt.Sprintf("document:testdoc#viewer@user:user%d", i)))
+	}
+
+	ctx, dispatch, revision := newLocalDispatcherWithSchemaAndRels(t, `
+		definition user {}
+
+		definition document {
+			relation viewer: user
+			permission view = viewer
+		}
+	`, rels)
+
+	for _, start := range []*core.ObjectAndRelation{
+		ONR("document", "testdoc", "viewer"),
+		ONR("document", "testdoc", "view"),
+	} {
+		start := start
+		t.Run(tuple.StringONR(start), func(t *testing.T) {
+			require := require.New(t)
+
+			var pages [][]string
+			var cursor *v1.Cursor
+			for {
+				expandResult, err := dispatch.DispatchExpand(ctx, &v1.DispatchExpandRequest{
+					ResourceAndRelation: start,
+					Metadata: &v1.ResolverMeta{
+						AtRevision:     revision.String(),
+						DepthRemaining: 50,
+					},
+					ExpansionMode:        v1.DispatchExpandRequest_SHALLOW,
+					OptionalSubjectLimit: 2,
+					OptionalCursor:       cursor,
+				})
+				require.NoError(err)
+
+				leaf := expandResult.TreeNode.GetLeafNode()
+				if leaf == nil {
+					leaf = expandResult.TreeNode.GetIntermediateNode().ChildNodes[0].GetLeafNode()
+				}
+
+				page := make([]string, 0, len(leaf.Subjects))
+				for _, subject := range leaf.Subjects {
+					page = append(page, subject.Subject.ObjectId)
+				}
+				pages = append(pages, page)
+
+				if len(expandResult.TruncatedNodes) == 0 {
+					break
+				}
+
+				require.Len(expandResult.TruncatedNodes, 1)
+				require.Equal(tuple.StringONR(ONR("document", "testdoc", "viewer")), tuple.StringONR(expandResult.TruncatedNodes[0].Expanded))
+				require.NotNil(expandResult.TruncatedNodes[0].OptionalCursor)
+
+				// The remaining subjects are returned by expanding the truncated node from its cursor.
+				start = expandResult.TruncatedNodes[0].Expanded
+				cursor = expandResult.TruncatedNodes[0].OptionalCursor
+			}
+
+			require.Equal([][]string{{"user0", "user1"}, {"user2", "user3"}, {"user4"}}, pages)
+		})
+	}
+}
+
+func TestExpandOptionalSubjectLimitArrow(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+	require := require.New(t)
+
+	var rels []*core.RelationTuple
+	for i := 0; i < 5; i++ {
+		rels = append(rels,
+			tuple.MustParse(fmt.Sprintf("document:testdoc#parent@folder:folder%d", i)),
+			tuple.MustParse(fmt.Sprintf("folder:folder%d#viewer@user:user%d", i, i)),
+		)
+	}
+
+	ctx, dispatch, revision := newLocalDispatcherWithSchemaAndRels(t, `
+		definition user {}
+
+		definition folder {
+			relation viewer: user
+		}
+
+		definition document {
+			relation parent: folder
+			permission view = parent->viewer
+		}
+	`, rels)
+
+	// expandedFolders returns the folders whose viewers were expanded beneath the node.
+	var expandedFolders func(node *core.RelationTupleTreeNode) []string
+	expandedFolders = func(node *core.RelationTupleTreeNode) []string {
+		if leaf := node.GetLeafNode(); leaf != nil {
+			if node.Expanded.Namespace == "folder" {
+				return []string{node.Expanded.ObjectId}
+			}
+			return nil
+		}
+
+		var folders []string
+		for _, child := range node.GetIntermediateNode().ChildNodes {
+			folders = append(folders, expandedFolders(child)...)
+		}
+		return folders
+	}
+
+	expandResult, err := dispatch.DispatchExpand(ctx, &v1.DispatchExpandRequest{
+		ResourceAndRelation: ONR("document", "testdoc", "view"),
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     revision.String(),
+			DepthRemaining: 50,
+		},
+		ExpansionMode:        v1.DispatchExpandRequest_SHALLOW,
+		OptionalSubjectLimit: 2,
+	})
+	require.NoError(err)
+	require.ElementsMatch([]string{"folder0", "folder1"}, expandedFolders(expandResult.TreeNode))
+
+	// The remaining relationships of the tupleset are returned by expanding it from the cursor of
+	// its truncated node.
+	require.Len(expandResult.TruncatedNodes, 1)
+	require.Equal(tuple.StringONR(ONR("document", "testdoc", "parent")), tuple.StringONR(expandResult.TruncatedNodes[0].Expanded))
+	require.NotNil(expandResult.TruncatedNodes[0].OptionalCursor)
+
+	var pages [][]string
+	cursor := expandResult.TruncatedNodes[0].OptionalCursor
+	for cursor != nil {
+		expandResult, err := dispatch.DispatchExpand(ctx, &v1.DispatchExpandRequest{
+			ResourceAndRelation: ONR("document", "testdoc", "parent"),
+			Metadata: &v1.ResolverMeta{
+				AtRevision:     revision.String(),
+				DepthRemaining: 50,
+			},
+			ExpansionMode:        v1.DispatchExpandRequest_SHALLOW,
+			OptionalSubjectLimit: 2,
+			OptionalCursor:       cursor,
+		})
+		require.NoError(err)
+
+		page := make([]string, 0, 2)
+		for _, subject := range expandResult.TreeNode.GetLeafNode().Subjects {
+			page = append(page, subject.Subject.ObjectId)
+		}
+		pages = append(pages, page)
+
+		cursor = nil
+		if len(expandResult.TruncatedNodes) > 0 {
+			cursor = expandResult.TruncatedNodes[0].OptionalCursor
+		}
+	}
+	require.Equal([][]string{{"folder2", "folder3"}, {"folder4"}}, pages)
+}
+
 func TestCaveatedExpand(t *testing.T) {
 	defer goleak.VerifyNone(t, goleakIgnores...)
 
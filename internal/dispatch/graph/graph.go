@@ -16,6 +16,7 @@ import (
 	"github.com/authzed/spicedb/internal/graph"
 	log "github.com/authzed/spicedb/internal/logging"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/internal/taskrunner"
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
@@ -89,11 +90,11 @@ func NewLocalOnlyDispatcherWithLimits(concurrencyLimits ConcurrencyLimits) dispa
 
 	concurrencyLimits = limitsOrDefaults(concurrencyLimits, defaultConcurrencyLimit)
 
-	d.checker = graph.NewConcurrentChecker(d, concurrencyLimits.Check)
+	d.checker = graph.NewConcurrentChecker(d, taskrunner.StaticConcurrencyLimit(concurrencyLimits.Check))
 	d.expander = graph.NewConcurrentExpander(d)
-	d.reachableResourcesHandler = graph.NewCursoredReachableResources(d, concurrencyLimits.ReachableResources)
-	d.lookupResourcesHandler = graph.NewCursoredLookupResources(d, d, concurrencyLimits.LookupResources)
-	d.lookupSubjectsHandler = graph.NewConcurrentLookupSubjects(d, concurrencyLimits.LookupSubjects)
+	d.reachableResourcesHandler = graph.NewCursoredReachableResources(d, taskrunner.StaticConcurrencyLimit(concurrencyLimits.ReachableResources))
+	d.lookupResourcesHandler = graph.NewCursoredLookupResources(d, d, d, taskrunner.StaticConcurrencyLimit(concurrencyLimits.LookupResources))
+	d.lookupSubjectsHandler = graph.NewConcurrentLookupSubjects(d, taskrunner.StaticConcurrencyLimit(concurrencyLimits.LookupSubjects))
 
 	return d
 }
@@ -103,11 +104,11 @@ func NewLocalOnlyDispatcherWithLimits(concurrencyLimits ConcurrencyLimits) dispa
 func NewDispatcher(redispatcher dispatch.Dispatcher, concurrencyLimits ConcurrencyLimits) dispatch.Dispatcher {
 	concurrencyLimits = limitsOrDefaults(concurrencyLimits, defaultConcurrencyLimit)
 
-	checker := graph.NewConcurrentChecker(redispatcher, concurrencyLimits.Check)
+	checker := graph.NewConcurrentChecker(redispatcher, taskrunner.StaticConcurrencyLimit(concurrencyLimits.Check))
 	expander := graph.NewConcurrentExpander(redispatcher)
-	reachableResourcesHandler := graph.NewCursoredReachableResources(redispatcher, concurrencyLimits.ReachableResources)
-	lookupResourcesHandler := graph.NewCursoredLookupResources(redispatcher, redispatcher, concurrencyLimits.LookupResources)
-	lookupSubjectsHandler := graph.NewConcurrentLookupSubjects(redispatcher, concurrencyLimits.LookupSubjects)
+	reachableResourcesHandler := graph.NewCursoredReachableResources(redispatcher, taskrunner.StaticConcurrencyLimit(concurrencyLimits.ReachableResources))
+	lookupResourcesHandler := graph.NewCursoredLookupResources(redispatcher, redispatcher, redispatcher, taskrunner.StaticConcurrencyLimit(concurrencyLimits.LookupResources))
+	lookupSubjectsHandler := graph.NewConcurrentLookupSubjects(redispatcher, taskrunner.StaticConcurrencyLimit(concurrencyLimits.LookupSubjects))
 
 	return &localDispatcher{
 		checker:                   checker,
@@ -118,12 +119,30 @@ func NewDispatcher(redispatcher dispatch.Dispatcher, concurrencyLimits Concurren
 	}
 }
 
+// NewAdaptiveDispatcher creates a dispatcher that consults with the graph and redispatches subproblems to
+// the provided redispatcher, with its concurrency limits adjusted and its load shed by the provided
+// AdaptiveConcurrency.
+func NewAdaptiveDispatcher(redispatcher dispatch.Dispatcher, adaptive *AdaptiveConcurrency) dispatch.Dispatcher {
+	return &localDispatcher{
+		checker:                   graph.NewConcurrentChecker(redispatcher, adaptive.check),
+		expander:                  graph.NewConcurrentExpander(redispatcher),
+		reachableResourcesHandler: graph.NewCursoredReachableResources(redispatcher, adaptive.reachableResources),
+		lookupResourcesHandler:    graph.NewCursoredLookupResources(redispatcher, redispatcher, redispatcher, adaptive.lookupResources),
+		lookupSubjectsHandler:     graph.NewConcurrentLookupSubjects(redispatcher, adaptive.lookupSubjects),
+		adaptive:                  *adaptive,
+	}
+}
+
 type localDispatcher struct {
 	checker                   *graph.ConcurrentChecker
 	expander                  *graph.ConcurrentExpander
 	reachableResourcesHandler *graph.CursoredReachableResources
 	lookupResourcesHandler    *graph.CursoredLookupResources
 	lookupSubjectsHandler     *graph.ConcurrentLookupSubjects
+
+	// adaptive holds the limiters admitting the dispatches of each operation, which are nil
+	// if the concurrency limits are static.
+	adaptive AdaptiveConcurrency
 }
 
 func (ld *localDispatcher) loadNamespace(ctx context.Context, nsName string, revision datastore.Revision) (*core.NamespaceDefinition, error) {
@@ -192,6 +211,12 @@ func (ld *localDispatcher) DispatchCheck(ctx context.Context, req *v1.DispatchCh
 		}, rewriteError(ctx, err)
 	}
 
+	ctx, release, err := ld.adaptive.check.admit(ctx)
+	if err != nil {
+		return &v1.DispatchCheckResponse{Metadata: emptyMetadata}, err
+	}
+	defer release()
+
 	revision, err := ld.parseRevision(ctx, req.Metadata.AtRevision)
 	if err != nil {
 		return &v1.DispatchCheckResponse{Metadata: emptyMetadata}, rewriteError(ctx, err)
@@ -211,7 +236,7 @@ func (ld *localDispatcher) DispatchCheck(ctx context.Context, req *v1.DispatchCh
 	// resource, load the aliased relation and dispatch to it. We cannot use the alias if the
 	// resource and subject types are the same because a check on the *exact same* resource and
 	// subject must pass, and we don't know how many intermediate steps may hit that case.
-	if relation.AliasingRelation != "" && req.ResourceRelation.Namespace != req.Subject.Namespace {
+	if relation.AliasingRelation != "" && !req.HasSubjectOfType(req.ResourceRelation.Namespace) {
 		relation, err := ld.lookupRelation(ctx, ns, relation.AliasingRelation)
 		if err != nil {
 			return &v1.DispatchCheckResponse{Metadata: emptyMetadata}, rewriteError(ctx, err)
@@ -224,22 +249,24 @@ func (ld *localDispatcher) DispatchCheck(ctx context.Context, req *v1.DispatchCh
 					Namespace: req.ResourceRelation.Namespace,
 					Relation:  relation.Name,
 				},
-				ResourceIds: req.ResourceIds,
-				Subject:     req.Subject,
-				Metadata:    req.Metadata,
-				Debug:       req.Debug,
+				ResourceIds:          req.ResourceIds,
+				Subject:              req.Subject,
+				AdditionalSubjects:   req.AdditionalSubjects,
+				AllowWildcardSubject: req.AllowWildcardSubject,
+				Metadata:             req.Metadata,
+				Debug:                req.Debug,
 			},
 			Revision: revision,
 		}
 
-		resp, err := ld.checker.Check(ctx, validatedReq, relation)
+		resp, err := ld.checker.Check(ctx, validatedReq, ns, relation)
 		return resp, rewriteError(ctx, err)
 	}
 
 	resp, err := ld.checker.Check(ctx, graph.ValidatedCheckRequest{
 		DispatchCheckRequest: req,
 		Revision:             revision,
-	}, relation)
+	}, ns, relation)
 	return resp, rewriteError(ctx, err)
 }
 
@@ -294,6 +321,12 @@ func (ld *localDispatcher) DispatchReachableResources(
 		return err
 	}
 
+	ctx, release, err := ld.adaptive.reachableResources.admit(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	revision, err := ld.parseRevision(ctx, req.Metadata.AtRevision)
 	if err != nil {
 		return err
@@ -323,6 +356,12 @@ func (ld *localDispatcher) DispatchLookupResources(
 		return err
 	}
 
+	ctx, release, err := ld.adaptive.lookupResources.admit(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	revision, err := ld.parseRevision(ctx, req.Metadata.AtRevision)
 	if err != nil {
 		return err
@@ -357,6 +396,12 @@ func (ld *localDispatcher) DispatchLookupSubjects(
 		return err
 	}
 
+	ctx, release, err := ld.adaptive.lookupSubjects.admit(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	revision, err := ld.parseRevision(ctx, req.Metadata.AtRevision)
 	if err != nil {
 		return err
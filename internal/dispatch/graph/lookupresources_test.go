@@ -669,3 +669,172 @@ func TestLookupResourcesWithError(t *testing.T) {
 
 	require.Error(err)
 }
+
+func TestLookupResourcesWithWildcards(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	schema := `
+		definition user {}
+
+		caveat somecaveat(somecondition int) {
+			somecondition == 42
+		}
+
+		definition group {
+			relation member: user | user:*
+		}
+
+		definition document {
+			relation viewer: user | user:*
+			relation conditional_viewer: user:* with somecaveat
+			relation banned: user
+			relation conditionally_banned: user with somecaveat
+			relation group: group
+			permission view = viewer + group->member + conditional_viewer
+			permission restricted_view = viewer - banned
+			permission conditionally_restricted_view = viewer - conditionally_banned
+		}
+	`
+
+	relationships := []*core.RelationTuple{
+		tuple.MustParse("document:public#viewer@user:*"),
+		tuple.MustParse("document:private#viewer@user:tom"),
+		tuple.MustParse("document:both#viewer@user:*"),
+		tuple.MustParse("document:both#viewer@user:tom"),
+		tuple.MustParse("document:viagroup#group@group:everyone"),
+		tuple.MustParse("group:everyone#member@user:*"),
+		tuple.MustParse("document:tombanned#viewer@user:*"),
+		tuple.MustParse("document:tombanned#banned@user:tom"),
+		tuple.MustParse("document:conditional#conditional_viewer@user:*[somecaveat]"),
+		tuple.MustParse("document:tomconditionallybanned#viewer@user:*"),
+		tuple.MustParse("document:tomconditionallybanned#conditionally_banned@user:tom[somecaveat]"),
+	}
+
+	testCases := []struct {
+		name             string
+		permission       *core.RelationReference
+		subject          *core.ObjectAndRelation
+		wildcardSetting  v1.DispatchLookupResourcesRequest_WildcardSetting
+		expectedWildcard map[string]bool
+		expectedError    string
+	}{
+		{
+			"no annotations",
+			RR("document", "view"),
+			ONR("user", "tom", "..."),
+			v1.DispatchLookupResourcesRequest_NO_WILDCARD_ANNOTATIONS,
+			map[string]bool{"public": false, "private": false, "both": false, "viagroup": false, "tombanned": false, "conditional": false, "tomconditionallybanned": false},
+			"",
+		},
+		{
+			"annotated",
+			RR("document", "view"),
+			ONR("user", "tom", "..."),
+			v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS,
+			map[string]bool{"public": true, "private": false, "both": true, "viagroup": true, "tombanned": true, "conditional": true, "tomconditionallybanned": true},
+			"",
+		},
+		{
+			"annotated with exclusion",
+			RR("document", "restricted_view"),
+			ONR("user", "tom", "..."),
+			v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS,
+			map[string]bool{"public": true, "private": false, "both": true, "tomconditionallybanned": true},
+			"",
+		},
+		{
+			"annotated with exclusion of another subject",
+			RR("document", "restricted_view"),
+			ONR("user", "sarah", "..."),
+			v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS,
+			map[string]bool{"public": true, "both": true, "tombanned": false, "tomconditionallybanned": true},
+			"",
+		},
+		{
+			"annotated with caveated exclusion of another subject",
+			RR("document", "conditionally_restricted_view"),
+			ONR("user", "sarah", "..."),
+			v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS,
+			map[string]bool{"public": true, "both": true, "tombanned": true, "tomconditionallybanned": false},
+			"",
+		},
+		{
+			"only wildcards",
+			RR("document", "view"),
+			ONR("user", "*", "..."),
+			v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS,
+			map[string]bool{"public": true, "both": true, "viagroup": true, "tombanned": true, "conditional": true, "tomconditionallybanned": true},
+			"",
+		},
+		{
+			"only wildcards with exclusion",
+			RR("document", "restricted_view"),
+			ONR("user", "*", "..."),
+			v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS,
+			map[string]bool{"public": true, "both": true, "tomconditionallybanned": true},
+			"",
+		},
+		{
+			"only wildcards with caveated exclusion",
+			RR("document", "conditionally_restricted_view"),
+			ONR("user", "*", "..."),
+			v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS,
+			map[string]bool{"public": true, "both": true, "tombanned": true},
+			"",
+		},
+		{
+			"only wildcards for a concrete subject",
+			RR("document", "view"),
+			ONR("user", "tom", "..."),
+			v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS,
+			nil,
+			"requires the wildcard as the subject",
+		},
+		{
+			"wildcard subject without only wildcards",
+			RR("document", "view"),
+			ONR("user", "*", "..."),
+			v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS,
+			nil,
+			"cannot perform lookup resources on wildcard",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctx, dispatcher, revision := newLocalDispatcherWithSchemaAndRels(t, schema, relationships)
+			defer dispatcher.Close()
+
+			stream := dispatch.NewCollectingDispatchStream[*v1.DispatchLookupResourcesResponse](ctx)
+			err := dispatcher.DispatchLookupResources(&v1.DispatchLookupResourcesRequest{
+				ObjectRelation: tc.permission,
+				Subject:        tc.subject,
+				Metadata: &v1.ResolverMeta{
+					AtRevision:     revision.String(),
+					DepthRemaining: 50,
+				},
+				WildcardSetting: tc.wildcardSetting,
+			}, stream)
+			if tc.expectedError != "" {
+				require.ErrorContains(err, tc.expectedError)
+				return
+			}
+			require.NoError(err)
+
+			// NOTE: a resource can be found more than once, but is annotated the same way each time.
+			foundWildcard := map[string]bool{}
+			for _, result := range stream.Results() {
+				resourceID := result.ResolvedResource.ResourceId
+				if grantedByWildcard, ok := foundWildcard[resourceID]; ok {
+					require.Equal(grantedByWildcard, result.ResolvedResource.GrantedByWildcard, "resource %s was annotated differently", resourceID)
+				}
+				foundWildcard[resourceID] = result.ResolvedResource.GrantedByWildcard
+			}
+
+			require.Equal(tc.expectedWildcard, foundWildcard)
+		})
+	}
+}
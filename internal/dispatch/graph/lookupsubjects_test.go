@@ -13,6 +13,7 @@ import (
 	"github.com/authzed/spicedb/internal/datastore/common"
 	"github.com/authzed/spicedb/internal/datastore/memdb"
 	"github.com/authzed/spicedb/internal/dispatch"
+	"github.com/authzed/spicedb/internal/graph"
 	log "github.com/authzed/spicedb/internal/logging"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/testfixtures"
@@ -192,6 +193,112 @@ func TestSimpleLookupSubjects(t *testing.T) {
 	}
 }
 
+func TestLookupSubjectsWithLimitAndCursor(t *testing.T) {
+	defer goleak.VerifyNone(t, goleakIgnores...)
+
+	testCases := []struct {
+		resourceType        string
+		resourceID          string
+		permission          string
+		candidateSubjectIDs []string
+		expectedSubjects    []string
+	}{
+		{
+			"document",
+			"masterplan",
+			"view",
+			nil,
+			[]string{"auditor", "chief_financial_officer", "eng_lead", "legal", "owner", "product_manager", "vp_product"},
+		},
+		{
+			"document",
+			"masterplan",
+			"view",
+			[]string{"legal", "owner", "unknown", "villain"},
+			[]string{"legal", "owner"},
+		},
+		{
+			"document",
+			"specialplan",
+			"viewer_and_editor",
+			nil,
+			[]string{"missingrolegal", "multiroleguy"},
+		},
+		{
+			"document",
+			"specialplan",
+			"view_and_edit",
+			nil,
+			[]string{"multiroleguy"},
+		},
+		{
+			"folder",
+			"strategy",
+			"view",
+			[]string{"auditor", "vp_product"},
+			[]string{"auditor", "vp_product"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		for _, limit := range []uint32{1, 2, 3, 100} {
+			limit := limit
+			t.Run(fmt.Sprintf("%s:%s#%s-%v-%d", tc.resourceType, tc.resourceID, tc.permission, tc.candidateSubjectIDs, limit), func(t *testing.T) {
+				require := require.New(t)
+
+				ctx, dis, revision := newLocalDispatcher(t)
+				defer dis.Close()
+
+				foundSubjectIds := []string{}
+				var cursor *v1.Cursor
+				for {
+					stream := dispatch.NewCollectingDispatchStream[*v1.DispatchLookupSubjectsResponse](ctx)
+					err := dis.DispatchLookupSubjects(&v1.DispatchLookupSubjectsRequest{
+						ResourceRelation: RR(tc.resourceType, tc.permission),
+						ResourceIds:      []string{tc.resourceID},
+						SubjectRelation:  RR("user", "..."),
+						Metadata: &v1.ResolverMeta{
+							AtRevision:     revision.String(),
+							DepthRemaining: 50,
+						},
+						OptionalSubjectIds: tc.candidateSubjectIDs,
+						OptionalCursor:     cursor,
+						OptionalLimit:      limit,
+					}, stream)
+					require.NoError(err)
+
+					// Each response is limited, so the responses are combined and limited again.
+					pageSubjectIds := map[string]struct{}{}
+					for _, result := range stream.Results() {
+						for _, found := range result.FoundSubjectsByResourceId[tc.resourceID].GetFoundSubjects() {
+							pageSubjectIds[found.SubjectId] = struct{}{}
+						}
+					}
+
+					page := make([]string, 0, len(pageSubjectIds))
+					for subjectID := range pageSubjectIds {
+						page = append(page, subjectID)
+					}
+					sort.Strings(page)
+					if len(page) > int(limit) {
+						page = page[:limit]
+					}
+
+					foundSubjectIds = append(foundSubjectIds, page...)
+					if len(page) < int(limit) {
+						break
+					}
+
+					cursor = graph.NewLookupSubjectsCursor(page[len(page)-1])
+				}
+
+				require.Equal(tc.expectedSubjects, foundSubjectIds)
+			})
+		}
+	}
+}
+
 func TestLookupSubjectsMaxDepth(t *testing.T) {
 	require := require.New(t)
 
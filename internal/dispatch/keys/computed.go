@@ -26,6 +26,7 @@ const (
 	expandPrefix             cachePrefix = "e"
 	reachableResourcesPrefix cachePrefix = "rr"
 	lookupSubjectsPrefix     cachePrefix = "ls"
+	tenantPrefix             cachePrefix = "t"
 )
 
 var cachePrefixes = []cachePrefix{
@@ -35,29 +36,50 @@ var cachePrefixes = []cachePrefix{
 	expandPrefix,
 	reachableResourcesPrefix,
 	lookupSubjectsPrefix,
+	tenantPrefix,
 }
 
 // checkRequestToKey converts a check request into a cache key based on the relation
 func checkRequestToKey(req *v1.DispatchCheckRequest, option dispatchCacheKeyHashComputeOption) DispatchCacheKey {
-	return dispatchCacheKeyHash(checkViaRelationPrefix, req.Metadata.AtRevision, option,
+	// NOTE: the additional subjects and the wildcard subject option are only hashed if given, to
+	// keep the keys of other requests stable.
+	hashables := []hashableValue{
 		hashableRelationReference{req.ResourceRelation},
 		hashableIds(req.ResourceIds),
 		hashableOnr{req.Subject},
 		hashableResultSetting(req.ResultsSetting),
-	)
+	}
+	hashables = appendCheckOptionHashables(req, hashables)
+
+	return dispatchCacheKeyHash(checkViaRelationPrefix, req.Metadata.AtRevision, option, hashables...)
+}
+
+// appendCheckOptionHashables appends the hashables of the optional parts of a check request,
+// if they are given.
+func appendCheckOptionHashables(req *v1.DispatchCheckRequest, hashables []hashableValue) []hashableValue {
+	if len(req.AdditionalSubjects) > 0 {
+		hashables = append(hashables, hashableOnrs(req.AdditionalSubjects))
+	}
+	if req.AllowWildcardSubject {
+		hashables = append(hashables, hashableString("allow-wildcard-subject"))
+	}
+	return hashables
 }
 
 // checkRequestToKeyWithCanonical converts a check request into a cache key based
 // on the canonical key.
 func checkRequestToKeyWithCanonical(req *v1.DispatchCheckRequest, canonicalKey string) (DispatchCacheKey, error) {
 	// NOTE: canonical cache keys are only unique *within* a version of a namespace.
-	cacheKey := dispatchCacheKeyHash(checkViaCanonicalPrefix, req.Metadata.AtRevision, computeBothHashes,
+	hashables := []hashableValue{
 		hashableString(req.ResourceRelation.Namespace),
 		hashableString(canonicalKey),
 		hashableIds(req.ResourceIds),
 		hashableOnr{req.Subject},
 		hashableResultSetting(req.ResultsSetting),
-	)
+	}
+	hashables = appendCheckOptionHashables(req, hashables)
+
+	cacheKey := dispatchCacheKeyHash(checkViaCanonicalPrefix, req.Metadata.AtRevision, computeBothHashes, hashables...)
 
 	if canonicalKey == "" {
 		return cacheKey, spiceerrors.MustBugf("given empty canonical key for request: %s => %s", req.ResourceRelation, tuple.StringONR(req.Subject))
@@ -68,8 +90,19 @@ func checkRequestToKeyWithCanonical(req *v1.DispatchCheckRequest, canonicalKey s
 
 // expandRequestToKey converts an expand request into a cache key
 func expandRequestToKey(req *v1.DispatchExpandRequest, option dispatchCacheKeyHashComputeOption) DispatchCacheKey {
+	// NOTE: the key of an unbounded expand is left unchanged by the limits, so that it remains
+	// stable across versions.
+	if req.OptionalMaxDepth == 0 && req.OptionalSubjectLimit == 0 && req.OptionalCursor == nil {
+		return dispatchCacheKeyHash(expandPrefix, req.Metadata.AtRevision, option,
+			hashableOnr{req.ResourceAndRelation},
+		)
+	}
+
 	return dispatchCacheKeyHash(expandPrefix, req.Metadata.AtRevision, option,
 		hashableOnr{req.ResourceAndRelation},
+		hashableLimit(req.OptionalMaxDepth),
+		hashableLimit(req.OptionalSubjectLimit),
+		hashableCursor{req.OptionalCursor},
 	)
 }
 
@@ -86,20 +119,49 @@ func reachableResourcesRequestToKey(req *v1.DispatchReachableResourcesRequest, o
 
 // lookupResourcesRequestToKey converts a lookup request into a cache key
 func lookupResourcesRequestToKey(req *v1.DispatchLookupResourcesRequest, option dispatchCacheKeyHashComputeOption) DispatchCacheKey {
-	return dispatchCacheKeyHash(lookupPrefix, req.Metadata.AtRevision, option,
+	hashables := []hashableValue{
 		hashableRelationReference{req.ObjectRelation},
 		hashableOnr{req.Subject},
 		hashableContext{HashableContext: caveats.HashableContext{Struct: req.Context}}, // NOTE: context is included here because lookup does a single dispatch
 		hashableCursor{req.OptionalCursor},
 		hashableLimit(req.OptionalLimit),
-	)
+	}
+
+	// NOTE: the wildcard setting is only hashed if given, to keep the keys of other requests stable.
+	if req.WildcardSetting != v1.DispatchLookupResourcesRequest_NO_WILDCARD_ANNOTATIONS {
+		hashables = append(hashables, hashableString(req.WildcardSetting.String()))
+	}
+
+	return dispatchCacheKeyHash(lookupPrefix, req.Metadata.AtRevision, option, hashables...)
 }
 
 // lookupSubjectsRequestToKey converts a lookup subjects request into a cache key
 func lookupSubjectsRequestToKey(req *v1.DispatchLookupSubjectsRequest, option dispatchCacheKeyHashComputeOption) DispatchCacheKey {
+	// NOTE: the key of an unrestricted lookup is left unchanged by the restrictions, so that it
+	// remains stable across versions.
+	if len(req.OptionalSubjectIds) == 0 && req.OptionalCursor == nil && req.OptionalLimit == 0 {
+		return dispatchCacheKeyHash(lookupSubjectsPrefix, req.Metadata.AtRevision, option,
+			hashableRelationReference{req.ResourceRelation},
+			hashableRelationReference{req.SubjectRelation},
+			hashableIds(req.ResourceIds),
+		)
+	}
+
 	return dispatchCacheKeyHash(lookupSubjectsPrefix, req.Metadata.AtRevision, option,
 		hashableRelationReference{req.ResourceRelation},
 		hashableRelationReference{req.SubjectRelation},
 		hashableIds(req.ResourceIds),
+		hashableIds(req.OptionalSubjectIds),
+		hashableCursor{req.OptionalCursor},
+		hashableLimit(req.OptionalLimit),
+	)
+}
+
+// tenantScopedKey converts a key computed for a request into a key scoped to the tenant the
+// request was made for.
+func tenantScopedKey(tenant string, key DispatchCacheKey, option dispatchCacheKeyHashComputeOption) DispatchCacheKey {
+	return dispatchCacheKeyHash(tenantPrefix, "", option,
+		hashableString(tenant),
+		hashableKey(key),
 	)
 }
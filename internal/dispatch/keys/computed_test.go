@@ -1,6 +1,7 @@
 package keys
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
@@ -49,6 +51,51 @@ func TestStableCacheKeys(t *testing.T) {
 			},
 			"e09cbca18290f7afae01",
 		},
+		{
+			"check with additional subjects",
+			func() DispatchCacheKey {
+				return checkRequestToKey(&v1.DispatchCheckRequest{
+					ResourceRelation:   RR("document", "view"),
+					ResourceIds:        []string{"foo"},
+					Subject:            ONR("user", "tom", "..."),
+					AdditionalSubjects: []*core.ObjectAndRelation{ONR("user", "sarah", "..."), ONR("group", "eng", "member")},
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+				}, computeBothHashes)
+			},
+			"b4b2e0d0a6c4c59d0b",
+		},
+		{
+			"check with additional subjects in canonical ordering",
+			func() DispatchCacheKey {
+				return checkRequestToKey(&v1.DispatchCheckRequest{
+					ResourceRelation:   RR("document", "view"),
+					ResourceIds:        []string{"foo"},
+					Subject:            ONR("user", "tom", "..."),
+					AdditionalSubjects: []*core.ObjectAndRelation{ONR("group", "eng", "member"), ONR("user", "sarah", "...")},
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+				}, computeBothHashes)
+			},
+			"b4b2e0d0a6c4c59d0b",
+		},
+		{
+			"check allowing a wildcard subject",
+			func() DispatchCacheKey {
+				return checkRequestToKey(&v1.DispatchCheckRequest{
+					ResourceRelation:     RR("document", "view"),
+					ResourceIds:          []string{"foo", "bar"},
+					Subject:              ONR("user", "*", "..."),
+					AllowWildcardSubject: true,
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+				}, computeBothHashes)
+			},
+			"b1b8caeac3a6d5e0c401",
+		},
 		{
 			"basic check with canonical ordering",
 			func() DispatchCacheKey {
@@ -128,6 +175,32 @@ func TestStableCacheKeys(t *testing.T) {
 			},
 			"f1b396da87bdeae2bd01",
 		},
+		{
+			"expand with max depth",
+			func() DispatchCacheKey {
+				return expandRequestToKey(&v1.DispatchExpandRequest{
+					ResourceAndRelation: ONR("document", "foo", "view"),
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+					OptionalMaxDepth: 1,
+				}, computeBothHashes)
+			},
+			"9bd3ebace59ecf9268",
+		},
+		{
+			"expand with subject limit",
+			func() DispatchCacheKey {
+				return expandRequestToKey(&v1.DispatchExpandRequest{
+					ResourceAndRelation: ONR("document", "foo", "view"),
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+					OptionalSubjectLimit: 1,
+				}, computeBothHashes)
+			},
+			"d7a9a4e7e1bee3ce1d",
+		},
 		{
 			"lookup resources",
 			func() DispatchCacheKey {
@@ -141,6 +214,34 @@ func TestStableCacheKeys(t *testing.T) {
 			},
 			"b4989ce7d2f695c251",
 		},
+		{
+			"lookup resources annotating wildcards",
+			func() DispatchCacheKey {
+				return lookupResourcesRequestToKey(&v1.DispatchLookupResourcesRequest{
+					ObjectRelation: RR("document", "view"),
+					Subject:        ONR("user", "mariah", "..."),
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+					WildcardSetting: v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS,
+				}, computeBothHashes)
+			},
+			"cda18ee7d087889753",
+		},
+		{
+			"lookup resources of only wildcards",
+			func() DispatchCacheKey {
+				return lookupResourcesRequestToKey(&v1.DispatchLookupResourcesRequest{
+					ObjectRelation: RR("document", "view"),
+					Subject:        ONR("user", "*", "..."),
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+					WildcardSetting: v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS,
+				}, computeBothHashes)
+			},
+			"f4dcf8fc8a8ce6f143",
+		},
 		{
 			"lookup resources with zero limit",
 			func() DispatchCacheKey {
@@ -405,6 +506,36 @@ func TestStableCacheKeys(t *testing.T) {
 			},
 			"d699c5b5d3a6dfade601",
 		},
+		{
+			"lookup subjects with candidate subjects",
+			func() DispatchCacheKey {
+				return lookupSubjectsRequestToKey(&v1.DispatchLookupSubjectsRequest{
+					ResourceRelation: RR("document", "view"),
+					SubjectRelation:  RR("user", "..."),
+					ResourceIds:      []string{"mariah", "tom"},
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+					OptionalSubjectIds: []string{"sarah"},
+				}, computeBothHashes)
+			},
+			"e68187a0e1e0cca247",
+		},
+		{
+			"lookup subjects with limit",
+			func() DispatchCacheKey {
+				return lookupSubjectsRequestToKey(&v1.DispatchLookupSubjectsRequest{
+					ResourceRelation: RR("document", "view"),
+					SubjectRelation:  RR("user", "..."),
+					ResourceIds:      []string{"mariah", "tom"},
+					Metadata: &v1.ResolverMeta{
+						AtRevision: "1234",
+					},
+					OptionalLimit: 10,
+				}, computeBothHashes)
+			},
+			"aaa7c3e5abb3c8f960",
+		},
 	}
 
 	for _, tc := range tcs {
@@ -550,6 +681,28 @@ var generatorFuncs = map[string]generatorFunc{
 				subjectRelation.Relation,
 			}, resourceIds...)
 	},
+
+	// Tenant-scoped Check.
+	string(tenantPrefix): func(
+		resourceIds []string,
+		subjectIds []string,
+		resourceRelation *core.RelationReference,
+		subjectRelation *core.RelationReference,
+		metadata *v1.ResolverMeta,
+	) (DispatchCacheKey, []string) {
+		return tenantScopedKey("sometenant", checkRequestToKey(&v1.DispatchCheckRequest{
+				ResourceRelation: resourceRelation,
+				ResourceIds:      resourceIds,
+				Subject:          ONR(subjectRelation.Namespace, subjectIds[0], subjectRelation.Relation),
+				Metadata:         metadata,
+			}, computeBothHashes), computeBothHashes), append([]string{
+				resourceRelation.Namespace,
+				resourceRelation.Relation,
+				subjectRelation.Namespace,
+				subjectIds[0],
+				subjectRelation.Relation,
+			}, resourceIds...)
+	},
 }
 
 func TestCacheKeyNoOverlap(t *testing.T) {
@@ -632,6 +785,60 @@ func TestCacheKeyNoOverlap(t *testing.T) {
 	}
 }
 
+func TestTenantScopedKeys(t *testing.T) {
+	req := &v1.DispatchCheckRequest{
+		ResourceRelation: RR("document", "view"),
+		ResourceIds:      []string{"foo"},
+		Subject:          ONR("user", "tom", "..."),
+		Metadata: &v1.ResolverMeta{
+			AtRevision: "1234",
+		},
+	}
+
+	handler := &DirectKeyHandler{}
+	cacheKeys := mapz.NewSet[DispatchCacheKey]()
+	dispatchKeys := mapz.NewSet[string]()
+	for _, tenant := range []string{"", "first", "second"} {
+		ctx := context.Background()
+		if tenant != "" {
+			ctx = tenantmw.ContextWithTenant(ctx, tenant)
+		}
+
+		cacheKey, err := handler.CheckCacheKey(ctx, req)
+		require.NoError(t, err)
+		require.True(t, cacheKeys.Add(cacheKey))
+
+		dispatchKey, err := handler.CheckDispatchKey(ctx, req)
+		require.NoError(t, err)
+		require.True(t, dispatchKeys.Add(hex.EncodeToString(dispatchKey)))
+	}
+
+	unscopedKey, err := handler.CheckCacheKey(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, checkRequestToKey(req, computeBothHashes), unscopedKey)
+}
+
+func TestTenantScopedKeysAreStableAcrossProcesses(t *testing.T) {
+	key := checkRequestToKey(&v1.DispatchCheckRequest{
+		ResourceRelation: RR("document", "view"),
+		ResourceIds:      []string{"foo"},
+		Subject:          ONR("user", "tom", "..."),
+		Metadata: &v1.ResolverMeta{
+			AtRevision: "1234",
+		},
+	}, computeBothHashes)
+
+	// Simulate the same key computed in another process, which has a different seed for its
+	// process-specific sum.
+	otherProcessKey := key
+	otherProcessKey.processSpecificSum++
+
+	scoped := tenantScopedKey("sometenant", key, computeBothHashes)
+	otherProcessScoped := tenantScopedKey("sometenant", otherProcessKey, computeBothHashes)
+	require.Equal(t, scoped.StableSumAsBytes(), otherProcessScoped.StableSumAsBytes())
+	require.Equal(t, scoped.StableKeyAsBytes(), otherProcessScoped.StableKeyAsBytes())
+}
+
 func TestComputeOnlyStableHash(t *testing.T) {
 	result := checkRequestToKey(&v1.DispatchCheckRequest{
 		ResourceRelation: RR("document", "view"),
@@ -669,4 +876,5 @@ func TestComputeContextHash(t *testing.T) {
 	}, computeBothHashes)
 
 	require.Equal(t, "a4eacff68ec68bca62", hex.EncodeToString(result.StableSumAsBytes()))
+	require.Equal(t, "62942e30eed3f5248149994c59802a78", hex.EncodeToString(result.StableKeyAsBytes()))
 }
@@ -8,6 +8,7 @@ import (
 // dispatched or cached.
 type DispatchCacheKey struct {
 	stableSum          uint64
+	verificationSum    uint64
 	processSpecificSum uint64
 }
 
@@ -18,6 +19,15 @@ func (dck DispatchCacheKey) StableSumAsBytes() []byte {
 	return binary.AppendUvarint(make([]byte, 0, 8), dck.stableSum)
 }
 
+// StableKeyAsBytes returns the full stable dispatch cache key as bytes, made up of the stable sum
+// and a second stable sum computed with a distinct seed. Unlike StableSumAsBytes, the returned
+// bytes are suitable for identifying cached entries shared between processes. The verification sum
+// is only computed for keys used for caching.
+func (dck DispatchCacheKey) StableKeyAsBytes() []byte {
+	key := binary.BigEndian.AppendUint64(make([]byte, 0, 16), dck.stableSum)
+	return binary.BigEndian.AppendUint64(key, dck.verificationSum)
+}
+
 // AsUInt64s returns the cache key in the form of two uint64's. This method returns uint64s created
 // from two distinct hashing algorithms, which should make the risk of key overlap incredibly
 // unlikely.
@@ -25,4 +35,4 @@ func (dck DispatchCacheKey) AsUInt64s() (uint64, uint64) {
 	return dck.processSpecificSum, dck.stableSum
 }
 
-var emptyDispatchCacheKey = DispatchCacheKey{0, 0}
+var emptyDispatchCacheKey = DispatchCacheKey{0, 0, 0}
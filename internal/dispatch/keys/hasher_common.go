@@ -59,12 +59,38 @@ func (hnr hashableOnr) AppendToHash(hasher hasherInterface) {
 	hasher.WriteString(hnr.Relation)
 }
 
+type hashableOnrs []*core.ObjectAndRelation
+
+func (hnrs hashableOnrs) AppendToHash(hasher hasherInterface) {
+	// Sort the ONRs to canonicalize them.
+	c := make([]string, 0, len(hnrs))
+	for _, onr := range hnrs {
+		c = append(c, tuple.StringONR(onr))
+	}
+	sort.Strings(c)
+
+	for _, onr := range c {
+		hasher.WriteString(onr)
+		hasher.WriteString(",")
+	}
+}
+
 type hashableString string
 
 func (hs hashableString) AppendToHash(hasher hasherInterface) {
 	hasher.WriteString(string(hs))
 }
 
+// hashableKey hashes the stable portions of an existing key, so that keys derived from it remain
+// the same across processes.
+type hashableKey DispatchCacheKey
+
+func (hk hashableKey) AppendToHash(hasher hasherInterface) {
+	hasher.WriteString(strconv.FormatUint(hk.stableSum, 10))
+	hasher.WriteString(",")
+	hasher.WriteString(strconv.FormatUint(hk.verificationSum, 10))
+}
+
 type hashableLimit uint32
 
 func (hl hashableLimit) AppendToHash(hasher hasherInterface) {
@@ -23,8 +23,13 @@ func dispatchCacheKeyHash(prefix cachePrefix, atRevision string, computeOption d
 	return hasher.BuildKey()
 }
 
+// verificationSeed is the seed of the second stable hash, which is combined with the stable sum
+// to identify entries shared between processes.
+const verificationSeed = 0x5370696365444221
+
 type dispatchCacheKeyHasher struct {
 	stableHasher       *xxhash.Digest
+	verificationHasher *xxhash.Digest
 	computeOption      dispatchCacheKeyHashComputeOption
 	processSpecificSum uint64
 }
@@ -35,6 +40,10 @@ func newDispatchCacheKeyHasher(prefix cachePrefix, computeOption dispatchCacheKe
 		computeOption: computeOption,
 	}
 
+	if computeOption == computeBothHashes {
+		h.verificationHasher = xxhash.NewWithSeed(verificationSeed)
+	}
+
 	prefixString := string(prefix)
 	h.WriteString(prefixString)
 	h.WriteString("/")
@@ -55,6 +64,7 @@ func (h *dispatchCacheKeyHasher) mustWriteString(value string) {
 	}
 
 	if h.computeOption == computeBothHashes {
+		_, _ = h.verificationHasher.WriteString(value)
 		h.processSpecificSum = runMemHash(h.processSpecificSum, []byte(value))
 	}
 }
@@ -76,8 +86,12 @@ func runMemHash(seed uint64, data []byte) uint64 {
 
 // BuildKey returns the constructed DispatchCheckKey.
 func (h *dispatchCacheKeyHasher) BuildKey() DispatchCacheKey {
-	return DispatchCacheKey{
+	key := DispatchCacheKey{
 		stableSum:          h.stableHasher.Sum64(),
 		processSpecificSum: h.processSpecificSum,
 	}
+	if h.verificationHasher != nil {
+		key.verificationSum = h.verificationHasher.Sum64()
+	}
+	return key
 }
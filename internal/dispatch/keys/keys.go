@@ -4,6 +4,7 @@ import (
 	"context"
 
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
 	"github.com/authzed/spicedb/internal/namespace"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 )
@@ -41,42 +42,52 @@ type Handler interface {
 	ReachableResourcesDispatchKey(ctx context.Context, req *v1.DispatchReachableResourcesRequest) ([]byte, error)
 }
 
+// scopedToTenant scopes a key computed for a request to the tenant of the context, if any, so
+// that tenants whose definitions share names never share dispatches or cache entries.
+func scopedToTenant(ctx context.Context, key DispatchCacheKey, option dispatchCacheKeyHashComputeOption) DispatchCacheKey {
+	tenant := tenantmw.FromContext(ctx)
+	if tenant == "" {
+		return key
+	}
+	return tenantScopedKey(tenant, key, option)
+}
+
 type baseKeyHandler struct{}
 
-func (b baseKeyHandler) LookupResourcesCacheKey(_ context.Context, req *v1.DispatchLookupResourcesRequest) (DispatchCacheKey, error) {
-	return lookupResourcesRequestToKey(req, computeBothHashes), nil
+func (b baseKeyHandler) LookupResourcesCacheKey(ctx context.Context, req *v1.DispatchLookupResourcesRequest) (DispatchCacheKey, error) {
+	return scopedToTenant(ctx, lookupResourcesRequestToKey(req, computeBothHashes), computeBothHashes), nil
 }
 
-func (b baseKeyHandler) LookupSubjectsCacheKey(_ context.Context, req *v1.DispatchLookupSubjectsRequest) (DispatchCacheKey, error) {
-	return lookupSubjectsRequestToKey(req, computeBothHashes), nil
+func (b baseKeyHandler) LookupSubjectsCacheKey(ctx context.Context, req *v1.DispatchLookupSubjectsRequest) (DispatchCacheKey, error) {
+	return scopedToTenant(ctx, lookupSubjectsRequestToKey(req, computeBothHashes), computeBothHashes), nil
 }
 
-func (b baseKeyHandler) ExpandCacheKey(_ context.Context, req *v1.DispatchExpandRequest) (DispatchCacheKey, error) {
-	return expandRequestToKey(req, computeBothHashes), nil
+func (b baseKeyHandler) ExpandCacheKey(ctx context.Context, req *v1.DispatchExpandRequest) (DispatchCacheKey, error) {
+	return scopedToTenant(ctx, expandRequestToKey(req, computeBothHashes), computeBothHashes), nil
 }
 
-func (b baseKeyHandler) ReachableResourcesCacheKey(_ context.Context, req *v1.DispatchReachableResourcesRequest) (DispatchCacheKey, error) {
-	return reachableResourcesRequestToKey(req, computeBothHashes), nil
+func (b baseKeyHandler) ReachableResourcesCacheKey(ctx context.Context, req *v1.DispatchReachableResourcesRequest) (DispatchCacheKey, error) {
+	return scopedToTenant(ctx, reachableResourcesRequestToKey(req, computeBothHashes), computeBothHashes), nil
 }
 
-func (b baseKeyHandler) CheckDispatchKey(_ context.Context, req *v1.DispatchCheckRequest) ([]byte, error) {
-	return checkRequestToKey(req, computeOnlyStableHash).StableSumAsBytes(), nil
+func (b baseKeyHandler) CheckDispatchKey(ctx context.Context, req *v1.DispatchCheckRequest) ([]byte, error) {
+	return scopedToTenant(ctx, checkRequestToKey(req, computeOnlyStableHash), computeOnlyStableHash).StableSumAsBytes(), nil
 }
 
-func (b baseKeyHandler) LookupResourcesDispatchKey(_ context.Context, req *v1.DispatchLookupResourcesRequest) ([]byte, error) {
-	return lookupResourcesRequestToKey(req, computeOnlyStableHash).StableSumAsBytes(), nil
+func (b baseKeyHandler) LookupResourcesDispatchKey(ctx context.Context, req *v1.DispatchLookupResourcesRequest) ([]byte, error) {
+	return scopedToTenant(ctx, lookupResourcesRequestToKey(req, computeOnlyStableHash), computeOnlyStableHash).StableSumAsBytes(), nil
 }
 
-func (b baseKeyHandler) LookupSubjectsDispatchKey(_ context.Context, req *v1.DispatchLookupSubjectsRequest) ([]byte, error) {
-	return lookupSubjectsRequestToKey(req, computeOnlyStableHash).StableSumAsBytes(), nil
+func (b baseKeyHandler) LookupSubjectsDispatchKey(ctx context.Context, req *v1.DispatchLookupSubjectsRequest) ([]byte, error) {
+	return scopedToTenant(ctx, lookupSubjectsRequestToKey(req, computeOnlyStableHash), computeOnlyStableHash).StableSumAsBytes(), nil
 }
 
-func (b baseKeyHandler) ExpandDispatchKey(_ context.Context, req *v1.DispatchExpandRequest) ([]byte, error) {
-	return expandRequestToKey(req, computeOnlyStableHash).StableSumAsBytes(), nil
+func (b baseKeyHandler) ExpandDispatchKey(ctx context.Context, req *v1.DispatchExpandRequest) ([]byte, error) {
+	return scopedToTenant(ctx, expandRequestToKey(req, computeOnlyStableHash), computeOnlyStableHash).StableSumAsBytes(), nil
 }
 
-func (b baseKeyHandler) ReachableResourcesDispatchKey(_ context.Context, req *v1.DispatchReachableResourcesRequest) ([]byte, error) {
-	return reachableResourcesRequestToKey(req, computeOnlyStableHash).StableSumAsBytes(), nil
+func (b baseKeyHandler) ReachableResourcesDispatchKey(ctx context.Context, req *v1.DispatchReachableResourcesRequest) ([]byte, error) {
+	return scopedToTenant(ctx, reachableResourcesRequestToKey(req, computeOnlyStableHash), computeOnlyStableHash).StableSumAsBytes(), nil
 }
 
 // DirectKeyHandler is a key handler that uses the relation name itself as the key.
@@ -84,8 +95,8 @@ type DirectKeyHandler struct {
 	baseKeyHandler
 }
 
-func (d *DirectKeyHandler) CheckCacheKey(_ context.Context, req *v1.DispatchCheckRequest) (DispatchCacheKey, error) {
-	return checkRequestToKey(req, computeBothHashes), nil
+func (d *DirectKeyHandler) CheckCacheKey(ctx context.Context, req *v1.DispatchCheckRequest) (DispatchCacheKey, error) {
+	return scopedToTenant(ctx, checkRequestToKey(req, computeBothHashes), computeBothHashes), nil
 }
 
 // CanonicalKeyHandler is a key handler which makes use of the canonical key for relations for
@@ -98,7 +109,7 @@ func (c *CanonicalKeyHandler) CheckCacheKey(ctx context.Context, req *v1.Dispatc
 	// NOTE: We do not use the canonicalized cache key when checking within the same namespace, as
 	// we may get different results if the subject being checked matches the resource exactly, e.g.
 	// a check for `somenamespace:someobject#somerel@somenamespace:someobject#somerel`.
-	if req.ResourceRelation.Namespace != req.Subject.Namespace {
+	if !req.HasSubjectOfType(req.ResourceRelation.Namespace) {
 		// Load the relation to get its computed cache key, if any.
 		ds := datastoremw.MustFromContext(ctx)
 
@@ -119,9 +130,13 @@ func (c *CanonicalKeyHandler) CheckCacheKey(ctx context.Context, req *v1.Dispatc
 		}
 
 		if relation.CanonicalCacheKey != "" {
-			return checkRequestToKeyWithCanonical(req, relation.CanonicalCacheKey)
+			key, err := checkRequestToKeyWithCanonical(req, relation.CanonicalCacheKey)
+			if err != nil {
+				return emptyDispatchCacheKey, err
+			}
+			return scopedToTenant(ctx, key, computeBothHashes), nil
 		}
 	}
 
-	return checkRequestToKey(req, computeBothHashes), nil
+	return scopedToTenant(ctx, checkRequestToKey(req, computeBothHashes), computeBothHashes), nil
 }
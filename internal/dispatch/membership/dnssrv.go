@@ -0,0 +1,65 @@
+package membership
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// SRVScheme is the scheme of targets resolved from DNS SRV records, e.g.
+// `dnssrv:///_dispatch._tcp.spicedb.example.com`.
+const SRVScheme = "dnssrv"
+
+// SRVResolverBuilder builds resolvers which look up the peers from the DNS SRV records of the
+// target name, using the target and port of each record.
+type SRVResolverBuilder struct {
+	// RefreshInterval is the interval at which the records are looked up and the peers are
+	// health checked. Defaults to DefaultRefreshInterval.
+	RefreshInterval time.Duration
+
+	// HealthCheckTimeout is the timeout for the health check of a peer. Defaults to
+	// DefaultHealthCheckTimeout.
+	HealthCheckTimeout time.Duration
+
+	// Resolver is the DNS resolver to use. Defaults to net.DefaultResolver.
+	Resolver *net.Resolver
+}
+
+// Build implements resolver.Builder.
+func (b *SRVResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	name := target.Endpoint()
+	if name == "" {
+		return nil, fmt.Errorf("missing name of the dispatch peer SRV records")
+	}
+
+	dnsResolver := b.Resolver
+	if dnsResolver == nil {
+		dnsResolver = net.DefaultResolver
+	}
+
+	lookupPeers := func(ctx context.Context) ([]string, error) {
+		_, records, err := dnsResolver.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up dispatch peer SRV records: %w", err)
+		}
+
+		peers := make([]string, 0, len(records))
+		for _, record := range records {
+			peers = append(peers, net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port))))
+		}
+		return peers, nil
+	}
+	return startPeerResolver(cc, lookupPeers, grpcHealthCheck(opts), b.RefreshInterval, b.HealthCheckTimeout), nil
+}
+
+// Scheme implements resolver.Builder.
+func (b *SRVResolverBuilder) Scheme() string {
+	return SRVScheme
+}
+
+var _ resolver.Builder = (*SRVResolverBuilder)(nil)
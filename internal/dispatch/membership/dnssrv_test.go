@@ -0,0 +1,109 @@
+package membership
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+	"google.golang.org/grpc/resolver"
+)
+
+func mustParseURL(t *testing.T, rawURL string) *url.URL {
+	parsed, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return parsed
+}
+
+// startFakeDNSServer starts a DNS server which answers every SRV query with a record for each
+// of the peers.
+func startFakeDNSServer(t *testing.T, peers []net.Listener) *net.Resolver {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			var parser dnsmessage.Parser
+			header, err := parser.Start(buf[:n])
+			if err != nil {
+				continue
+			}
+			question, err := parser.Question()
+			if err != nil {
+				continue
+			}
+
+			builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: header.ID, Response: true, Authoritative: true})
+			_ = builder.StartQuestions()
+			_ = builder.Question(question)
+			_ = builder.StartAnswers()
+			if question.Type == dnsmessage.TypeSRV {
+				for _, peer := range peers {
+					_, port, _ := net.SplitHostPort(peer.Addr().String())
+					parsedPort, _ := strconv.Atoi(port)
+					_ = builder.SRVResource(
+						dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: 1},
+						dnsmessage.SRVResource{Target: dnsmessage.MustNewName("localhost."), Port: uint16(parsedPort)},
+					)
+				}
+			}
+
+			response, err := builder.Finish()
+			if err != nil {
+				continue
+			}
+			_, _ = conn.WriteTo(response, addr)
+		}
+	}()
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+}
+
+func TestSRVResolver(t *testing.T) {
+	peers := startPeers(t, 3)
+
+	cc := &fakeClientConn{}
+	builder := &SRVResolverBuilder{
+		RefreshInterval: 10 * time.Millisecond,
+		Resolver:        startFakeDNSServer(t, peers),
+	}
+	require.Equal(t, SRVScheme, builder.Scheme())
+
+	r, err := builder.Build(resolver.Target{URL: *mustParseURL(t, "dnssrv:///_dispatch._tcp.spicedb.example.com.")}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	cc.requireAddresses(t, localhostAddrs(t, peers...)...)
+
+	require.NoError(t, peers[0].Close())
+	cc.requireAddresses(t, localhostAddrs(t, peers[1:]...)...)
+}
+
+func localhostAddrs(t *testing.T, listeners ...net.Listener) []string {
+	addresses := make([]string, 0, len(listeners))
+	for _, listener := range listeners {
+		_, port, err := net.SplitHostPort(listener.Addr().String())
+		require.NoError(t, err)
+		addresses = append(addresses, net.JoinHostPort("localhost", port))
+	}
+	return addresses
+}
@@ -0,0 +1,78 @@
+package membership
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// FileScheme is the scheme of targets resolved from a static peer file, e.g.
+// `file:///etc/spicedb/peers`.
+const FileScheme = "file"
+
+// FileResolverBuilder builds resolvers which read the peers from a file containing one
+// `host:port` address per line. Blank lines and lines starting with `#` are ignored. The file is
+// reread on every refresh, so changes to it are picked up without a restart.
+type FileResolverBuilder struct {
+	// RefreshInterval is the interval at which the file is reread and the peers are health
+	// checked. Defaults to DefaultRefreshInterval.
+	RefreshInterval time.Duration
+
+	// HealthCheckTimeout is the timeout for the health check of a peer. Defaults to
+	// DefaultHealthCheckTimeout.
+	HealthCheckTimeout time.Duration
+}
+
+// Build implements resolver.Builder.
+func (b *FileResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	path := target.URL.Path
+	if path == "" {
+		path = target.URL.Opaque
+	}
+	if path == "" {
+		return nil, errors.New("missing path of the dispatch peer file")
+	}
+
+	lookupPeers := func(_ context.Context) ([]string, error) {
+		return readPeerFile(path)
+	}
+	return startPeerResolver(cc, lookupPeers, grpcHealthCheck(opts), b.RefreshInterval, b.HealthCheckTimeout), nil
+}
+
+// Scheme implements resolver.Builder.
+func (b *FileResolverBuilder) Scheme() string {
+	return FileScheme
+}
+
+func readPeerFile(path string) ([]string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dispatch peer file: %w", err)
+	}
+
+	var peers []string
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if _, _, err := net.SplitHostPort(line); err != nil {
+			return nil, fmt.Errorf("invalid dispatch peer address on line %d of %s: %w", lineNumber, path, err)
+		}
+		peers = append(peers, line)
+	}
+
+	return peers, scanner.Err()
+}
+
+var _ resolver.Builder = (*FileResolverBuilder)(nil)
@@ -0,0 +1,236 @@
+// Package membership implements gRPC resolvers which provide the members of a
+// dispatch cluster outside of Kubernetes, from a static peer file or from DNS
+// SRV records. Peers which fail their health check are removed from the
+// resolved addresses, and thus from the dispatch hashring, until they recover.
+package membership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/resolver"
+
+	log "github.com/authzed/spicedb/internal/logging"
+	dispatchv1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+)
+
+const (
+	// DefaultRefreshInterval is the default interval at which peers are looked up and
+	// health checked.
+	DefaultRefreshInterval = 5 * time.Second
+
+	// DefaultHealthCheckTimeout is the default timeout for the health check of a peer.
+	DefaultHealthCheckTimeout = 1 * time.Second
+)
+
+var errNoPeers = errors.New("no dispatch peers found")
+
+// RegisterResolvers registers the resolvers for the `file` and `dnssrv` schemes with gRPC,
+// using the default refresh interval and health check timeout.
+func RegisterResolvers() {
+	resolver.Register(&FileResolverBuilder{})
+	resolver.Register(&SRVResolverBuilder{})
+}
+
+// lookupPeersFunc returns the addresses, in `host:port` form, of the peers.
+type lookupPeersFunc func(ctx context.Context) ([]string, error)
+
+// healthCheckFunc returns an error if the peer at the address is not healthy.
+type healthCheckFunc func(ctx context.Context, addr string) error
+
+// grpcHealthCheck returns a health check which queries the gRPC health service of a peer for the
+// status of the dispatch service. The peer is dialed with the transport credentials and dialer of
+// the client connection being resolved, so that peers serving dispatch over TLS can be checked.
+func grpcHealthCheck(opts resolver.BuildOptions) healthCheckFunc {
+	var dialOpts []grpc.DialOption
+	switch {
+	case opts.DialCreds != nil:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(opts.DialCreds))
+	case opts.CredsBundle != nil:
+		dialOpts = append(dialOpts, grpc.WithCredentialsBundle(opts.CredsBundle))
+	default:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	if opts.Dialer != nil {
+		dialOpts = append(dialOpts, grpc.WithContextDialer(opts.Dialer))
+	}
+
+	return func(ctx context.Context, addr string) error {
+		conn, err := grpc.NewClient("passthrough:///"+addr, dialOpts...)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+			Service: dispatchv1.DispatchService_ServiceDesc.ServiceName,
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("dispatch service is %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+// peerResolver is a resolver which periodically looks up the peers, health checks them and
+// updates the client connection with the addresses of the healthy peers.
+type peerResolver struct {
+	cc                 resolver.ClientConn
+	lookupPeers        lookupPeersFunc
+	healthCheck        healthCheckFunc
+	refreshInterval    time.Duration
+	healthCheckTimeout time.Duration
+
+	cancel     context.CancelFunc
+	resolveNow chan struct{}
+	done       chan struct{}
+
+	// resolved are the sorted addresses last sent to the client connection.
+	resolved []string
+}
+
+func startPeerResolver(cc resolver.ClientConn, lookupPeers lookupPeersFunc, healthCheck healthCheckFunc, refreshInterval time.Duration, healthCheckTimeout time.Duration) *peerResolver {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	if healthCheckTimeout <= 0 {
+		healthCheckTimeout = DefaultHealthCheckTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &peerResolver{
+		cc:                 cc,
+		lookupPeers:        lookupPeers,
+		healthCheck:        healthCheck,
+		refreshInterval:    refreshInterval,
+		healthCheckTimeout: healthCheckTimeout,
+		cancel:             cancel,
+		resolveNow:         make(chan struct{}, 1),
+		done:               make(chan struct{}),
+	}
+
+	go r.run(ctx)
+	return r
+}
+
+func (r *peerResolver) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		r.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.resolveNow:
+		}
+	}
+}
+
+func (r *peerResolver) refresh(ctx context.Context) {
+	peers, err := r.lookupPeers(ctx)
+	if err == nil && len(peers) == 0 {
+		err = errNoPeers
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Warn().Err(err).Msg("failed to look up dispatch peers")
+			r.cc.ReportError(err)
+		}
+		return
+	}
+
+	healthy := r.healthyPeers(ctx, peers)
+	if len(healthy) == 0 {
+		// Keep every peer in the hashring rather than none, so that dispatches fail with the
+		// connection errors of the peers and recover as soon as they do.
+		log.Warn().Strs("peers", peers).Msg("no dispatch peers passed their health check")
+		healthy = peers
+	}
+
+	slices.Sort(healthy)
+	healthy = slices.Compact(healthy)
+	if slices.Equal(healthy, r.resolved) {
+		return
+	}
+
+	addresses := make([]resolver.Address, 0, len(healthy))
+	for _, peer := range healthy {
+		host, _, err := net.SplitHostPort(peer)
+		if err != nil {
+			host = peer
+		}
+		addresses = append(addresses, resolver.Address{Addr: peer, ServerName: host})
+	}
+
+	log.Info().Strs("peers", healthy).Msg("updated dispatch peers")
+	if err := r.cc.UpdateState(resolver.State{Addresses: addresses}); err != nil {
+		log.Warn().Err(err).Msg("failed to update dispatch peers")
+		return
+	}
+	r.resolved = healthy
+}
+
+// healthyPeers health checks the peers concurrently and returns those which passed.
+func (r *peerResolver) healthyPeers(ctx context.Context, peers []string) []string {
+	var (
+		lock    sync.Mutex
+		wg      sync.WaitGroup
+		healthy = make([]string, 0, len(peers))
+	)
+
+	for _, peer := range peers {
+		peer := peer
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, r.healthCheckTimeout)
+			defer cancel()
+
+			if err := r.healthCheck(checkCtx, peer); err != nil {
+				log.Debug().Err(err).Str("peer", peer).Msg("dispatch peer failed its health check")
+				return
+			}
+
+			lock.Lock()
+			healthy = append(healthy, peer)
+			lock.Unlock()
+		}()
+	}
+
+	wg.Wait()
+	return healthy
+}
+
+// ResolveNow implements resolver.Resolver.
+func (r *peerResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+// Close implements resolver.Resolver.
+func (r *peerResolver) Close() {
+	r.cancel()
+	<-r.done
+}
+
+var _ resolver.Resolver = (*peerResolver)(nil)
@@ -0,0 +1,224 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/resolver"
+
+	dispatchv1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+)
+
+type fakeClientConn struct {
+	resolver.ClientConn
+
+	lock      sync.Mutex
+	addresses []string
+	err       error
+}
+
+func (f *fakeClientConn) UpdateState(state resolver.State) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.addresses = nil
+	for _, address := range state.Addresses {
+		f.addresses = append(f.addresses, address.Addr)
+	}
+	f.err = nil
+	return nil
+}
+
+func (f *fakeClientConn) ReportError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.err = err
+}
+
+func (f *fakeClientConn) requireAddresses(t *testing.T, expected ...string) {
+	slices.Sort(expected)
+	require.Eventually(t, func() bool {
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		return slices.Equal(expected, f.addresses)
+	}, 5*time.Second, 10*time.Millisecond, "expected addresses %v", expected)
+}
+
+// startPeers starts peers serving the gRPC health service, which report the dispatch service as
+// serving until their listener is closed.
+func startPeers(t *testing.T, count int) []net.Listener {
+	listeners := make([]net.Listener, 0, count)
+	for i := 0; i < count; i++ {
+		listener, _ := startPeer(t)
+		listeners = append(listeners, listener)
+	}
+	return listeners
+}
+
+func startPeer(t *testing.T) (net.Listener, *health.Server) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus(dispatchv1.DispatchService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthSrv)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener, healthSrv
+}
+
+func addrs(listeners ...net.Listener) []string {
+	addresses := make([]string, 0, len(listeners))
+	for _, listener := range listeners {
+		addresses = append(addresses, listener.Addr().String())
+	}
+	return addresses
+}
+
+func TestPeerResolverHealthChecks(t *testing.T) {
+	var (
+		lock      sync.Mutex
+		unhealthy = map[string]bool{}
+	)
+	healthCheck := func(_ context.Context, addr string) error {
+		lock.Lock()
+		defer lock.Unlock()
+		if unhealthy[addr] {
+			return errors.New("unhealthy")
+		}
+		return nil
+	}
+	setUnhealthy := func(addr string, value bool) {
+		lock.Lock()
+		defer lock.Unlock()
+		unhealthy[addr] = value
+	}
+
+	lookupPeers := func(_ context.Context) ([]string, error) {
+		return []string{"first:50053", "second:50053", "third:50053"}, nil
+	}
+
+	cc := &fakeClientConn{}
+	r := startPeerResolver(cc, lookupPeers, healthCheck, 10*time.Millisecond, time.Second)
+	defer r.Close()
+
+	cc.requireAddresses(t, "first:50053", "second:50053", "third:50053")
+
+	setUnhealthy("second:50053", true)
+	cc.requireAddresses(t, "first:50053", "third:50053")
+
+	setUnhealthy("second:50053", false)
+	cc.requireAddresses(t, "first:50053", "second:50053", "third:50053")
+
+	// If no peer is healthy, all of them are kept.
+	setUnhealthy("first:50053", true)
+	setUnhealthy("second:50053", true)
+	setUnhealthy("third:50053", true)
+	time.Sleep(50 * time.Millisecond)
+	cc.requireAddresses(t, "first:50053", "second:50053", "third:50053")
+}
+
+func TestGRPCHealthCheck(t *testing.T) {
+	healthCheck := grpcHealthCheck(resolver.BuildOptions{})
+	check := func(addr string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		return healthCheck(ctx, addr)
+	}
+
+	peer, healthSrv := startPeer(t)
+	require.NoError(t, check(peer.Addr().String()))
+
+	// Peers which report the dispatch service as not serving are unhealthy.
+	healthSrv.SetServingStatus(dispatchv1.DispatchService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	require.ErrorContains(t, check(peer.Addr().String()), "NOT_SERVING")
+
+	// As are peers which accept connections without serving gRPC.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	require.Error(t, check(listener.Addr().String()))
+
+	// And peers which are gone.
+	require.NoError(t, peer.Close())
+	require.Error(t, check(peer.Addr().String()))
+}
+
+func TestFileResolver(t *testing.T) {
+	peers := startPeers(t, 3)
+
+	path := filepath.Join(t.TempDir(), "peers")
+	writePeers := func(addresses ...string) {
+		contents := "# dispatch peers\n\n" + strings.Join(addresses, "\n") + "\n"
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	}
+	writePeers(addrs(peers[0], peers[1])...)
+
+	cc := &fakeClientConn{}
+	builder := &FileResolverBuilder{RefreshInterval: 10 * time.Millisecond}
+	require.Equal(t, FileScheme, builder.Scheme())
+
+	r, err := builder.Build(resolver.Target{URL: *mustParseURL(t, "file://"+path)}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	cc.requireAddresses(t, addrs(peers[0], peers[1])...)
+
+	// Peers added to the file are picked up.
+	writePeers(addrs(peers...)...)
+	cc.requireAddresses(t, addrs(peers...)...)
+
+	// Peers which stop accepting connections are removed, until they return.
+	require.NoError(t, peers[1].Close())
+	cc.requireAddresses(t, addrs(peers[0], peers[2])...)
+}
+
+func TestReadPeerFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peers")
+
+	require.NoError(t, os.WriteFile(path, []byte("# comment\n  first:50053  \n\nsecond.example.com:50053\n"), 0o600))
+	peers, err := readPeerFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first:50053", "second.example.com:50053"}, peers)
+
+	require.NoError(t, os.WriteFile(path, []byte("first:50053\nsecond\n"), 0o600))
+	_, err = readPeerFile(path)
+	require.ErrorContains(t, err, "invalid dispatch peer address on line 2")
+
+	_, err = readPeerFile(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, err, "failed to read dispatch peer file")
+}
+
+func TestFileResolverMissingFile(t *testing.T) {
+	cc := &fakeClientConn{}
+	builder := &FileResolverBuilder{RefreshInterval: 10 * time.Millisecond}
+
+	r, err := builder.Build(resolver.Target{URL: *mustParseURL(t, "file:///does/not/exist")}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	require.Eventually(t, func() bool {
+		cc.lock.Lock()
+		defer cc.lock.Unlock()
+		return cc.err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -99,7 +99,7 @@ func (cr *clusterDispatcher) DispatchCheck(ctx context.Context, req *v1.Dispatch
 		return &v1.DispatchCheckResponse{Metadata: emptyMetadata}, err
 	}
 
-	ctx = context.WithValue(ctx, consistent.CtxKey, requestKey)
+	ctx = dispatch.OutgoingAdmittedContext(context.WithValue(ctx, consistent.CtxKey, requestKey))
 
 	resp, err := dispatchRequest(ctx, cr, "check", req, func(ctx context.Context, client ClusterClient) (*v1.DispatchCheckResponse, error) {
 		resp, err := client.DispatchCheck(ctx, req)
@@ -239,7 +239,7 @@ func (cr *clusterDispatcher) DispatchExpand(ctx context.Context, req *v1.Dispatc
 		return &v1.DispatchExpandResponse{Metadata: emptyMetadata}, err
 	}
 
-	ctx = context.WithValue(ctx, consistent.CtxKey, requestKey)
+	ctx = dispatch.OutgoingAdmittedContext(context.WithValue(ctx, consistent.CtxKey, requestKey))
 
 	withTimeout, cancelFn := context.WithTimeout(ctx, cr.dispatchOverallTimeout)
 	defer cancelFn()
@@ -262,7 +262,7 @@ func (cr *clusterDispatcher) DispatchReachableResources(
 		return err
 	}
 
-	ctx := context.WithValue(stream.Context(), consistent.CtxKey, requestKey)
+	ctx := dispatch.OutgoingAdmittedContext(context.WithValue(stream.Context(), consistent.CtxKey, requestKey))
 	stream = dispatch.StreamWithContext(ctx, stream)
 
 	if err := dispatch.CheckDepth(ctx, req); err != nil {
@@ -312,7 +312,7 @@ func (cr *clusterDispatcher) DispatchLookupResources(
 		return err
 	}
 
-	ctx := context.WithValue(stream.Context(), consistent.CtxKey, requestKey)
+	ctx := dispatch.OutgoingAdmittedContext(context.WithValue(stream.Context(), consistent.CtxKey, requestKey))
 	stream = dispatch.StreamWithContext(ctx, stream)
 
 	if err := dispatch.CheckDepth(ctx, req); err != nil {
@@ -362,7 +362,7 @@ func (cr *clusterDispatcher) DispatchLookupSubjects(
 		return err
 	}
 
-	ctx := context.WithValue(stream.Context(), consistent.CtxKey, requestKey)
+	ctx := dispatch.OutgoingAdmittedContext(context.WithValue(stream.Context(), consistent.CtxKey, requestKey))
 	stream = dispatch.StreamWithContext(ctx, stream)
 
 	if err := dispatch.CheckDepth(ctx, req); err != nil {
@@ -48,15 +48,16 @@ func init() {
 }
 
 // NewConcurrentChecker creates an instance of ConcurrentChecker.
-func NewConcurrentChecker(d dispatch.Check, concurrencyLimit uint16) *ConcurrentChecker {
-	return &ConcurrentChecker{d, concurrencyLimit}
+func NewConcurrentChecker(d dispatch.Check, concurrencyLimiter taskrunner.ConcurrencyLimiter) *ConcurrentChecker {
+	return &ConcurrentChecker{d, concurrencyLimiter, newCheckPlanner()}
 }
 
 // ConcurrentChecker exposes a method to perform Check requests, and delegates subproblems to the
 // provided dispatch.Check instance.
 type ConcurrentChecker struct {
-	d                dispatch.Check
-	concurrencyLimit uint16
+	d                  dispatch.Check
+	concurrencyLimiter taskrunner.ConcurrencyLimiter
+	planner            *checkPlanner
 }
 
 // ValidatedCheckRequest represents a request after it has been validated and parsed for internal
@@ -72,6 +73,10 @@ type currentRequestContext struct {
 	// parentReq is the parent request being processed.
 	parentReq ValidatedCheckRequest
 
+	// namespace is the definition of the resource type being checked, as resolved by the
+	// dispatcher. It may be nil, in which case the planner estimates costs without the schema.
+	namespace *core.NamespaceDefinition
+
 	// filteredResourceIDs are those resource IDs to be checked after filtering for
 	// any resource IDs found directly matching the incoming subject.
 	//
@@ -90,18 +95,44 @@ type currentRequestContext struct {
 
 	// maxDispatchCount is the maximum number of resource IDs that can be specified in each dispatch.
 	maxDispatchCount uint16
+
+	// subjects are the distinct subjects being checked.
+	subjects []checkedSubject
+
+	// keyedBySubject is whether the request has additional subjects, in which case the results are
+	// keyed by both resource ID and subject, as per resultKey.
+	keyedBySubject bool
+
+	// planDecisions collects the decisions of the planner for the debug trace, if debugging.
+	planDecisions *checkPlanDecisions
+
+	// narrowed is whether the resource IDs to be checked were narrowed by a branch of an
+	// intersection or exclusion evaluated first, per the plan of the planner.
+	narrowed bool
 }
 
-// Check performs a check request with the provided request and context
-func (cc *ConcurrentChecker) Check(ctx context.Context, req ValidatedCheckRequest, relation *core.Relation) (*v1.DispatchCheckResponse, error) {
+// withAllResultsRequired returns the context with the results setting requiring all results.
+func (crc currentRequestContext) withAllResultsRequired() currentRequestContext {
+	crc.resultsSetting = v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS
+	return crc
+}
+
+// Check performs a check request with the provided request and context, for the given relation of
+// the given namespace definition.
+func (cc *ConcurrentChecker) Check(ctx context.Context, req ValidatedCheckRequest, nsDef *core.NamespaceDefinition, relation *core.Relation) (*v1.DispatchCheckResponse, error) {
 	var startTime *time.Time
+	var planDecisions *checkPlanDecisions
 	if req.Debug != v1.DispatchCheckRequest_NO_DEBUG {
 		now := time.Now()
 		startTime = &now
+		planDecisions = &checkPlanDecisions{}
 	}
 
-	resolved := cc.checkInternal(ctx, req, relation)
+	resolved := cc.checkInternal(ctx, req, nsDef, relation, planDecisions)
 	resolved.Resp.Metadata = addCallToResponseMetadata(resolved.Resp.Metadata)
+	if len(req.AdditionalSubjects) > 0 {
+		toResultsBySubject(resolved.Resp)
+	}
 	if req.Debug == v1.DispatchCheckRequest_NO_DEBUG {
 		return resolved.Resp, resolved.Err
 	}
@@ -132,11 +163,12 @@ func (cc *ConcurrentChecker) Check(ctx context.Context, req ValidatedCheckReques
 	}
 
 	debugInfo.Check.Results = results
+	debugInfo.Check.PlanDecisions = planDecisions.all()
 	resolved.Resp.Metadata.DebugInfo = debugInfo
 	return resolved.Resp, resolved.Err
 }
 
-func (cc *ConcurrentChecker) checkInternal(ctx context.Context, req ValidatedCheckRequest, relation *core.Relation) CheckResult {
+func (cc *ConcurrentChecker) checkInternal(ctx context.Context, req ValidatedCheckRequest, nsDef *core.NamespaceDefinition, relation *core.Relation, planDecisions *checkPlanDecisions) CheckResult {
 	// Ensure that we have proper type information for running the check. This is now required as of the deprecation and removal
 	// of the v0 API.
 	if relation.GetTypeInformation() == nil && relation.GetUsersetRewrite() == nil {
@@ -154,22 +186,40 @@ func (cc *ConcurrentChecker) checkInternal(ctx context.Context, req ValidatedChe
 		)
 	}
 
-	// Ensure that we are not performing a check for a wildcard as the subject.
-	if req.Subject.ObjectId == tuple.PublicWildcard {
-		return checkResultError(NewErrInvalidArgument(errors.New("cannot perform check on wildcard")), emptyMetadata)
+	// Ensure that we are not performing a check for a wildcard as the subject, unless explicitly allowed.
+	subjects := checkedSubjects(req.DispatchCheckRequest)
+	for _, subject := range subjects {
+		if subject.onr.ObjectId == tuple.PublicWildcard && !req.AllowWildcardSubject {
+			return checkResultError(NewErrInvalidArgument(errors.New("cannot perform check on wildcard")), emptyMetadata)
+		}
+	}
+
+	keyedBySubject := len(req.AdditionalSubjects) > 0
+	if keyedBySubject && req.Debug != v1.DispatchCheckRequest_NO_DEBUG {
+		return checkResultError(NewErrInvalidArgument(errors.New("cannot debug a check of additional subjects")), emptyMetadata)
 	}
 
 	// Deduplicate any incoming resource IDs.
 	resourceIds := lo.Uniq(req.ResourceIds)
 
+	crc := currentRequestContext{
+		parentReq:        req,
+		namespace:        nsDef,
+		resultsSetting:   req.ResultsSetting,
+		maxDispatchCount: maxDispatchChunkSize,
+		subjects:         subjects,
+		keyedBySubject:   keyedBySubject,
+		planDecisions:    planDecisions,
+	}
+
 	// Filter the incoming resource IDs for any which match the subject directly. For example, if we receive
 	// a check for resource `user:{tom, fred, sarah}#...` and a subject of `user:sarah#...`, then we know
 	// that `user:sarah#...` is a valid "member" of the resource, as it matches exactly.
 	//
 	// If the filtering results in no further resource IDs to check, or a result is found and a single
 	// result is allowed, we terminate early.
-	membershipSet, filteredResourcesIds := filterForFoundMemberResource(req.ResourceRelation, resourceIds, req.Subject)
-	if membershipSet.HasDeterminedMember() && req.DispatchCheckRequest.ResultsSetting == v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT {
+	membershipSet, filteredResourcesIds := crc.filterForFoundMemberResource(req.ResourceRelation, resourceIds)
+	if membershipSet.HasDeterminedMember() && req.DispatchCheckRequest.ResultsSetting == v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT && !keyedBySubject {
 		return checkResultsForMembership(membershipSet, emptyMetadata)
 	}
 
@@ -179,16 +229,13 @@ func (cc *ConcurrentChecker) checkInternal(ctx context.Context, req ValidatedChe
 
 	// NOTE: We can always allow a single result if we're only trying to find the results for a
 	// single resource ID. This "reset" allows for short circuiting of downstream dispatched calls.
-	resultsSetting := req.ResultsSetting
+	// The results for every subject are required when checking additional subjects, however.
+	crc.filteredResourceIDs = filteredResourcesIds
 	if len(filteredResourcesIds) == 1 {
-		resultsSetting = v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT
+		crc.resultsSetting = v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT
 	}
-
-	crc := currentRequestContext{
-		parentReq:           req,
-		filteredResourceIDs: filteredResourcesIds,
-		resultsSetting:      resultsSetting,
-		maxDispatchCount:    maxDispatchChunkSize,
+	if keyedBySubject {
+		crc.resultsSetting = v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS
 	}
 
 	if req.Debug == v1.DispatchCheckRequest_ENABLE_TRACE_DEBUGGING {
@@ -233,16 +280,24 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 	log.Ctx(ctx).Trace().Object("direct", crc.parentReq).Send()
 	ds := datastoremw.MustFromContext(ctx).SnapshotReader(crc.parentReq.Revision)
 
+	directSubjects := tuple.NewONRByTypeSet()
+	wildcardSubjectTypes := mapz.NewSet[string]()
 	for _, allowedDirectRelation := range relation.GetTypeInformation().GetAllowedDirectRelations() {
 		// If the namespace of the allowed direct relation matches the subject type, there are two
 		// cases to optimize:
 		// 1) Finding the target subject itself, as a direct lookup
 		// 2) Finding a wildcard for the subject type+relation
-		if allowedDirectRelation.GetNamespace() == crc.parentReq.Subject.Namespace {
+		for _, subject := range crc.subjects {
+			if allowedDirectRelation.GetNamespace() != subject.onr.Namespace {
+				continue
+			}
+
 			if allowedDirectRelation.GetPublicWildcard() != nil {
 				hasWildcardSubject = true
-			} else if allowedDirectRelation.GetRelation() == crc.parentReq.Subject.Relation {
+				wildcardSubjectTypes.Add(subject.onr.Namespace)
+			} else if allowedDirectRelation.GetRelation() == subject.onr.Relation {
 				hasDirectSubject = true
+				directSubjects.Add(subject.onr)
 			}
 		}
 
@@ -268,17 +323,17 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 	if hasDirectSubject || hasWildcardSubject {
 		subjectSelectors := []datastore.SubjectsSelector{}
 
-		if hasDirectSubject {
+		directSubjects.ForEachType(func(rr *core.RelationReference, subjectIDs []string) {
 			subjectSelectors = append(subjectSelectors, datastore.SubjectsSelector{
-				OptionalSubjectType: crc.parentReq.Subject.Namespace,
-				OptionalSubjectIds:  []string{crc.parentReq.Subject.ObjectId},
-				RelationFilter:      datastore.SubjectRelationFilter{}.WithRelation(crc.parentReq.Subject.Relation),
+				OptionalSubjectType: rr.Namespace,
+				OptionalSubjectIds:  lo.Uniq(subjectIDs),
+				RelationFilter:      datastore.SubjectRelationFilter{}.WithRelation(rr.Relation),
 			})
-		}
+		})
 
-		if hasWildcardSubject {
+		for _, subjectType := range wildcardSubjectTypes.AsSlice() {
 			subjectSelectors = append(subjectSelectors, datastore.SubjectsSelector{
-				OptionalSubjectType: crc.parentReq.Subject.Namespace,
+				OptionalSubjectType: subjectType,
 				OptionalSubjectIds:  []string{tuple.PublicWildcard},
 				RelationFilter:      datastore.SubjectRelationFilter{}.WithEllipsisRelation(),
 			})
@@ -304,9 +359,17 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 				return checkResultError(NewCheckFailureErr(it.Err()), emptyMetadata)
 			}
 
-			// If the subject of the relationship matches the target subject, then we've found
+			// If the subject of the relationship matches a target subject, then we've found
 			// a result.
-			if !tuple.OnrEqualOrWildcard(tpl.Subject, crc.parentReq.Subject) {
+			matched := false
+			for _, subject := range crc.subjects {
+				if tuple.OnrEqualOrWildcard(tpl.Subject, subject.onr) {
+					matched = true
+					foundResources.AddDirectMember(crc.resultKey(tpl.ResourceAndRelation.ObjectId, subject.key), tpl.Caveat)
+				}
+			}
+
+			if !matched {
 				tplString, err := tuple.String(tpl)
 				if err != nil {
 					return checkResultError(err, emptyMetadata)
@@ -320,7 +383,6 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 				)
 			}
 
-			foundResources.AddDirectMember(tpl.ResourceAndRelation.ObjectId, tpl.Caveat)
 			if crc.resultsSetting == v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT && foundResources.HasDeterminedMember() {
 				return checkResultsForMembership(foundResources, emptyMetadata)
 			}
@@ -329,10 +391,18 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 	}
 
 	// Filter down the resource IDs for further dispatch based on whether they exist as found
-	// subjects in the existing membership set.
-	furtherFilteredResourceIDs := make([]string, 0, len(crc.filteredResourceIDs)-foundResources.Size())
+	// subjects in the existing membership set, for every subject checked.
+	furtherFilteredResourceIDs := make([]string, 0, len(crc.filteredResourceIDs))
 	for _, resourceID := range crc.filteredResourceIDs {
-		if foundResources.HasConcreteResourceID(resourceID) {
+		foundForAllSubjects := true
+		for _, subjectKey := range crc.subjectKeys() {
+			if !foundResources.HasConcreteResourceID(crc.resultKey(resourceID, subjectKey)) {
+				foundForAllSubjects = false
+				break
+			}
+		}
+
+		if foundForAllSubjects {
 			continue
 		}
 
@@ -403,10 +473,12 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 	result := union(ctx, crc, toDispatch, func(ctx context.Context, crc currentRequestContext, dd directDispatch) CheckResult {
 		childResult := cc.dispatch(ctx, crc, ValidatedCheckRequest{
 			&v1.DispatchCheckRequest{
-				ResourceRelation: dd.resourceType,
-				ResourceIds:      dd.resourceIds,
-				Subject:          crc.parentReq.Subject,
-				ResultsSetting:   crc.resultsSetting,
+				ResourceRelation:     dd.resourceType,
+				ResourceIds:          dd.resourceIds,
+				Subject:              crc.parentReq.Subject,
+				AdditionalSubjects:   crc.parentReq.AdditionalSubjects,
+				ResultsSetting:       crc.resultsSetting,
+				AllowWildcardSubject: crc.parentReq.AllowWildcardSubject,
 
 				Metadata: decrementDepth(crc.parentReq.Metadata),
 				Debug:    crc.parentReq.Debug,
@@ -417,16 +489,17 @@ func (cc *ConcurrentChecker) checkDirect(ctx context.Context, crc currentRequest
 			return childResult
 		}
 
-		return mapFoundResources(childResult, dd.resourceType, relationshipsBySubjectONR)
-	}, cc.concurrencyLimit)
+		return mapFoundResources(crc, childResult, dd.resourceType, relationshipsBySubjectONR)
+	}, cc.concurrencyLimiter.ConcurrencyLimit())
 
 	return combineResultWithFoundResources(result, foundResources)
 }
 
-func mapFoundResources(result CheckResult, resourceType *core.RelationReference, relationshipsBySubjectONR *mapz.MultiMap[string, *core.RelationTuple]) CheckResult {
+func mapFoundResources(crc currentRequestContext, result CheckResult, resourceType *core.RelationReference, relationshipsBySubjectONR *mapz.MultiMap[string, *core.RelationTuple]) CheckResult {
 	// Map any resources found to the parent resource IDs.
 	membershipSet := NewMembershipSet()
-	for foundResourceID, result := range result.Resp.ResultsByResourceId {
+	for foundKey, result := range result.Resp.ResultsByResourceId {
+		foundResourceID, checkedSubjectKey := crc.splitResultKey(foundKey)
 		subjectKey := tuple.StringONR(&core.ObjectAndRelation{
 			Namespace: resourceType.Namespace,
 			ObjectId:  foundResourceID,
@@ -435,7 +508,7 @@ func mapFoundResources(result CheckResult, resourceType *core.RelationReference,
 
 		tuples, _ := relationshipsBySubjectONR.Get(subjectKey)
 		for _, relationTuple := range tuples {
-			membershipSet.AddMemberViaRelationship(relationTuple.ResourceAndRelation.ObjectId, result.Expression, relationTuple)
+			membershipSet.AddMemberViaRelationship(crc.resultKey(relationTuple.ResourceAndRelation.ObjectId, checkedSubjectKey), result.Expression, relationTuple)
 		}
 	}
 
@@ -454,26 +527,39 @@ func (cc *ConcurrentChecker) checkUsersetRewrite(ctx context.Context, crc curren
 			ctx, span = tracer.Start(ctx, "+")
 			defer span.End()
 		}
-		return union(ctx, crc, rw.Union.Child, cc.runSetOperation, cc.concurrencyLimit)
+		return union(ctx, crc, rw.Union.Child, cc.runSetOperation, cc.concurrencyLimiter.ConcurrencyLimit())
 	case *core.UsersetRewrite_Intersection:
 		ctx, span := tracer.Start(ctx, "&")
 		defer span.End()
-		return all(ctx, crc, rw.Intersection.Child, cc.runSetOperation, cc.concurrencyLimit)
+		plan := cc.planner.plan(ctx, crc, v1.CheckPlanDecision_INTERSECTION, rw.Intersection.Child)
+		return all(ctx, crc, rw.Intersection.Child, cc.runPlannedSetOperation, plan, cc.concurrencyLimiter.ConcurrencyLimit())
 	case *core.UsersetRewrite_Exclusion:
 		ctx, span := tracer.Start(ctx, "-")
 		defer span.End()
-		return difference(ctx, crc, rw.Exclusion.Child, cc.runSetOperation, cc.concurrencyLimit)
+		plan := cc.planner.plan(ctx, crc, v1.CheckPlanDecision_EXCLUSION, rw.Exclusion.Child)
+		return difference(ctx, crc, rw.Exclusion.Child, cc.runPlannedSetOperation, plan, cc.concurrencyLimiter.ConcurrencyLimit())
 	default:
 		return checkResultError(fmt.Errorf("unknown userset rewrite operator"), emptyMetadata)
 	}
 }
 
-func (cc *ConcurrentChecker) dispatch(ctx context.Context, _ currentRequestContext, req ValidatedCheckRequest) CheckResult {
+func (cc *ConcurrentChecker) dispatch(ctx context.Context, crc currentRequestContext, req ValidatedCheckRequest) CheckResult {
 	log.Ctx(ctx).Trace().Object("dispatch", req).Send()
 	result, err := cc.d.DispatchCheck(ctx, req.DispatchCheckRequest)
+	if crc.keyedBySubject && result != nil {
+		result = fromResultsBySubject(result)
+	}
 	return CheckResult{result, err}
 }
 
+// runPlannedSetOperation runs a branch of an intersection or exclusion, recording its cost for the
+// planning of later checks.
+func (cc *ConcurrentChecker) runPlannedSetOperation(ctx context.Context, crc currentRequestContext, childOneof *core.SetOperation_Child) CheckResult {
+	result := cc.runSetOperation(ctx, crc, childOneof)
+	cc.planner.observe(ctx, crc, childOneof, result)
+	return result
+}
+
 func (cc *ConcurrentChecker) runSetOperation(ctx context.Context, crc currentRequestContext, childOneof *core.SetOperation_Child) CheckResult {
 	switch child := childOneof.ChildType.(type) {
 	case *core.SetOperation_Child_XThis:
@@ -519,7 +605,7 @@ func (cc *ConcurrentChecker) checkComputedUserset(ctx context.Context, crc curre
 	}
 
 	// If we will be dispatching to the goal's ONR, then we know that the ONR is a member.
-	membershipSet, updatedTargetResourceIds := filterForFoundMemberResource(targetRR, targetResourceIds, crc.parentReq.Subject)
+	membershipSet, updatedTargetResourceIds := crc.filterForFoundMemberResource(targetRR, targetResourceIds)
 	if (membershipSet.HasDeterminedMember() && crc.resultsSetting == v1.DispatchCheckRequest_ALLOW_SINGLE_RESULT) || len(updatedTargetResourceIds) == 0 {
 		return checkResultsForMembership(membershipSet, emptyMetadata)
 	}
@@ -541,12 +627,14 @@ func (cc *ConcurrentChecker) checkComputedUserset(ctx context.Context, crc curre
 
 	result := cc.dispatch(ctx, crc, ValidatedCheckRequest{
 		&v1.DispatchCheckRequest{
-			ResourceRelation: targetRR,
-			ResourceIds:      updatedTargetResourceIds,
-			Subject:          crc.parentReq.Subject,
-			ResultsSetting:   crc.resultsSetting,
-			Metadata:         decrementDepth(crc.parentReq.Metadata),
-			Debug:            crc.parentReq.Debug,
+			ResourceRelation:     targetRR,
+			ResourceIds:          updatedTargetResourceIds,
+			Subject:              crc.parentReq.Subject,
+			AdditionalSubjects:   crc.parentReq.AdditionalSubjects,
+			ResultsSetting:       crc.resultsSetting,
+			AllowWildcardSubject: crc.parentReq.AllowWildcardSubject,
+			Metadata:             decrementDepth(crc.parentReq.Metadata),
+			Debug:                crc.parentReq.Debug,
 		},
 		crc.parentReq.Revision,
 	})
@@ -575,11 +663,15 @@ func removeIndexFromSlice[T any](s []T, index int) []T {
 	return append(cpy, s[index+1:]...)
 }
 
-func (cc *ConcurrentChecker) checkTupleToUserset(ctx context.Context, crc currentRequestContext, ttu *core.TupleToUserset) CheckResult {
-	ctx, span := tracer.Start(ctx, ttu.Tupleset.Relation+"->"+ttu.ComputedUserset.Relation)
-	defer span.End()
+// tuplesetRelationships holds the relationships found for the tupleset of an arrow, along with
+// the dispatches necessary to check the computed userset on their subjects.
+type tuplesetRelationships struct {
+	relationshipsBySubjectONR *mapz.MultiMap[string, *core.RelationTuple]
+	relationshipsByResourceID *mapz.MultiMap[string, *core.RelationTuple]
+	toDispatch                []directDispatch
+}
 
-	log.Ctx(ctx).Trace().Object("ttu", crc.parentReq).Send()
+func (cc *ConcurrentChecker) loadTuplesetRelationships(ctx context.Context, crc currentRequestContext, ttu *core.TupleToUserset) (tuplesetRelationships, error) {
 	ds := datastoremw.MustFromContext(ctx).SnapshotReader(crc.parentReq.Revision)
 	it, err := ds.QueryRelationships(ctx, datastore.RelationshipsFilter{
 		OptionalResourceType:     crc.parentReq.ResourceRelation.Namespace,
@@ -587,19 +679,21 @@ func (cc *ConcurrentChecker) checkTupleToUserset(ctx context.Context, crc curren
 		OptionalResourceRelation: ttu.Tupleset.Relation,
 	})
 	if err != nil {
-		return checkResultError(NewCheckFailureErr(err), emptyMetadata)
+		return tuplesetRelationships{}, err
 	}
 	defer it.Close()
 
 	subjectsToDispatch := tuple.NewONRByTypeSet()
 	relationshipsBySubjectONR := mapz.NewMultiMap[string, *core.RelationTuple]()
+	relationshipsByResourceID := mapz.NewMultiMap[string, *core.RelationTuple]()
 	for tpl := it.Next(); tpl != nil; tpl = it.Next() {
 		if it.Err() != nil {
-			return checkResultError(NewCheckFailureErr(it.Err()), emptyMetadata)
+			return tuplesetRelationships{}, it.Err()
 		}
 
 		subjectsToDispatch.Add(tpl.Subject)
 		relationshipsBySubjectONR.Add(tuple.StringONR(tpl.Subject), tpl)
+		relationshipsByResourceID.Add(tpl.ResourceAndRelation.ObjectId, tpl)
 	}
 	it.Close()
 
@@ -620,22 +714,174 @@ func (cc *ConcurrentChecker) checkTupleToUserset(ctx context.Context, crc curren
 		dispatchChunkCountHistogram.Observe(chunkCount)
 	})
 
+	return tuplesetRelationships{
+		relationshipsBySubjectONR: relationshipsBySubjectONR,
+		relationshipsByResourceID: relationshipsByResourceID,
+		toDispatch:                toDispatch,
+	}, nil
+}
+
+func (cc *ConcurrentChecker) checkTupleToUserset(ctx context.Context, crc currentRequestContext, ttu *core.TupleToUserset) CheckResult {
+	if ttu.Function == core.TupleToUserset_FUNCTION_ALL {
+		return cc.checkIntersectionTupleToUserset(ctx, crc, ttu)
+	}
+
+	ctx, span := tracer.Start(ctx, ttu.Tupleset.Relation+"->"+ttu.ComputedUserset.Relation)
+	defer span.End()
+
+	log.Ctx(ctx).Trace().Object("ttu", crc.parentReq).Send()
+	loaded, err := cc.loadTuplesetRelationships(ctx, crc, ttu)
+	if err != nil {
+		return checkResultError(NewCheckFailureErr(err), emptyMetadata)
+	}
+
 	return union(
 		ctx,
 		crc,
-		toDispatch,
+		loaded.toDispatch,
 		func(ctx context.Context, crc currentRequestContext, dd directDispatch) CheckResult {
 			childResult := cc.checkComputedUserset(ctx, crc, ttu.ComputedUserset, dd.resourceType, dd.resourceIds)
 			if childResult.Err != nil {
 				return childResult
 			}
 
-			return mapFoundResources(childResult, dd.resourceType, relationshipsBySubjectONR)
+			return mapFoundResources(crc, childResult, dd.resourceType, loaded.relationshipsBySubjectONR)
 		},
-		cc.concurrencyLimit,
+		cc.concurrencyLimiter.ConcurrencyLimit(),
 	)
 }
 
+// checkIntersectionTupleToUserset checks an arrow with the `all` function, for which a resource
+// is a member only if the computed userset is satisfied on *every* subject found via its tupleset.
+// Resources without any tupleset relationships are never members.
+func (cc *ConcurrentChecker) checkIntersectionTupleToUserset(ctx context.Context, crc currentRequestContext, ttu *core.TupleToUserset) CheckResult {
+	ctx, span := tracer.Start(ctx, ttu.Tupleset.Relation+".all("+ttu.ComputedUserset.Relation+")")
+	defer span.End()
+
+	log.Ctx(ctx).Trace().Object("intersectionttu", crc.parentReq).Send()
+	loaded, err := cc.loadTuplesetRelationships(ctx, crc, ttu)
+	if err != nil {
+		return checkResultError(NewCheckFailureErr(err), emptyMetadata)
+	}
+
+	if len(loaded.toDispatch) == 0 {
+		return noMembers()
+	}
+
+	// Membership must be determined for every subject, so all results are required.
+	allResultsCRC := crc.withAllResultsRequired()
+
+	resultChan := make(chan CheckResult, len(loaded.toDispatch))
+	childCtx, cancelFn := context.WithCancel(ctx)
+	dispatchAllAsync(childCtx, allResultsCRC, loaded.toDispatch, func(ctx context.Context, crc currentRequestContext, dd directDispatch) CheckResult {
+		childResult := cc.checkComputedUserset(ctx, crc, ttu.ComputedUserset, dd.resourceType, dd.resourceIds)
+		if childResult.Err != nil {
+			return childResult
+		}
+
+		return mapFoundSubjects(crc, childResult, dd.resourceType)
+	}, resultChan, cc.concurrencyLimiter.ConcurrencyLimit())
+	defer cancelFn()
+
+	responseMetadata := emptyMetadata
+	foundSubjects := make(CheckResultsMap)
+	for i := 0; i < len(loaded.toDispatch); i++ {
+		select {
+		case result := <-resultChan:
+			log.Ctx(ctx).Trace().Object("intersectionTTUResult", result.Resp).Send()
+			responseMetadata = combineResponseMetadata(responseMetadata, result.Resp.Metadata)
+			if result.Err != nil {
+				return checkResultError(result.Err, responseMetadata)
+			}
+
+			for subjectKey, subjectResult := range result.Resp.ResultsByResourceId {
+				foundSubjects[subjectKey] = subjectResult
+			}
+
+		case <-ctx.Done():
+			log.Ctx(ctx).Trace().Msg("intersectionTTUCanceled")
+			return checkResultError(context.Canceled, responseMetadata)
+		}
+	}
+
+	membershipSet := NewMembershipSet()
+	for _, resourceID := range loaded.relationshipsByResourceID.Keys() {
+		relationships, _ := loaded.relationshipsByResourceID.Get(resourceID)
+
+		for _, checkedSubjectKey := range crc.subjectKeys() {
+			// A caveated tupleset relationship only applies when its caveat holds, so the computed
+			// userset need only be satisfied on its subject under that caveat. At least one of the
+			// tupleset relationships must apply for the resource to be a member.
+			var resourceExpression *core.CaveatExpression
+			var appliesExpression *core.CaveatExpression
+			hasUncaveated := false
+			hasApplicable := false
+			isMember := true
+			for _, relationship := range relationships {
+				subjectResult, ok := foundSubjects[crc.resultKey(tuple.StringONR(relationship.Subject), checkedSubjectKey)]
+				if relationship.Caveat == nil {
+					if !ok {
+						isMember = false
+						break
+					}
+
+					hasUncaveated = true
+					hasApplicable = true
+					resourceExpression = caveatAnd(resourceExpression, subjectResult.Expression)
+					continue
+				}
+
+				relationshipCaveat := wrapCaveat(relationship.Caveat)
+				if !ok {
+					resourceExpression = caveatAnd(resourceExpression, caveatInvert(relationshipCaveat))
+					continue
+				}
+
+				hasApplicable = true
+				appliesExpression = caveatOr(appliesExpression, relationshipCaveat)
+				if subjectResult.Expression != nil {
+					resourceExpression = caveatAnd(resourceExpression, caveatOr(caveatInvert(relationshipCaveat), subjectResult.Expression))
+				}
+			}
+
+			if !isMember || !hasApplicable {
+				continue
+			}
+
+			if !hasUncaveated {
+				resourceExpression = caveatAnd(resourceExpression, appliesExpression)
+			}
+
+			membershipSet.addMember(crc.resultKey(resourceID, checkedSubjectKey), resourceExpression)
+		}
+	}
+
+	return checkResultsForMembership(membershipSet, responseMetadata)
+}
+
+// mapFoundSubjects rekeys the resources found by a check of the computed userset of an arrow
+// by their subject ONR string, for matching against the tupleset relationships.
+func mapFoundSubjects(crc currentRequestContext, result CheckResult, resourceType *core.RelationReference) CheckResult {
+	resultsBySubject := make(CheckResultsMap, len(result.Resp.ResultsByResourceId))
+	for foundKey, foundResult := range result.Resp.ResultsByResourceId {
+		foundResourceID, checkedSubjectKey := crc.splitResultKey(foundKey)
+		subjectKey := tuple.StringONR(&core.ObjectAndRelation{
+			Namespace: resourceType.Namespace,
+			ObjectId:  foundResourceID,
+			Relation:  resourceType.Relation,
+		})
+		resultsBySubject[crc.resultKey(subjectKey, checkedSubjectKey)] = foundResult
+	}
+
+	return CheckResult{
+		&v1.DispatchCheckResponse{
+			ResultsByResourceId: resultsBySubject,
+			Metadata:            result.Resp.Metadata,
+		},
+		nil,
+	}
+}
+
 func withDistinctMetadata(result CheckResult) CheckResult {
 	// NOTE: This is necessary to ensure unique debug information on the request and that debug
 	// information from the child metadata is *not* copied over.
@@ -694,12 +940,15 @@ func union[T any](
 	return checkResultsForMembership(membershipSet, responseMetadata)
 }
 
-// all returns whether all of the lazy checks pass, and is used for intersection.
+// all returns whether all of the lazy checks pass, and is used for intersection. The checks are
+// dispatched in the order of the plan and, if the plan evaluates the cheapest check first, the others
+// are skipped if it finds no members, or narrowed to the resources it found.
 func all[T any](
 	ctx context.Context,
 	crc currentRequestContext,
 	children []T,
 	handler func(ctx context.Context, crc currentRequestContext, child T) CheckResult,
+	plan branchPlan,
 	concurrencyLimit uint16,
 ) CheckResult {
 	if len(children) == 0 {
@@ -711,19 +960,36 @@ func all[T any](
 	}
 
 	responseMetadata := emptyMetadata
+	allResultsCRC := crc.withAllResultsRequired()
+	ordered := inPlannedOrder(plan, children)
 
-	resultChan := make(chan CheckResult, len(children))
+	var membershipSet *MembershipSet
+	if plan.cheapestFirst {
+		first := handler(ctx, allResultsCRC, ordered[0])
+		responseMetadata = combineResponseMetadata(responseMetadata, first.Resp.Metadata)
+		if first.Err != nil {
+			return checkResultError(first.Err, responseMetadata)
+		}
+
+		membershipSet = NewMembershipSet()
+		membershipSet.UnionWith(first.Resp.ResultsByResourceId)
+		if membershipSet.IsEmpty() {
+			plan.recordShortCircuit(1)
+			return noMembersWithMetadata(responseMetadata)
+		}
+
+		// Only the resources found by the first check can be members, so the others need not check
+		// any other resources.
+		allResultsCRC = allResultsCRC.withFilteredResourceIDs(allResultsCRC.resourceIDsFoundIn(first.Resp.ResultsByResourceId))
+		ordered = ordered[1:]
+	}
+
+	resultChan := make(chan CheckResult, len(ordered))
 	childCtx, cancelFn := context.WithCancel(ctx)
-	dispatchAllAsync(childCtx, currentRequestContext{
-		parentReq:           crc.parentReq,
-		filteredResourceIDs: crc.filteredResourceIDs,
-		resultsSetting:      v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS,
-		maxDispatchCount:    crc.maxDispatchCount,
-	}, children, handler, resultChan, concurrencyLimit)
+	dispatchAllAsync(childCtx, allResultsCRC, ordered, handler, resultChan, concurrencyLimit)
 	defer cancelFn()
 
-	var membershipSet *MembershipSet
-	for i := 0; i < len(children); i++ {
+	for i := 0; i < len(ordered); i++ {
 		select {
 		case result := <-resultChan:
 			responseMetadata = combineResponseMetadata(responseMetadata, result.Resp.Metadata)
@@ -739,6 +1005,9 @@ func all[T any](
 			}
 
 			if membershipSet.IsEmpty() {
+				if i < len(ordered)-1 {
+					plan.recordShortCircuit(len(children))
+				}
 				return noMembersWithMetadata(responseMetadata)
 			}
 		case <-ctx.Done():
@@ -750,11 +1019,16 @@ func all[T any](
 }
 
 // difference returns whether the first lazy check passes and none of the supsequent checks pass.
+// The subsequent checks are dispatched in the order of the plan. If the plan evaluates the cheapest
+// check first and it is the first check, the others are skipped if it finds no members, or narrowed
+// to the resources it found. If the cheapest check is a subsequent one, the resources it finds for
+// every subject are not checked any further.
 func difference[T any](
 	ctx context.Context,
 	crc currentRequestContext,
 	children []T,
 	handler func(ctx context.Context, crc currentRequestContext, child T) CheckResult,
+	plan branchPlan,
 	concurrencyLimit uint16,
 ) CheckResult {
 	if len(children) == 0 {
@@ -765,46 +1039,102 @@ func difference[T any](
 		return checkResultError(fmt.Errorf("difference requires more than a single child"), emptyMetadata)
 	}
 
-	childCtx, cancelFn := context.WithCancel(ctx)
-	baseChan := make(chan CheckResult, 1)
-	othersChan := make(chan CheckResult, len(children)-1)
+	responseMetadata := emptyMetadata
+	membershipSet := NewMembershipSet()
 
-	go func() {
-		result := handler(childCtx, crc, children[0])
-		baseChan <- result
-	}()
+	// Order the subsequent checks as planned.
+	order := plan.order
+	if order == nil {
+		order = lo.Range(len(children))
+	}
 
-	dispatchAllAsync(childCtx, currentRequestContext{
-		parentReq:           crc.parentReq,
-		filteredResourceIDs: crc.filteredResourceIDs,
-		resultsSetting:      v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS,
-		maxDispatchCount:    crc.maxDispatchCount,
-	}, children[1:], handler, othersChan, concurrencyLimit-1)
-	defer cancelFn()
+	others := make([]T, 0, len(children)-1)
+	for _, index := range order {
+		if index != 0 {
+			others = append(others, children[index])
+		}
+	}
 
-	responseMetadata := emptyMetadata
-	membershipSet := NewMembershipSet()
+	baseFirst := plan.cheapestFirst && order[0] == 0
+	var excluded CheckResultsMap
+	if plan.cheapestFirst && !baseFirst {
+		first := handler(ctx, crc.withAllResultsRequired(), others[0])
+		responseMetadata = combineResponseMetadata(responseMetadata, first.Resp.Metadata)
+		if first.Err != nil {
+			return checkResultError(first.Err, responseMetadata)
+		}
 
-	// Wait for the base set to return.
-	select {
-	case base := <-baseChan:
-		responseMetadata = combineResponseMetadata(responseMetadata, base.Resp.Metadata)
+		// Resources found to be members of the first check for every subject cannot be members,
+		// so they need not be checked any further.
+		remainingResourceIDs := crc.resourceIDsNotDeterminedIn(first.Resp.ResultsByResourceId)
+		if len(remainingResourceIDs) == 0 {
+			plan.recordShortCircuit(1)
+			return noMembersWithMetadata(responseMetadata)
+		}
+
+		crc = crc.withFilteredResourceIDs(remainingResourceIDs)
+		excluded = first.Resp.ResultsByResourceId
+		others = others[1:]
+	}
+
+	childCtx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
 
+	othersChan := make(chan CheckResult, len(others))
+	if baseFirst {
+		base := handler(ctx, crc, children[0])
+		responseMetadata = combineResponseMetadata(responseMetadata, base.Resp.Metadata)
 		if base.Err != nil {
 			return checkResultError(base.Err, responseMetadata)
 		}
 
 		membershipSet.UnionWith(base.Resp.ResultsByResourceId)
 		if membershipSet.IsEmpty() {
+			plan.recordShortCircuit(1)
 			return noMembersWithMetadata(responseMetadata)
 		}
 
-	case <-ctx.Done():
-		return checkResultError(context.Canceled, responseMetadata)
+		// Only the resources found by the base can be members, so the subsequent checks need not
+		// check any other resources.
+		othersCRC := crc.withAllResultsRequired().withFilteredResourceIDs(crc.resourceIDsFoundIn(base.Resp.ResultsByResourceId))
+		dispatchAllAsync(childCtx, othersCRC, others, handler, othersChan, concurrencyLimit)
+	} else {
+		baseChan := make(chan CheckResult, 1)
+		go func() {
+			result := handler(childCtx, crc, children[0])
+			baseChan <- result
+		}()
+
+		dispatchAllAsync(childCtx, crc.withAllResultsRequired(), others, handler, othersChan, concurrencyLimit-1)
+
+		// Wait for the base set to return.
+		select {
+		case base := <-baseChan:
+			responseMetadata = combineResponseMetadata(responseMetadata, base.Resp.Metadata)
+
+			if base.Err != nil {
+				return checkResultError(base.Err, responseMetadata)
+			}
+
+			membershipSet.UnionWith(base.Resp.ResultsByResourceId)
+			if membershipSet.IsEmpty() {
+				return noMembersWithMetadata(responseMetadata)
+			}
+
+		case <-ctx.Done():
+			return checkResultError(context.Canceled, responseMetadata)
+		}
+	}
+
+	if excluded != nil {
+		membershipSet.Subtract(excluded)
+		if membershipSet.IsEmpty() {
+			return noMembersWithMetadata(responseMetadata)
+		}
 	}
 
 	// Subtract the remaining sets.
-	for i := 1; i < len(children); i++ {
+	for i := 0; i < len(others); i++ {
 		select {
 		case sub := <-othersChan:
 			responseMetadata = combineResponseMetadata(responseMetadata, sub.Resp.Metadata)
@@ -815,6 +1145,9 @@ func difference[T any](
 
 			membershipSet.Subtract(sub.Resp.ResultsByResourceId)
 			if membershipSet.IsEmpty() {
+				if i < len(others)-1 {
+					plan.recordShortCircuit(len(children))
+				}
 				return noMembersWithMetadata(responseMetadata)
 			}
 
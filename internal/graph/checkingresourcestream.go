@@ -9,12 +9,16 @@ import (
 	"go.uber.org/atomic"
 	"golang.org/x/exp/maps"
 
+	"github.com/authzed/spicedb/internal/datasets"
 	"github.com/authzed/spicedb/internal/dispatch"
 	"github.com/authzed/spicedb/internal/graph/computed"
 	"github.com/authzed/spicedb/pkg/datastore"
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
+	"github.com/authzed/spicedb/pkg/genutil/slicez"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/spiceerrors"
+	"github.com/authzed/spicedb/pkg/tuple"
 )
 
 // possibleResource is a resource that was returned by reachable resources and, after processing,
@@ -163,6 +167,10 @@ type checkingResourceStream struct {
 	checker      dispatch.Check
 	parentStream dispatch.Stream[*v1.DispatchLookupResourcesResponse]
 
+	// subjectsLookup looks up the exclusions of the wildcard, for the resources it is checked to be
+	// granted, if requested by the wildcard setting.
+	subjectsLookup dispatch.LookupSubjects
+
 	// sem is a chan of length `concurrencyLimit` used to ensure the task runner does
 	// not exceed the concurrencyLimit with spawned goroutines.
 	sem chan struct{}
@@ -219,6 +227,7 @@ func newCheckingResourceStream(
 	cancelReachable func(),
 	req ValidatedLookupResourcesRequest,
 	checker dispatch.Check,
+	subjectsLookup dispatch.LookupSubjects,
 	parentStream dispatch.Stream[*v1.DispatchLookupResourcesResponse],
 	limits *limitTracker,
 	concurrencyLimit uint16,
@@ -243,10 +252,11 @@ func newCheckingResourceStream(
 		cancelReachable:  cancelReachable,
 		concurrencyLimit: concurrencyLimit,
 
-		req:          req,
-		checker:      checker,
-		parentStream: parentStream,
-		limits:       limits,
+		req:            req,
+		checker:        checker,
+		subjectsLookup: subjectsLookup,
+		parentStream:   parentStream,
+		limits:         limits,
 
 		sem: make(chan struct{}, processingConcurrencyLimit),
 
@@ -455,12 +465,13 @@ func (crs *checkingResourceStream) runProcess(alwaysProcess bool) (bool, error)
 		crs.ctx,
 		crs.checker,
 		computed.CheckParameters{
-			ResourceType:  crs.req.ObjectRelation,
-			Subject:       crs.req.Subject,
-			CaveatContext: crs.req.Context.AsMap(),
-			AtRevision:    crs.req.Revision,
-			MaximumDepth:  crs.req.Metadata.DepthRemaining,
-			DebugOption:   computed.NoDebugging,
+			ResourceType:         crs.req.ObjectRelation,
+			Subject:              crs.req.Subject,
+			CaveatContext:        crs.req.Context.AsMap(),
+			AtRevision:           crs.req.Revision,
+			MaximumDepth:         crs.req.Metadata.DepthRemaining,
+			DebugOption:          computed.NoDebugging,
+			AllowWildcardSubject: crs.req.WildcardSetting == v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS,
 		},
 		toCheck.Keys(),
 	)
@@ -471,9 +482,20 @@ func (crs *checkingResourceStream) runProcess(alwaysProcess bool) (bool, error)
 	crs.dispatchesToBeReported.Add(checkResultMetadata.DispatchCount)
 	crs.cachedDispatchesToBeReported.Add(checkResultMetadata.CachedDispatchCount)
 
+	grantedByWildcard, wildcardDepthRequired, err := crs.checkGrantedByWildcard(toCheck.Values(), results)
+	if err != nil {
+		return true, err
+	}
+
 	for _, rai := range toCheck.Values() {
 		checkResult := results[rai.reachableResult.Resource.ResourceId]
 
+		// When looking up only wildcards, the resources of a wildcard with exclusions are not
+		// granted to every subject, so they are not returned.
+		if crs.req.WildcardSetting == v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS && !grantedByWildcard.Has(rai.reachableResult.Resource.ResourceId) {
+			checkResult = nil
+		}
+
 		var permissionship v1.ResolvedResource_Permissionship
 		var missingFields []string
 
@@ -499,13 +521,14 @@ func (crs *checkingResourceStream) runProcess(alwaysProcess bool) (bool, error)
 		if permissionship != v1.ResolvedResource_UNKNOWN {
 			metadata := rai.reachableResult.Metadata
 			metadata = crs.addSkippedDispatchCountToBePublished(metadata)
-			metadata.DepthRequired = max(metadata.DepthRequired, checkResultMetadata.DepthRequired)
+			metadata.DepthRequired = max(metadata.DepthRequired, checkResultMetadata.DepthRequired, wildcardDepthRequired)
 
 			lookupResult = &v1.DispatchLookupResourcesResponse{
 				ResolvedResource: &v1.ResolvedResource{
 					ResourceId:             rai.reachableResult.Resource.ResourceId,
 					Permissionship:         permissionship,
 					MissingRequiredContext: missingFields,
+					GrantedByWildcard:      grantedByWildcard.Has(rai.reachableResult.Resource.ResourceId),
 				},
 				Metadata:            metadata,
 				AfterResponseCursor: rai.reachableResult.AfterResponseCursor,
@@ -537,6 +560,148 @@ func (crs *checkingResourceStream) runProcess(alwaysProcess bool) (bool, error)
 	}
 }
 
+// checkGrantedByWildcard returns the IDs of those checked resources which are granted via a
+// wildcard, if requested by the wildcard setting, along with the depth required to determine them.
+// A resource is only granted via a wildcard if no subject is excluded from the wildcard, whether
+// concretely or under a caveat, as it is otherwise not granted to every subject of its type.
+func (crs *checkingResourceStream) checkGrantedByWildcard(checked []possibleResource, results map[string]*v1.ResourceCheckResult) (*mapz.Set[string], uint32, error) {
+	if crs.req.WildcardSetting == v1.DispatchLookupResourcesRequest_NO_WILDCARD_ANNOTATIONS {
+		return mapz.NewSet[string](), 0, nil
+	}
+
+	// Find the resources found for the subject. When looking up only wildcards, the subject was
+	// the wildcard itself, so it is already known to be granted these resources.
+	granted := mapz.NewSet[string]()
+	for _, rai := range checked {
+		resourceID := rai.reachableResult.Resource.ResourceId
+		checkResult := results[resourceID]
+		if checkResult != nil && checkResult.Membership != v1.ResourceCheckResult_NOT_MEMBER {
+			granted.Insert(resourceID)
+		}
+	}
+
+	if granted.IsEmpty() {
+		return granted, 0, nil
+	}
+
+	var depthRequired uint32
+	if crs.req.WildcardSetting == v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS {
+		// Reaching a resource, via a wildcard or otherwise, does not mean that the wildcard is
+		// granted the permission, as the other branches of an intersection or exclusion may only
+		// apply to the subject, so check the wildcard itself.
+		wildcardResults, checkResultMetadata, err := computed.ComputeBulkCheck(
+			crs.ctx,
+			crs.checker,
+			computed.CheckParameters{
+				ResourceType: crs.req.ObjectRelation,
+				Subject: &core.ObjectAndRelation{
+					Namespace: crs.req.Subject.Namespace,
+					ObjectId:  tuple.PublicWildcard,
+					Relation:  crs.req.Subject.Relation,
+				},
+				CaveatContext:        crs.req.Context.AsMap(),
+				AtRevision:           crs.req.Revision,
+				MaximumDepth:         crs.req.Metadata.DepthRemaining,
+				DebugOption:          computed.NoDebugging,
+				AllowWildcardSubject: true,
+			},
+			granted.AsSlice(),
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		crs.dispatchesToBeReported.Add(checkResultMetadata.DispatchCount)
+		crs.cachedDispatchesToBeReported.Add(checkResultMetadata.CachedDispatchCount)
+		depthRequired = checkResultMetadata.DepthRequired
+
+		granted = mapz.NewSet[string]()
+		for resourceID, checkResult := range wildcardResults {
+			if checkResult.Membership != v1.ResourceCheckResult_NOT_MEMBER {
+				granted.Insert(resourceID)
+			}
+		}
+
+		if granted.IsEmpty() {
+			return granted, depthRequired, nil
+		}
+	}
+
+	// Checking the wildcard does not account for the subjects excluded from it, so look up its
+	// exclusions.
+	grantedByWildcard, lookupDepthRequired, err := crs.lookupWildcardsWithoutExclusions(granted.AsSlice())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return grantedByWildcard, max(depthRequired, lookupDepthRequired), nil
+}
+
+// lookupWildcardsWithoutExclusions returns the IDs of those given resources whose subjects include
+// the wildcard with no subject excluded from it, along with the depth required to determine them.
+func (crs *checkingResourceStream) lookupWildcardsWithoutExclusions(resourceIDs []string) (*mapz.Set[string], uint32, error) {
+	foundSubjects := datasets.NewSubjectSetByResourceID()
+	var depthRequired uint32
+
+	// NOTE: the subjects looked up are not restricted to the wildcard, as the subjects excluded
+	// from it would then be dropped as well.
+	_, err := slicez.ForEachChunkUntil(resourceIDs, datastore.FilterMaximumIDCount, func(resourceIDsToLookup []string) (bool, error) {
+		stream := dispatch.NewCollectingDispatchStream[*v1.DispatchLookupSubjectsResponse](crs.ctx)
+		err := crs.subjectsLookup.DispatchLookupSubjects(&v1.DispatchLookupSubjectsRequest{
+			ResourceRelation: crs.req.ObjectRelation,
+			ResourceIds:      resourceIDsToLookup,
+			SubjectRelation: &core.RelationReference{
+				Namespace: crs.req.Subject.Namespace,
+				Relation:  crs.req.Subject.Relation,
+			},
+			Metadata: &v1.ResolverMeta{
+				AtRevision:     crs.req.Revision.String(),
+				DepthRemaining: crs.req.Metadata.DepthRemaining,
+			},
+		}, stream)
+		if err != nil {
+			return false, err
+		}
+
+		for _, result := range stream.Results() {
+			crs.dispatchesToBeReported.Add(result.Metadata.DispatchCount)
+			crs.cachedDispatchesToBeReported.Add(result.Metadata.CachedDispatchCount)
+			depthRequired = max(depthRequired, result.Metadata.DepthRequired)
+
+			if err := foundSubjects.UnionWith(result.FoundSubjectsByResourceId); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	withoutExclusions := mapz.NewSet[string]()
+	for resourceID, subjects := range foundSubjects.AsMap() {
+		for _, subject := range subjects.FoundSubjects {
+			if subject.SubjectId == tuple.PublicWildcard && len(subject.ExcludedSubjects) == 0 {
+				withoutExclusions.Insert(resourceID)
+			}
+		}
+	}
+
+	return withoutExclusions, depthRequired, nil
+}
+
+// isGrantedByWildcard returns whether a resource found to have permission without a check is
+// granted via a wildcard, if requested by the wildcard setting.
+func (crs *checkingResourceStream) isGrantedByWildcard(resource *v1.ReachableResource) bool {
+	if crs.req.WildcardSetting == v1.DispatchLookupResourcesRequest_NO_WILDCARD_ANNOTATIONS {
+		return false
+	}
+
+	// NOTE: resources which have permission without a check were reached solely via unions, so a
+	// non-caveated wildcard relationship grants the permission to every subject of its type.
+	return slices.Contains(resource.ForSubjectIds, tuple.PublicWildcard)
+}
+
 // addSkippedDispatchCountToBePublished adds any dispatch counts that were skipped due to a resource being filtered,
 // to the metadata to be published.
 func (crs *checkingResourceStream) addSkippedDispatchCountToBePublished(metadata *v1.ResponseMeta) *v1.ResponseMeta {
@@ -573,6 +738,15 @@ func (crs *checkingResourceStream) spawnIfAvailable() {
 
 // Publish implements the Stream interface and is invoked by the ReachableResources call.
 func (crs *checkingResourceStream) Publish(result *v1.DispatchReachableResourcesResponse) error {
+	// When annotating wildcards, a resource found to have permission other than via a wildcard may
+	// also be found via a wildcard, so it is checked to be annotated the same way either way.
+	if crs.req.WildcardSetting == v1.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS &&
+		result.Resource.ResultStatus == v1.ReachableResource_HAS_PERMISSION &&
+		!crs.isGrantedByWildcard(result.Resource) {
+		result = result.CloneVT()
+		result.Resource.ResultStatus = v1.ReachableResource_REQUIRES_CHECK
+	}
+
 	currentResource := possibleResource{
 		reachableResult: result,
 		lookupResult:    nil,
@@ -585,8 +759,9 @@ func (crs *checkingResourceStream) Publish(result *v1.DispatchReachableResources
 		metadata := crs.addSkippedDispatchCountToBePublished(result.Metadata)
 		currentResource.lookupResult = &v1.DispatchLookupResourcesResponse{
 			ResolvedResource: &v1.ResolvedResource{
-				ResourceId:     result.Resource.ResourceId,
-				Permissionship: v1.ResolvedResource_HAS_PERMISSION,
+				ResourceId:        result.Resource.ResourceId,
+				Permissionship:    v1.ResolvedResource_HAS_PERMISSION,
+				GrantedByWildcard: crs.isGrantedByWildcard(result.Resource),
 			},
 			Metadata:            metadata,
 			AfterResponseCursor: result.AfterResponseCursor,
@@ -0,0 +1,520 @@
+package graph
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	log "github.com/authzed/spicedb/internal/logging"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+)
+
+const (
+	// cheapestFirstCostRatio is how many times cheaper than every other branch the cheapest branch
+	// of an intersection or exclusion must be estimated to be for it to be evaluated on its own,
+	// before the others are dispatched. Evaluating it first trades latency for the chance to skip
+	// the other branches entirely.
+	cheapestFirstCostRatio = 4
+
+	// observedCostWeight is the weight given to each newly observed cost of a branch in its moving
+	// average.
+	observedCostWeight = 0.2
+
+	// maxObservedCosts is the maximum number of branches whose observed costs are kept. The costs of
+	// the branches least recently planned or observed are dropped first.
+	maxObservedCosts = 10_000
+
+	// statsRefreshInterval is how often the datastore statistics used for planning are reloaded.
+	statsRefreshInterval = time.Minute
+
+	// statsRefreshTimeout is the maximum time spent reloading the datastore statistics.
+	statsRefreshTimeout = 10 * time.Second
+
+	// defaultFanout is the estimated fanout of a relation when no datastore statistics are available.
+	defaultFanout = 2
+
+	// maxPlanningDepth is the maximum depth of permissions whose branches are walked to estimate
+	// their cost.
+	maxPlanningDepth = 3
+)
+
+// checkPlanner orders the branches of intersections and exclusions by their estimated cost, so that
+// cheap and selective branches are evaluated first and expensive ones can be skipped or narrowed.
+//
+// The cost of a branch is its number of dispatches, as observed when it was last evaluated or, if
+// it was never evaluated, as estimated from the schema and the datastore statistics.
+type checkPlanner struct {
+	observedCosts *observedCostCache
+
+	statsLock       sync.Mutex
+	statsLoadedAt   time.Time
+	statsRefreshing bool
+	fanout          float64
+}
+
+func newCheckPlanner() *checkPlanner {
+	return &checkPlanner{
+		observedCosts: newObservedCostCache(maxObservedCosts),
+		fanout:        defaultFanout,
+	}
+}
+
+// observedCostCache holds the observed costs of the branches most recently planned or observed,
+// up to a maximum number of branches.
+type observedCostCache struct {
+	lock     sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+
+	// recency holds the observed costs, most recently used first.
+	recency *list.List
+}
+
+type observedCost struct {
+	key  string
+	cost float64
+}
+
+func newObservedCostCache(capacity int) *observedCostCache {
+	return &observedCostCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element, capacity),
+		recency:  list.New(),
+	}
+}
+
+// get returns the observed cost of the branch with the given key, if any.
+func (occ *observedCostCache) get(key string) (float64, bool) {
+	occ.lock.Lock()
+	defer occ.lock.Unlock()
+
+	element, ok := occ.entries[key]
+	if !ok {
+		return 0, false
+	}
+
+	occ.recency.MoveToFront(element)
+	return element.Value.(*observedCost).cost, true
+}
+
+// observe adds the cost to the moving average of the observed costs of the branch with the given
+// key, dropping the cost of the branch least recently used if there are too many.
+func (occ *observedCostCache) observe(key string, cost float64) {
+	occ.lock.Lock()
+	defer occ.lock.Unlock()
+
+	if element, ok := occ.entries[key]; ok {
+		existing := element.Value.(*observedCost)
+		existing.cost += observedCostWeight * (cost - existing.cost)
+		occ.recency.MoveToFront(element)
+		return
+	}
+
+	occ.entries[key] = occ.recency.PushFront(&observedCost{key, cost})
+	if occ.recency.Len() > occ.capacity {
+		oldest := occ.recency.Remove(occ.recency.Back()).(*observedCost)
+		delete(occ.entries, oldest.key)
+	}
+}
+
+// branchPlan is the order in which the branches of an intersection or exclusion are evaluated.
+type branchPlan struct {
+	// order holds the indexes of the branches, cheapest first.
+	order []int
+
+	// cheapestFirst is whether the first branch is evaluated before the others are dispatched.
+	cheapestFirst bool
+
+	// decision is the decision recorded into the debug trace, if debugging.
+	decision *v1.CheckPlanDecision
+}
+
+// inPlannedOrder returns the children in the order of the plan.
+func inPlannedOrder[T any](plan branchPlan, children []T) []T {
+	if plan.order == nil {
+		return children
+	}
+
+	ordered := make([]T, 0, len(children))
+	for _, index := range plan.order {
+		ordered = append(ordered, children[index])
+	}
+	return ordered
+}
+
+// recordShortCircuit records into the debug trace that the result was determined after evaluating
+// the given number of branches, in planned order.
+func (bp branchPlan) recordShortCircuit(evaluatedCount int) {
+	if bp.decision == nil {
+		return
+	}
+
+	bp.decision.ShortCircuited = true
+	for _, branch := range bp.decision.Branches[min(evaluatedCount, len(bp.decision.Branches)):] {
+		branch.Skipped = true
+	}
+}
+
+// checkPlanDecisions collects the decisions made by the planner while checking a request with
+// debugging enabled.
+type checkPlanDecisions struct {
+	lock      sync.Mutex
+	decisions []*v1.CheckPlanDecision
+}
+
+func (cpd *checkPlanDecisions) add(decision *v1.CheckPlanDecision) {
+	cpd.lock.Lock()
+	defer cpd.lock.Unlock()
+	cpd.decisions = append(cpd.decisions, decision)
+}
+
+func (cpd *checkPlanDecisions) all() []*v1.CheckPlanDecision {
+	cpd.lock.Lock()
+	defer cpd.lock.Unlock()
+	return cpd.decisions
+}
+
+// plan returns the plan for evaluating the branches of an intersection or exclusion found in the
+// relation being checked.
+func (cp *checkPlanner) plan(ctx context.Context, crc currentRequestContext, operation v1.CheckPlanDecision_Operation, children []*core.SetOperation_Child) branchPlan {
+	if len(children) < 2 {
+		return branchPlan{}
+	}
+
+	namespaceName := observedNamespaceKey(ctx, crc)
+	fanout := cp.currentFanout(ctx)
+
+	costs := make([]float64, len(children))
+	observed := make([]bool, len(children))
+	order := make([]int, len(children))
+	for index, child := range children {
+		costs[index], observed[index] = cp.branchCost(namespaceName, crc.namespace, child, fanout, 0)
+		order[index] = index
+	}
+
+	// NOTE: the sort is stable, so branches of equal cost keep their schema order.
+	sort.SliceStable(order, func(first, second int) bool {
+		return costs[order[first]] < costs[order[second]]
+	})
+
+	plan := branchPlan{
+		order:         order,
+		cheapestFirst: costs[order[1]] > 0 && costs[order[0]]*cheapestFirstCostRatio <= costs[order[1]],
+	}
+
+	if crc.planDecisions != nil {
+		strategy := v1.CheckPlanDecision_CONCURRENT
+		if plan.cheapestFirst {
+			strategy = v1.CheckPlanDecision_CHEAPEST_FIRST
+		}
+
+		plan.decision = &v1.CheckPlanDecision{
+			Operation: operation,
+			Strategy:  strategy,
+			Branches:  make([]*v1.CheckPlannedBranch, 0, len(children)),
+		}
+		for _, index := range order {
+			plan.decision.Branches = append(plan.decision.Branches, &v1.CheckPlannedBranch{
+				Name:          branchName(children[index]),
+				SchemaIndex:   uint32(index),
+				EstimatedCost: costs[index],
+				ObservedCost:  observed[index],
+			})
+		}
+		crc.planDecisions.add(plan.decision)
+	}
+
+	return plan
+}
+
+// observe records the cost of a branch of an intersection or exclusion that was evaluated.
+func (cp *checkPlanner) observe(ctx context.Context, crc currentRequestContext, child *core.SetOperation_Child, result CheckResult) {
+	// NOTE: canceled branches are not observed, as their cost would be underestimated. Neither are
+	// branches narrowed to the resources found by a branch evaluated first, as their cost would
+	// be underestimated for the checks of their own.
+	if result.Err != nil || crc.narrowed {
+		return
+	}
+
+	key := branchCostKey(observedNamespaceKey(ctx, crc), child)
+	if key == "" {
+		return
+	}
+
+	// NOTE: cached dispatches are included, as the cached results may not be found next time.
+	cp.observedCosts.observe(key, float64(result.Resp.Metadata.DispatchCount+result.Resp.Metadata.CachedDispatchCount))
+}
+
+// currentFanout returns the estimated number of relationships followed from a resource when
+// walking a relation, derived from the datastore statistics. The statistics are reloaded in the
+// background once stale, so the check is never held up by loading them; until they are first
+// loaded, the default fanout is returned.
+func (cp *checkPlanner) currentFanout(ctx context.Context) float64 {
+	cp.statsLock.Lock()
+	defer cp.statsLock.Unlock()
+
+	if !cp.statsRefreshing && time.Since(cp.statsLoadedAt) >= statsRefreshInterval {
+		cp.statsRefreshing = true
+
+		// NOTE: the statistics are loaded under a context detached from the check, so that they
+		// are not lost if the check is canceled.
+		refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), statsRefreshTimeout)
+		go func() {
+			defer cancel()
+			cp.refreshFanout(refreshCtx)
+		}()
+	}
+
+	return cp.fanout
+}
+
+// refreshFanout reloads the datastore statistics and updates the estimated fanout from them.
+//
+// The fanout is shared by the checks of all tenants, so it is estimated from the statistics of
+// the whole datastore rather than those of the tenant of the check which triggered the refresh.
+func (cp *checkPlanner) refreshFanout(ctx context.Context) {
+	fanout := float64(defaultFanout)
+	stats, err := proxy.UnscopedDatastore(datastoremw.MustFromContext(ctx)).Statistics(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("could not load datastore statistics for planning check")
+	} else {
+		var relationCount uint64
+		for _, objectTypeStats := range stats.ObjectTypeStatistics {
+			relationCount += uint64(objectTypeStats.NumRelations)
+		}
+		fanout = fanoutFromStats(stats.EstimatedRelationshipCount, relationCount)
+	}
+
+	cp.statsLock.Lock()
+	defer cp.statsLock.Unlock()
+
+	cp.statsLoadedAt = time.Now()
+	cp.statsRefreshing = false
+	cp.fanout = fanout
+}
+
+// fanoutFromStats estimates the fanout of a relation from the average number of relationships per
+// relation. As relationships are rarely spread evenly, the average is scaled logarithmically.
+func fanoutFromStats(relationshipCount uint64, relationCount uint64) float64 {
+	if relationCount == 0 {
+		return defaultFanout
+	}
+
+	average := float64(relationshipCount) / float64(relationCount)
+	return 1 + math.Log2(1+average)
+}
+
+// branchCost returns the cost of the branch and whether it was observed.
+func (cp *checkPlanner) branchCost(namespaceName string, nsDef *core.NamespaceDefinition, child *core.SetOperation_Child, fanout float64, depth int) (float64, bool) {
+	if key := branchCostKey(namespaceName, child); key != "" {
+		if cost, ok := cp.observedCosts.get(key); ok {
+			return cost, true
+		}
+	}
+
+	switch child := child.ChildType.(type) {
+	case *core.SetOperation_Child_XNil:
+		return 0, false
+
+	case *core.SetOperation_Child_ComputedUserset:
+		return cp.relationCost(namespaceName, nsDef, child.ComputedUserset.Relation, fanout, depth), false
+
+	case *core.SetOperation_Child_TupleToUserset:
+		// Each relationship of the tupleset is followed with a dispatch of the computed userset.
+		tuplesetTypes := 1
+		if relation := findRelation(nsDef, child.TupleToUserset.Tupleset.Relation); relation != nil {
+			tuplesetTypes = max(1, len(relation.GetTypeInformation().GetAllowedDirectRelations()))
+		}
+		return 1 + fanout*float64(tuplesetTypes), false
+
+	case *core.SetOperation_Child_UsersetRewrite:
+		return cp.rewriteCost(namespaceName, nsDef, child.UsersetRewrite, fanout, depth), false
+
+	default:
+		return fanout, false
+	}
+}
+
+// relationCost estimates the cost of checking a relation or permission of the namespace.
+func (cp *checkPlanner) relationCost(namespaceName string, nsDef *core.NamespaceDefinition, relationName string, fanout float64, depth int) float64 {
+	relation := findRelation(nsDef, relationName)
+	if relation == nil {
+		return fanout
+	}
+
+	if relation.UsersetRewrite != nil {
+		if depth >= maxPlanningDepth {
+			return fanout * fanout
+		}
+		return cp.rewriteCost(namespaceName, nsDef, relation.UsersetRewrite, fanout, depth+1)
+	}
+
+	// A relation is loaded with a single query, with a dispatch for each subject type that is a
+	// userset, such as `group#member`.
+	cost := 1.0
+	for _, allowedRelation := range relation.GetTypeInformation().GetAllowedDirectRelations() {
+		if allowedRelation.GetPublicWildcard() == nil && allowedRelation.GetRelation() != Ellipsis {
+			cost += fanout
+		}
+	}
+	return cost
+}
+
+// rewriteCost estimates the cost of a rewrite as the sum of the costs of its branches.
+func (cp *checkPlanner) rewriteCost(namespaceName string, nsDef *core.NamespaceDefinition, rewrite *core.UsersetRewrite, fanout float64, depth int) float64 {
+	var cost float64
+	for _, child := range rewriteChildren(rewrite) {
+		childCost, _ := cp.branchCost(namespaceName, nsDef, child, fanout, depth)
+		cost += childCost
+	}
+	return cost
+}
+
+func rewriteChildren(rewrite *core.UsersetRewrite) []*core.SetOperation_Child {
+	switch rw := rewrite.RewriteOperation.(type) {
+	case *core.UsersetRewrite_Union:
+		return rw.Union.Child
+	case *core.UsersetRewrite_Intersection:
+		return rw.Intersection.Child
+	case *core.UsersetRewrite_Exclusion:
+		return rw.Exclusion.Child
+	default:
+		return nil
+	}
+}
+
+func findRelation(nsDef *core.NamespaceDefinition, relationName string) *core.Relation {
+	for _, relation := range nsDef.GetRelation() {
+		if relation.Name == relationName {
+			return relation
+		}
+	}
+	return nil
+}
+
+// observedNamespaceKey returns the key of the namespace being checked under which the costs of its
+// branches are observed. In multi-tenant mode, it is scoped to the tenant of the check, as tenants
+// may have namespaces of the same name. It includes a hash of the definition of the namespace, so
+// that the costs observed before the definition is changed are not used once it has been.
+func observedNamespaceKey(ctx context.Context, crc currentRequestContext) string {
+	namespaceName := crc.parentReq.ResourceRelation.Namespace
+	if tenant := tenantmw.FromContext(ctx); tenant != "" {
+		namespaceName = proxy.TenantScopedName(tenant, namespaceName)
+	}
+	return namespaceName + "@" + definitionHash(crc.namespace)
+}
+
+// definitionHash returns a hash of the namespace definition, or empty if there is none.
+func definitionHash(nsDef *core.NamespaceDefinition) string {
+	if nsDef == nil {
+		return ""
+	}
+
+	serialized, err := nsDef.MarshalVT()
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatUint(xxhash.Sum64(serialized), 16)
+}
+
+// branchCostKey returns the key under which the observed cost of the branch is recorded, or empty
+// if the cost of the branch is not observed.
+func branchCostKey(namespaceName string, child *core.SetOperation_Child) string {
+	switch child.ChildType.(type) {
+	case *core.SetOperation_Child_ComputedUserset, *core.SetOperation_Child_TupleToUserset:
+		return namespaceName + "#" + branchName(child)
+	default:
+		return ""
+	}
+}
+
+// branchName returns a description of the branch, in the form found in the schema.
+func branchName(child *core.SetOperation_Child) string {
+	switch child := child.ChildType.(type) {
+	case *core.SetOperation_Child_XNil:
+		return "nil"
+
+	case *core.SetOperation_Child_ComputedUserset:
+		return child.ComputedUserset.Relation
+
+	case *core.SetOperation_Child_TupleToUserset:
+		ttu := child.TupleToUserset
+		switch ttu.Function {
+		case core.TupleToUserset_FUNCTION_ANY:
+			return ttu.Tupleset.Relation + ".any(" + ttu.ComputedUserset.Relation + ")"
+		case core.TupleToUserset_FUNCTION_ALL:
+			return ttu.Tupleset.Relation + ".all(" + ttu.ComputedUserset.Relation + ")"
+		default:
+			return ttu.Tupleset.Relation + "->" + ttu.ComputedUserset.Relation
+		}
+
+	case *core.SetOperation_Child_UsersetRewrite:
+		separator := " + "
+		switch child.UsersetRewrite.RewriteOperation.(type) {
+		case *core.UsersetRewrite_Intersection:
+			separator = " & "
+		case *core.UsersetRewrite_Exclusion:
+			separator = " - "
+		}
+
+		children := rewriteChildren(child.UsersetRewrite)
+		names := make([]string, 0, len(children))
+		for _, grandchild := range children {
+			names = append(names, branchName(grandchild))
+		}
+		return "(" + strings.Join(names, separator) + ")"
+
+	default:
+		return "unknown"
+	}
+}
+
+// withFilteredResourceIDs returns the context with the given resource IDs left to be checked, as
+// narrowed by a branch evaluated first.
+func (crc currentRequestContext) withFilteredResourceIDs(resourceIDs []string) currentRequestContext {
+	crc.filteredResourceIDs = resourceIDs
+	crc.narrowed = true
+	return crc
+}
+
+// resourceIDsFoundIn returns the resource IDs left to be checked for which a result was found for
+// any subject.
+func (crc currentRequestContext) resourceIDsFoundIn(results CheckResultsMap) []string {
+	found := make([]string, 0, len(results))
+	for _, resourceID := range crc.filteredResourceIDs {
+		for _, subjectKey := range crc.subjectKeys() {
+			if _, ok := results[crc.resultKey(resourceID, subjectKey)]; ok {
+				found = append(found, resourceID)
+				break
+			}
+		}
+	}
+	return found
+}
+
+// resourceIDsNotDeterminedIn returns the resource IDs left to be checked which were not found to
+// be determined members in the results for every subject.
+func (crc currentRequestContext) resourceIDsNotDeterminedIn(results CheckResultsMap) []string {
+	remaining := make([]string, 0, len(crc.filteredResourceIDs))
+	for _, resourceID := range crc.filteredResourceIDs {
+		for _, subjectKey := range crc.subjectKeys() {
+			result, ok := results[crc.resultKey(resourceID, subjectKey)]
+			if !ok || result.Membership != v1.ResourceCheckResult_MEMBER {
+				remaining = append(remaining, resourceID)
+				break
+			}
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
+	"github.com/authzed/spicedb/pkg/datastore"
+	ns "github.com/authzed/spicedb/pkg/namespace"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+var plannedDocument = ns.Namespace("document",
+	ns.MustRelation("org", nil, ns.AllowedRelation("organization", "...")),
+	ns.MustRelation("parent", nil, ns.AllowedRelation("folder", "..."), ns.AllowedRelation("organization", "...")),
+	ns.MustRelation("viewer", nil, ns.AllowedRelation("user", "...")),
+	ns.MustRelation("group_viewer", nil, ns.AllowedRelation("user", "..."), ns.AllowedRelation("group", "member")),
+	ns.MustRelation("public_viewer", nil, ns.AllowedPublicNamespace("user")),
+	ns.MustRelation("view", ns.Union(ns.ComputedUserset("viewer"), ns.ComputedUserset("group_viewer"))),
+)
+
+func TestFanoutFromStats(t *testing.T) {
+	require.Equal(t, float64(defaultFanout), fanoutFromStats(1000, 0))
+	require.Equal(t, float64(1), fanoutFromStats(0, 10))
+	require.Equal(t, float64(4), fanoutFromStats(70, 10))
+	require.Less(t, fanoutFromStats(1_000, 10), fanoutFromStats(1_000_000, 10))
+}
+
+func TestCurrentFanoutRefreshedInBackground(t *testing.T) {
+	ds, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+	t.Cleanup(func() { ds.Close() })
+
+	_, err = ds.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		return rwt.WriteNamespaces(ctx, plannedDocument)
+	})
+	require.NoError(t, err)
+
+	// The check is canceled once it has its fanout, which must not cancel the refresh.
+	ctx, cancel := context.WithCancel(datastoremw.ContextWithDatastore(context.Background(), ds))
+	planner := newCheckPlanner()
+	require.Equal(t, float64(defaultFanout), planner.currentFanout(ctx))
+	cancel()
+
+	// Without any relationship, the fanout loaded from the statistics is the minimum.
+	require.Eventually(t, func() bool {
+		return planner.currentFanout(ctx) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestCurrentFanoutFromUnscopedStatistics(t *testing.T) {
+	ds, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+	t.Cleanup(func() { ds.Close() })
+
+	_, err = ds.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		if err := rwt.WriteNamespaces(ctx, plannedDocument); err != nil {
+			return err
+		}
+		return rwt.WriteRelationships(ctx, []*core.RelationTupleUpdate{
+			tuple.Create(tuple.MustParse("document:first#viewer@user:alice")),
+			tuple.Create(tuple.MustParse("document:first#viewer@user:bob")),
+			tuple.Create(tuple.MustParse("document:second#viewer@user:alice")),
+		})
+	})
+	require.NoError(t, err)
+
+	stats, err := ds.Statistics(context.Background())
+	require.NoError(t, err)
+
+	var relationCount uint64
+	for _, objectTypeStats := range stats.ObjectTypeStatistics {
+		relationCount += uint64(objectTypeStats.NumRelations)
+	}
+	expected := fanoutFromStats(stats.EstimatedRelationshipCount, relationCount)
+	require.Greater(t, expected, float64(1))
+
+	// The fanout is estimated from the statistics of the whole datastore, even if the check which
+	// loads them is for a tenant without any definition.
+	ctx := datastoremw.ContextWithDatastore(context.Background(), proxy.NewTenantDatastoreProxy(ds, "empty"))
+	planner := newCheckPlanner()
+	require.Eventually(t, func() bool {
+		return planner.currentFanout(ctx) == expected
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestBranchName(t *testing.T) {
+	testCases := []struct {
+		child    *core.SetOperation_Child
+		expected string
+	}{
+		{ns.Nil(), "nil"},
+		{ns.ComputedUserset("viewer"), "viewer"},
+		{ns.TupleToUserset("parent", "view"), "parent->view"},
+		{ns.FunctionedTupleToUserset("parent", "view", core.TupleToUserset_FUNCTION_ANY), "parent.any(view)"},
+		{ns.FunctionedTupleToUserset("parent", "view", core.TupleToUserset_FUNCTION_ALL), "parent.all(view)"},
+		{ns.Rewrite(ns.Union(ns.ComputedUserset("viewer"), ns.ComputedUserset("editor"))), "(viewer + editor)"},
+		{ns.Rewrite(ns.Intersection(ns.ComputedUserset("viewer"), ns.TupleToUserset("org", "member"))), "(viewer & org->member)"},
+		{ns.Rewrite(ns.Exclusion(ns.ComputedUserset("viewer"), ns.ComputedUserset("banned"))), "(viewer - banned)"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.expected, func(t *testing.T) {
+			require.Equal(t, tc.expected, branchName(tc.child))
+		})
+	}
+}
+
+func TestBranchCost(t *testing.T) {
+	testCases := []struct {
+		name     string
+		child    *core.SetOperation_Child
+		expected float64
+	}{
+		{"nil", ns.Nil(), 0},
+		{"relation", ns.ComputedUserset("viewer"), 1},
+		{"wildcard relation", ns.ComputedUserset("public_viewer"), 1},
+		{"relation with userset", ns.ComputedUserset("group_viewer"), 4},
+		{"permission", ns.ComputedUserset("view"), 5},
+		{"unknown relation", ns.ComputedUserset("unknown"), 3},
+		{"arrow", ns.TupleToUserset("org", "member"), 4},
+		{"arrow over several types", ns.TupleToUserset("parent", "view"), 7},
+		{"nested rewrite", ns.Rewrite(ns.Union(ns.ComputedUserset("viewer"), ns.TupleToUserset("org", "member"))), 5},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cost, observed := newCheckPlanner().branchCost("document", plannedDocument, tc.child, 3, 0)
+			require.Equal(t, tc.expected, cost)
+			require.False(t, observed)
+		})
+	}
+}
+
+func TestBranchCostObserved(t *testing.T) {
+	planner := newCheckPlanner()
+	crc := currentRequestContext{
+		parentReq: ValidatedCheckRequest{
+			DispatchCheckRequest: &v1.DispatchCheckRequest{
+				ResourceRelation: &core.RelationReference{Namespace: "document", Relation: "view"},
+			},
+		},
+		namespace: plannedDocument,
+	}
+	documentKey := observedNamespaceKey(context.Background(), crc)
+
+	observe := func(child *core.SetOperation_Child, dispatchCount uint32, err error) {
+		planner.observe(context.Background(), crc, child, CheckResult{
+			Resp: &v1.DispatchCheckResponse{Metadata: &v1.ResponseMeta{DispatchCount: dispatchCount}},
+			Err:  err,
+		})
+	}
+
+	arrow := ns.TupleToUserset("org", "member")
+	observe(arrow, 10, nil)
+
+	cost, observed := planner.branchCost(documentKey, plannedDocument, arrow, 3, 0)
+	require.True(t, observed)
+	require.Equal(t, float64(10), cost)
+
+	// Later observations are averaged.
+	observe(arrow, 20, nil)
+	cost, _ = planner.branchCost(documentKey, plannedDocument, arrow, 3, 0)
+	require.Equal(t, float64(12), cost)
+
+	// Failed branches are not observed.
+	observe(arrow, 0, context.Canceled)
+	cost, _ = planner.branchCost(documentKey, plannedDocument, arrow, 3, 0)
+	require.Equal(t, float64(12), cost)
+
+	// Observations are per namespace.
+	_, observed = planner.branchCost("folder@", plannedDocument, arrow, 3, 0)
+	require.False(t, observed)
+
+	// Observations are per definition of the namespace, so they are not used once it is changed.
+	changed := crc
+	changed.namespace = ns.Namespace("document", ns.MustRelation("org", nil, ns.AllowedRelation("team", "...")))
+	_, observed = planner.branchCost(observedNamespaceKey(context.Background(), changed), changed.namespace, arrow, 3, 0)
+	require.False(t, observed)
+
+	// Branches narrowed by a branch evaluated first are not observed.
+	planner.observe(context.Background(), crc.withFilteredResourceIDs([]string{"first"}), arrow, CheckResult{
+		Resp: &v1.DispatchCheckResponse{Metadata: &v1.ResponseMeta{DispatchCount: 1}},
+	})
+	cost, _ = planner.branchCost(documentKey, plannedDocument, arrow, 3, 0)
+	require.Equal(t, float64(12), cost)
+
+	// Observations are per tenant.
+	tenantCtx := tenantmw.ContextWithTenant(context.Background(), "first")
+	planner.observe(tenantCtx, crc, arrow, CheckResult{
+		Resp: &v1.DispatchCheckResponse{Metadata: &v1.ResponseMeta{DispatchCount: 100}},
+	})
+	cost, _ = planner.branchCost(documentKey, plannedDocument, arrow, 3, 0)
+	require.Equal(t, float64(12), cost)
+
+	cost, observed = planner.branchCost(observedNamespaceKey(tenantCtx, crc), plannedDocument, arrow, 3, 0)
+	require.True(t, observed)
+	require.Equal(t, float64(100), cost)
+
+	_, observed = planner.branchCost(observedNamespaceKey(tenantmw.ContextWithTenant(context.Background(), "second"), crc), plannedDocument, arrow, 3, 0)
+	require.False(t, observed)
+}
+
+func TestObservedCostCacheDropsLeastRecentlyUsed(t *testing.T) {
+	costs := newObservedCostCache(2)
+	costs.observe("first", 1)
+	costs.observe("second", 2)
+
+	// Reading the first cost makes the second the least recently used.
+	cost, ok := costs.get("first")
+	require.True(t, ok)
+	require.Equal(t, float64(1), cost)
+
+	costs.observe("third", 3)
+
+	_, ok = costs.get("second")
+	require.False(t, ok)
+
+	cost, ok = costs.get("first")
+	require.True(t, ok)
+	require.Equal(t, float64(1), cost)
+
+	cost, ok = costs.get("third")
+	require.True(t, ok)
+	require.Equal(t, float64(3), cost)
+}
+
+func TestRecordShortCircuit(t *testing.T) {
+	plan := branchPlan{
+		order: []int{1, 0, 2},
+		decision: &v1.CheckPlanDecision{
+			Branches: []*v1.CheckPlannedBranch{
+				{Name: "viewer", SchemaIndex: 1},
+				{Name: "org->member", SchemaIndex: 0},
+				{Name: "parent->view", SchemaIndex: 2},
+			},
+		},
+	}
+
+	require.Equal(t, []string{"b", "a", "c"}, inPlannedOrder(plan, []string{"a", "b", "c"}))
+	require.Equal(t, []string{"a", "b", "c"}, inPlannedOrder(branchPlan{}, []string{"a", "b", "c"}))
+
+	plan.recordShortCircuit(1)
+	require.True(t, plan.decision.ShortCircuited)
+	require.False(t, plan.decision.Branches[0].Skipped)
+	require.True(t, plan.decision.Branches[1].Skipped)
+	require.True(t, plan.decision.Branches[2].Skipped)
+
+	// Plans without a decision are not recorded.
+	branchPlan{}.recordShortCircuit(1)
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"slices"
+	"strings"
+
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+// subjectResultKeySeparator separates the resource ID from the subject in the keys of the results
+// of a check with additional subjects. Resource IDs cannot contain it.
+const subjectResultKeySeparator = "@"
+
+// checkedSubject is a subject checked by a request, along with the string form under which its
+// results are keyed.
+type checkedSubject struct {
+	onr *core.ObjectAndRelation
+	key string
+}
+
+// checkedSubjects returns the distinct subjects checked by the request: its subject, along with
+// any additional subjects.
+func checkedSubjects(req *v1.DispatchCheckRequest) []checkedSubject {
+	subjects := make([]checkedSubject, 0, len(req.AdditionalSubjects)+1)
+	seen := make(map[string]struct{}, len(req.AdditionalSubjects)+1)
+	for _, subject := range append([]*core.ObjectAndRelation{req.Subject}, req.AdditionalSubjects...) {
+		key := tuple.StringONR(subject)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		subjects = append(subjects, checkedSubject{subject, key})
+	}
+	return subjects
+}
+
+// resultKey returns the key under which the result of the resource for the subject is found in
+// the results of the request. While checking additional subjects, the same resource can be found
+// for several subjects, so the results are keyed by both resource ID and subject.
+func (crc currentRequestContext) resultKey(resourceID string, subjectKey string) string {
+	if !crc.keyedBySubject {
+		return resourceID
+	}
+
+	return subjectResultKey(resourceID, subjectKey)
+}
+
+// splitResultKey returns the resource ID and subject key of a key returned by resultKey.
+func (crc currentRequestContext) splitResultKey(key string) (string, string) {
+	if !crc.keyedBySubject {
+		return key, ""
+	}
+
+	return splitSubjectResultKey(key)
+}
+
+func subjectResultKey(resourceID string, subjectKey string) string {
+	return resourceID + subjectResultKeySeparator + subjectKey
+}
+
+func splitSubjectResultKey(key string) (string, string) {
+	resourceID, subjectKey, _ := strings.Cut(key, subjectResultKeySeparator)
+	return resourceID, subjectKey
+}
+
+// subjectKeys returns the keys of the checked subjects, as given to resultKey.
+func (crc currentRequestContext) subjectKeys() []string {
+	if !crc.keyedBySubject {
+		return []string{""}
+	}
+
+	keys := make([]string, 0, len(crc.subjects))
+	for _, subject := range crc.subjects {
+		keys = append(keys, subject.key)
+	}
+	return keys
+}
+
+// toResultsBySubject moves the results of a check with additional subjects into the
+// results_by_subject of the response.
+func toResultsBySubject(resp *v1.DispatchCheckResponse) {
+	resultsBySubject := make(map[string]*v1.SubjectCheckResults)
+	for key, result := range resp.ResultsByResourceId {
+		resourceID, subjectKey := splitSubjectResultKey(key)
+		subjectResults, ok := resultsBySubject[subjectKey]
+		if !ok {
+			subjectResults = &v1.SubjectCheckResults{ResultsByResourceId: make(CheckResultsMap)}
+			resultsBySubject[subjectKey] = subjectResults
+		}
+		subjectResults.ResultsByResourceId[resourceID] = result
+	}
+
+	resp.ResultsByResourceId = nil
+	resp.ResultsBySubject = resultsBySubject
+}
+
+// fromResultsBySubject returns the response of a dispatched check with additional subjects, with
+// the results of its results_by_subject keyed as per resultKey.
+func fromResultsBySubject(resp *v1.DispatchCheckResponse) *v1.DispatchCheckResponse {
+	results := make(CheckResultsMap)
+	for subjectKey, subjectResults := range resp.ResultsBySubject {
+		for resourceID, result := range subjectResults.ResultsByResourceId {
+			results[subjectResultKey(resourceID, subjectKey)] = result
+		}
+	}
+
+	return &v1.DispatchCheckResponse{
+		Metadata:            resp.Metadata,
+		ResultsByResourceId: results,
+	}
+}
+
+// filterForFoundMemberResource returns the resources found as members because they match a checked
+// subject exactly, along with the resource IDs remaining to be checked.
+func (crc currentRequestContext) filterForFoundMemberResource(resourceRelation *core.RelationReference, resourceIds []string) (*MembershipSet, []string) {
+	if !crc.keyedBySubject {
+		return filterForFoundMemberResource(resourceRelation, resourceIds, crc.parentReq.Subject)
+	}
+
+	// A resource matching one subject must still be checked for the other subjects, so the
+	// resource IDs are not filtered.
+	var membershipSet *MembershipSet
+	for _, subject := range crc.subjects {
+		if resourceRelation.Namespace != subject.onr.Namespace || resourceRelation.Relation != subject.onr.Relation {
+			continue
+		}
+
+		if slices.Contains(resourceIds, subject.onr.ObjectId) {
+			if membershipSet == nil {
+				membershipSet = NewMembershipSet()
+			}
+			membershipSet.AddDirectMember(crc.resultKey(subject.onr.ObjectId, subject.key), nil)
+		}
+	}
+
+	return membershipSet, resourceIds
+}
@@ -11,6 +11,7 @@ import (
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/spiceerrors"
+	"github.com/authzed/spicedb/pkg/tuple"
 )
 
 // DebugOption defines the various debug level options for Checks.
@@ -46,6 +47,10 @@ type CheckParameters struct {
 	AtRevision    datastore.Revision
 	MaximumDepth  uint32
 	DebugOption   DebugOption
+
+	// AllowWildcardSubject, if true, allows the subject to be a wildcard, in which case the check
+	// determines whether the resources are granted to every subject of its type.
+	AllowWildcardSubject bool
 }
 
 // ComputeCheck computes a check result for the given resource and subject, computing any
@@ -74,6 +79,76 @@ func ComputeBulkCheck(
 	return computeCheck(ctx, d, params, resourceIDs)
 }
 
+// ComputeBulkCheckForSubjects computes a check result for the given resource and each of the
+// given subjects, computing any caveat expressions found. The subjects are checked together, with
+// the dispatches of their subproblems shared, and the results are keyed by the string form of
+// each subject. The subject of the parameters is ignored.
+func ComputeBulkCheckForSubjects(
+	ctx context.Context,
+	d dispatch.Check,
+	params CheckParameters,
+	resourceID string,
+	subjects []*core.ObjectAndRelation,
+) (map[string]*v1.ResourceCheckResult, *v1.ResponseMeta, error) {
+	if params.DebugOption != NoDebugging {
+		return nil, nil, spiceerrors.MustBugf("debugging cannot be enabled for a check of multiple subjects")
+	}
+
+	results := make(map[string]*v1.ResourceCheckResult, len(subjects))
+	metadata := &v1.ResponseMeta{}
+
+	bf, err := v1.NewTraversalBloomFilter(uint(params.MaximumDepth))
+	if err != nil {
+		return nil, nil, spiceerrors.MustBugf("failed to create new traversal bloom filter")
+	}
+
+	_, err = slicez.ForEachChunkUntil(subjects, datastore.FilterMaximumIDCount, func(subjectsToCheck []*core.ObjectAndRelation) (bool, error) {
+		checkResult, err := d.DispatchCheck(ctx, &v1.DispatchCheckRequest{
+			ResourceRelation:     params.ResourceType,
+			ResourceIds:          []string{resourceID},
+			ResultsSetting:       v1.DispatchCheckRequest_REQUIRE_ALL_RESULTS,
+			Subject:              subjectsToCheck[0],
+			AdditionalSubjects:   subjectsToCheck[1:],
+			AllowWildcardSubject: params.AllowWildcardSubject,
+			Metadata: &v1.ResolverMeta{
+				AtRevision:     params.AtRevision.String(),
+				DepthRemaining: params.MaximumDepth,
+				TraversalBloom: bf,
+			},
+		})
+
+		metadata = &v1.ResponseMeta{
+			DispatchCount:       metadata.DispatchCount + checkResult.Metadata.DispatchCount,
+			DepthRequired:       max(metadata.DepthRequired, checkResult.Metadata.DepthRequired),
+			CachedDispatchCount: metadata.CachedDispatchCount + checkResult.Metadata.CachedDispatchCount,
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, subject := range subjectsToCheck {
+			subjectKey := tuple.StringONR(subject)
+
+			// A single subject is checked without additional subjects, so its results are keyed by
+			// resource ID alone.
+			subjectResults := checkResult.ResultsByResourceId
+			if len(subjectsToCheck) > 1 {
+				subjectResults = checkResult.ResultsBySubject[subjectKey].GetResultsByResourceId()
+			}
+
+			computed, err := computeCaveatedCheckResult(ctx, params, resourceID, subjectResults)
+			if err != nil {
+				return false, err
+			}
+			results[subjectKey] = computed
+		}
+
+		return true, nil
+	})
+	return results, metadata, err
+}
+
 func computeCheck(ctx context.Context,
 	d dispatch.Check,
 	params CheckParameters,
@@ -109,10 +184,11 @@ func computeCheck(ctx context.Context,
 	// TODO(jschorr): Should we make this run in parallel via the preloadedTaskRunner?
 	_, err = slicez.ForEachChunkUntil(resourceIDs, datastore.FilterMaximumIDCount, func(resourceIDsToCheck []string) (bool, error) {
 		checkResult, err := d.DispatchCheck(ctx, &v1.DispatchCheckRequest{
-			ResourceRelation: params.ResourceType,
-			ResourceIds:      resourceIDsToCheck,
-			ResultsSetting:   setting,
-			Subject:          params.Subject,
+			ResourceRelation:     params.ResourceType,
+			ResourceIds:          resourceIDsToCheck,
+			ResultsSetting:       setting,
+			Subject:              params.Subject,
+			AllowWildcardSubject: params.AllowWildcardSubject,
 			Metadata: &v1.ResolverMeta{
 				AtRevision:     params.AtRevision.String(),
 				DepthRemaining: params.MaximumDepth,
@@ -136,7 +212,7 @@ func computeCheck(ctx context.Context,
 		}
 
 		for _, resourceID := range resourceIDsToCheck {
-			computed, err := computeCaveatedCheckResult(ctx, params, resourceID, checkResult)
+			computed, err := computeCaveatedCheckResult(ctx, params, resourceID, checkResult.ResultsByResourceId)
 			if err != nil {
 				return false, err
 			}
@@ -148,8 +224,8 @@ func computeCheck(ctx context.Context,
 	return results, metadata, err
 }
 
-func computeCaveatedCheckResult(ctx context.Context, params CheckParameters, resourceID string, checkResult *v1.DispatchCheckResponse) (*v1.ResourceCheckResult, error) {
-	result, ok := checkResult.ResultsByResourceId[resourceID]
+func computeCaveatedCheckResult(ctx context.Context, params CheckParameters, resourceID string, resultsByResourceID map[string]*v1.ResourceCheckResult) (*v1.ResourceCheckResult, error) {
+	result, ok := resultsByResourceID[resourceID]
 	if !ok {
 		return &v1.ResourceCheckResult{
 			Membership: v1.ResourceCheckResult_NOT_MEMBER,
@@ -12,11 +12,17 @@ import (
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/namespace"
 	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/datastore/options"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/spiceerrors"
+	"github.com/authzed/spicedb/pkg/tuple"
 )
 
+// expandDispatchVersion defines the "version" of the cursors returned by expand. Must be
+// incremented anytime an incompatible change is made to the cursor format.
+const expandDispatchVersion = 1
+
 // NewConcurrentExpander creates an instance of ConcurrentExpander
 func NewConcurrentExpander(d dispatch.Expand) *ConcurrentExpander {
 	return &ConcurrentExpander{d: d}
@@ -39,6 +45,11 @@ type ValidatedExpandRequest struct {
 func (ce *ConcurrentExpander) Expand(ctx context.Context, req ValidatedExpandRequest, relation *core.Relation) (*v1.DispatchExpandResponse, error) {
 	log.Ctx(ctx).Trace().Object("expand", req).Send()
 
+	// Cursors are only minted for the subjects of relations without a rewrite.
+	if req.OptionalCursor != nil && relation.UsersetRewrite != nil {
+		return &v1.DispatchExpandResponse{Metadata: emptyMetadata}, spiceerrors.MustBugf("cursor given for expansion of %s, which has a rewrite", tuple.StringONR(req.ResourceAndRelation))
+	}
+
 	var directFunc ReduceableExpandFunc
 	if relation.UsersetRewrite == nil {
 		directFunc = ce.expandDirect(ctx, req)
@@ -57,12 +68,18 @@ func (ce *ConcurrentExpander) expandDirect(
 ) ReduceableExpandFunc {
 	log.Ctx(ctx).Trace().Object("direct", req).Send()
 	return func(ctx context.Context, resultChan chan<- ExpandResult) {
+		ci, err := newCursorInformation(req.OptionalCursor, newLimitTracker(req.OptionalSubjectLimit), expandDispatchVersion)
+		if err != nil {
+			resultChan <- expandResultError(err, emptyMetadata)
+			return
+		}
+
 		ds := datastoremw.MustFromContext(ctx).SnapshotReader(req.Revision)
 		it, err := ds.QueryRelationships(ctx, datastore.RelationshipsFilter{
 			OptionalResourceType:     req.ResourceAndRelation.Namespace,
 			OptionalResourceIds:      []string{req.ResourceAndRelation.ObjectId},
 			OptionalResourceRelation: req.ResourceAndRelation.Relation,
-		})
+		}, subjectPageQueryOptions(req, ci)...)
 		if err != nil {
 			resultChan <- expandResultError(NewExpansionFailureErr(err), emptyMetadata)
 			return
@@ -71,12 +88,29 @@ func (ce *ConcurrentExpander) expandDirect(
 
 		var foundNonTerminalUsersets []*core.DirectSubject
 		var foundTerminalUsersets []*core.DirectSubject
+		var truncatedNodes []*v1.TruncatedExpandNode
+		var lastTpl *core.RelationTuple
 		for tpl := it.Next(); tpl != nil; tpl = it.Next() {
 			if it.Err() != nil {
 				resultChan <- expandResultError(NewExpansionFailureErr(it.Err()), emptyMetadata)
 				return
 			}
 
+			if !ci.limits.prepareForPublishing() {
+				nextCursor, err := ci.withOutgoingSection(tuple.StringWithoutCaveat(lastTpl))
+				if err != nil {
+					resultChan <- expandResultError(err, emptyMetadata)
+					return
+				}
+
+				truncatedNodes = append(truncatedNodes, &v1.TruncatedExpandNode{
+					Expanded:       req.ResourceAndRelation,
+					OptionalCursor: nextCursor.responsePartialCursor(),
+				})
+				break
+			}
+			lastTpl = tpl
+
 			ds := &core.DirectSubject{
 				Subject:          tpl.Subject,
 				CaveatExpression: caveats.CaveatAsExpr(tpl.Caveat),
@@ -92,7 +126,7 @@ func (ce *ConcurrentExpander) expandDirect(
 		// If only shallow expansion was required, or there are no non-terminal subjects found,
 		// nothing more to do.
 		if req.ExpansionMode == v1.DispatchExpandRequest_SHALLOW || len(foundNonTerminalUsersets) == 0 {
-			resultChan <- expandResult(
+			resultChan <- expandResultWithTruncatedNodes(
 				&core.RelationTupleTreeNode{
 					NodeType: &core.RelationTupleTreeNode_LeafNode{
 						LeafNode: &core.DirectSubjects{
@@ -101,6 +135,7 @@ func (ce *ConcurrentExpander) expandDirect(
 					},
 					Expanded: req.ResourceAndRelation,
 				},
+				truncatedNodes,
 				emptyMetadata,
 			)
 			return
@@ -110,15 +145,7 @@ func (ce *ConcurrentExpander) expandDirect(
 		// found terminals together.
 		var requestsToDispatch []ReduceableExpandFunc
 		for _, nonTerminalUser := range foundNonTerminalUsersets {
-			toDispatch := ce.dispatch(ValidatedExpandRequest{
-				&v1.DispatchExpandRequest{
-					ResourceAndRelation: nonTerminalUser.Subject,
-					Metadata:            decrementDepth(req.Metadata),
-					ExpansionMode:       req.ExpansionMode,
-				},
-				req.Revision,
-			})
-
+			toDispatch := ce.dispatchChild(req, nonTerminalUser.Subject)
 			requestsToDispatch = append(requestsToDispatch, decorateWithCaveatIfNecessary(toDispatch, nonTerminalUser.CaveatExpression))
 		}
 
@@ -137,10 +164,33 @@ func (ce *ConcurrentExpander) expandDirect(
 			},
 			Expanded: req.ResourceAndRelation,
 		})
+		result.Resp.TruncatedNodes = append(result.Resp.TruncatedNodes, truncatedNodes...)
 		resultChan <- result
 	}
 }
 
+// subjectPageQueryOptions returns the options of the query of the relationships of a relation
+// being expanded. If the subjects are paginated, they are returned in a stable order, resuming
+// after the relationship found at the head of the cursor, if any. One more relationship than the
+// limit is loaded to determine whether the subjects were truncated.
+func subjectPageQueryOptions(req ValidatedExpandRequest, ci cursorInformation) []options.QueryOptionsOption {
+	var queryOpts []options.QueryOptionsOption
+	if req.OptionalSubjectLimit > 0 || req.OptionalCursor != nil {
+		var afterCursor options.Cursor
+		if afterCursorString, ok := ci.headSectionValue(); ok && afterCursorString != "" {
+			afterCursor = tuple.MustParse(afterCursorString)
+		}
+
+		queryOpts = append(queryOpts, options.WithSort(options.ByResource), options.WithAfter(afterCursor))
+	}
+
+	if req.OptionalSubjectLimit > 0 {
+		limit := uint64(req.OptionalSubjectLimit) + 1
+		queryOpts = append(queryOpts, options.WithLimit(&limit))
+	}
+	return queryOpts
+}
+
 func decorateWithCaveatIfNecessary(toDispatch ReduceableExpandFunc, caveatExpr *core.CaveatExpression) ReduceableExpandFunc {
 	// If no caveat expression, simply return the func unmodified.
 	if caveatExpr == nil {
@@ -211,6 +261,31 @@ func (ce *ConcurrentExpander) dispatch(req ValidatedExpandRequest) ReduceableExp
 	}
 }
 
+// dispatchChild dispatches the expansion of the given relation, found beneath the relation being
+// expanded by req. If the maximum depth of the expansion has been reached, the relation is instead
+// returned as a truncated node.
+func (ce *ConcurrentExpander) dispatchChild(req ValidatedExpandRequest, onr *core.ObjectAndRelation) ReduceableExpandFunc {
+	if req.OptionalMaxDepth == 1 {
+		return truncatedExpansion(onr)
+	}
+
+	var maxDepth uint32
+	if req.OptionalMaxDepth > 0 {
+		maxDepth = req.OptionalMaxDepth - 1
+	}
+
+	return ce.dispatch(ValidatedExpandRequest{
+		&v1.DispatchExpandRequest{
+			ResourceAndRelation:  onr,
+			Metadata:             decrementDepth(req.Metadata),
+			ExpansionMode:        req.ExpansionMode,
+			OptionalMaxDepth:     maxDepth,
+			OptionalSubjectLimit: req.OptionalSubjectLimit,
+		},
+		req.Revision,
+	})
+}
+
 func (ce *ConcurrentExpander) expandComputedUserset(ctx context.Context, req ValidatedExpandRequest, cu *core.ComputedUserset, tpl *core.RelationTuple) ReduceableExpandFunc {
 	log.Ctx(ctx).Trace().Str("relation", cu.Relation).Msg("computed userset")
 	var start *core.ObjectAndRelation
@@ -239,28 +314,39 @@ func (ce *ConcurrentExpander) expandComputedUserset(ctx context.Context, req Val
 		return expandError(err)
 	}
 
-	return ce.dispatch(ValidatedExpandRequest{
-		&v1.DispatchExpandRequest{
-			ResourceAndRelation: &core.ObjectAndRelation{
-				Namespace: start.Namespace,
-				ObjectId:  start.ObjectId,
-				Relation:  cu.Relation,
-			},
-			Metadata:      decrementDepth(req.Metadata),
-			ExpansionMode: req.ExpansionMode,
-		},
-		req.Revision,
+	return ce.dispatchChild(req, &core.ObjectAndRelation{
+		Namespace: start.Namespace,
+		ObjectId:  start.ObjectId,
+		Relation:  cu.Relation,
 	})
 }
 
+// expandTupleToUserset expands an arrow. The subject limit of the request applies to the
+// relationships of its tupleset: if they are truncated, the tupleset relation is returned as a
+// truncated node, whose cursor resumes the expansion of the remaining relationships of the
+// tupleset, on whose subjects the computed userset of the arrow remains to be expanded.
 func (ce *ConcurrentExpander) expandTupleToUserset(_ context.Context, req ValidatedExpandRequest, ttu *core.TupleToUserset) ReduceableExpandFunc {
 	return func(ctx context.Context, resultChan chan<- ExpandResult) {
+		// Cursors are never given for the expansion of a rewrite, so the tupleset is always
+		// expanded from its first relationship.
+		ci, err := newCursorInformation(nil, newLimitTracker(req.OptionalSubjectLimit), expandDispatchVersion)
+		if err != nil {
+			resultChan <- expandResultError(err, emptyMetadata)
+			return
+		}
+
+		tupleset := &core.ObjectAndRelation{
+			Namespace: req.ResourceAndRelation.Namespace,
+			ObjectId:  req.ResourceAndRelation.ObjectId,
+			Relation:  ttu.Tupleset.Relation,
+		}
+
 		ds := datastoremw.MustFromContext(ctx).SnapshotReader(req.Revision)
 		it, err := ds.QueryRelationships(ctx, datastore.RelationshipsFilter{
-			OptionalResourceType:     req.ResourceAndRelation.Namespace,
-			OptionalResourceIds:      []string{req.ResourceAndRelation.ObjectId},
-			OptionalResourceRelation: ttu.Tupleset.Relation,
-		})
+			OptionalResourceType:     tupleset.Namespace,
+			OptionalResourceIds:      []string{tupleset.ObjectId},
+			OptionalResourceRelation: tupleset.Relation,
+		}, subjectPageQueryOptions(req, ci)...)
 		if err != nil {
 			resultChan <- expandResultError(NewExpansionFailureErr(err), emptyMetadata)
 			return
@@ -268,18 +354,49 @@ func (ce *ConcurrentExpander) expandTupleToUserset(_ context.Context, req Valida
 		defer it.Close()
 
 		var requestsToDispatch []ReduceableExpandFunc
+		var relationshipCaveats []*core.CaveatExpression
+		var truncatedNodes []*v1.TruncatedExpandNode
+		var lastTpl *core.RelationTuple
 		for tpl := it.Next(); tpl != nil; tpl = it.Next() {
 			if it.Err() != nil {
 				resultChan <- expandResultError(NewExpansionFailureErr(it.Err()), emptyMetadata)
 				return
 			}
 
+			if !ci.limits.prepareForPublishing() {
+				nextCursor, err := ci.withOutgoingSection(tuple.StringWithoutCaveat(lastTpl))
+				if err != nil {
+					resultChan <- expandResultError(err, emptyMetadata)
+					return
+				}
+
+				truncatedNodes = append(truncatedNodes, &v1.TruncatedExpandNode{
+					Expanded:       tupleset,
+					OptionalCursor: nextCursor.responsePartialCursor(),
+				})
+				break
+			}
+			lastTpl = tpl
+
 			toDispatch := ce.expandComputedUserset(ctx, req, ttu.ComputedUserset, tpl)
 			requestsToDispatch = append(requestsToDispatch, decorateWithCaveatIfNecessary(toDispatch, caveats.CaveatAsExpr(tpl.Caveat)))
+			relationshipCaveats = append(relationshipCaveats, caveats.CaveatAsExpr(tpl.Caveat))
 		}
 		it.Close()
 
-		resultChan <- expandAny(ctx, req.ResourceAndRelation, requestsToDispatch)
+		// An arrow with the `all` function requires the computed userset on every subject
+		// of the tupleset, and is therefore expanded as an intersection.
+		var result ExpandResult
+		if ttu.Function == core.TupleToUserset_FUNCTION_ALL {
+			result = expandAllArrow(ctx, req.ResourceAndRelation, requestsToDispatch, relationshipCaveats)
+		} else {
+			result = expandAny(ctx, req.ResourceAndRelation, requestsToDispatch)
+		}
+
+		if result.Err == nil {
+			result.Resp.TruncatedNodes = append(result.Resp.TruncatedNodes, truncatedNodes...)
+		}
+		resultChan <- result
 	}
 }
 
@@ -287,18 +404,12 @@ func setResult(
 	op core.SetOperationUserset_Operation,
 	start *core.ObjectAndRelation,
 	children []*core.RelationTupleTreeNode,
+	truncatedNodes []*v1.TruncatedExpandNode,
 	metadata *v1.ResponseMeta,
 ) ExpandResult {
-	return expandResult(
-		&core.RelationTupleTreeNode{
-			NodeType: &core.RelationTupleTreeNode_IntermediateNode{
-				IntermediateNode: &core.SetOperationUserset{
-					Operation:  op,
-					ChildNodes: children,
-				},
-			},
-			Expanded: start,
-		},
+	return expandResultWithTruncatedNodes(
+		setOperationNode(op, start, children),
+		truncatedNodes,
 		metadata,
 	)
 }
@@ -312,7 +423,7 @@ func expandSetOperation(
 	children := make([]*core.RelationTupleTreeNode, 0, len(requests))
 
 	if len(requests) == 0 {
-		return setResult(op, start, children, emptyMetadata)
+		return setResult(op, start, children, nil, emptyMetadata)
 	}
 
 	childCtx, cancelFn := context.WithCancel(ctx)
@@ -325,6 +436,7 @@ func expandSetOperation(
 		go req(childCtx, resultChan)
 	}
 
+	var truncatedNodes []*v1.TruncatedExpandNode
 	responseMetadata := emptyMetadata
 	for _, resultChan := range resultChans {
 		select {
@@ -334,12 +446,13 @@ func expandSetOperation(
 				return expandResultError(result.Err, responseMetadata)
 			}
 			children = append(children, result.Resp.TreeNode)
+			truncatedNodes = append(truncatedNodes, result.Resp.TruncatedNodes...)
 		case <-ctx.Done():
 			return expandResultError(context.Canceled, responseMetadata)
 		}
 	}
 
-	return setResult(op, start, children, responseMetadata)
+	return setResult(op, start, children, truncatedNodes, responseMetadata)
 }
 
 // emptyExpansion returns an empty expansion.
@@ -354,6 +467,18 @@ func emptyExpansion(start *core.ObjectAndRelation) ReduceableExpandFunc {
 	}
 }
 
+// truncatedExpansion returns an empty expansion, marked as truncated.
+func truncatedExpansion(start *core.ObjectAndRelation) ReduceableExpandFunc {
+	return func(ctx context.Context, resultChan chan<- ExpandResult) {
+		resultChan <- expandResultWithTruncatedNodes(&core.RelationTupleTreeNode{
+			NodeType: &core.RelationTupleTreeNode_LeafNode{
+				LeafNode: &core.DirectSubjects{},
+			},
+			Expanded: start,
+		}, []*v1.TruncatedExpandNode{{Expanded: start}}, emptyMetadata)
+	}
+}
+
 // expandError returns the error.
 func expandError(err error) ReduceableExpandFunc {
 	return func(ctx context.Context, resultChan chan<- ExpandResult) {
@@ -366,6 +491,66 @@ func expandAll(ctx context.Context, start *core.ObjectAndRelation, requests []Re
 	return expandSetOperation(ctx, start, requests, core.SetOperationUserset_INTERSECTION)
 }
 
+// expandAllArrow returns a tree for an arrow with the `all` function, with a child per tupleset
+// relationship. A caveated tupleset relationship only applies when its caveat holds, so its child is
+// only required under that caveat: the subjects of the uncaveated children (or, if there are none, of
+// any caveated child) are intersected with, for each caveated child, those subjects found on it or
+// else under the inversion of its caveat.
+func expandAllArrow(ctx context.Context, start *core.ObjectAndRelation, requests []ReduceableExpandFunc, relationshipCaveats []*core.CaveatExpression) ExpandResult {
+	result := expandAll(ctx, start, requests)
+	if result.Err != nil {
+		return result
+	}
+
+	children := result.Resp.TreeNode.GetIntermediateNode().ChildNodes
+	var uncaveated, caveated []*core.RelationTupleTreeNode
+	for index, child := range children {
+		if relationshipCaveats[index] == nil {
+			uncaveated = append(uncaveated, child)
+		} else {
+			caveated = append(caveated, child)
+		}
+	}
+
+	if len(caveated) == 0 {
+		return result
+	}
+
+	applicable := setOperationNode(core.SetOperationUserset_INTERSECTION, start, uncaveated)
+	if len(uncaveated) == 0 {
+		applicable = setOperationNode(core.SetOperationUserset_UNION, start, caveated)
+	}
+
+	intersected := []*core.RelationTupleTreeNode{applicable}
+	for _, child := range caveated {
+		withoutCaveat := child.CloneVT()
+		withoutCaveat.CaveatExpression = nil
+
+		notFound := setOperationNode(core.SetOperationUserset_EXCLUSION, start, []*core.RelationTupleTreeNode{applicable, withoutCaveat})
+		notFound.CaveatExpression = caveats.Invert(child.CaveatExpression)
+
+		intersected = append(intersected, setOperationNode(core.SetOperationUserset_UNION, start, []*core.RelationTupleTreeNode{
+			setOperationNode(core.SetOperationUserset_INTERSECTION, start, []*core.RelationTupleTreeNode{applicable, withoutCaveat}),
+			notFound,
+		}))
+	}
+
+	result.Resp.TreeNode = setOperationNode(core.SetOperationUserset_INTERSECTION, start, intersected)
+	return result
+}
+
+func setOperationNode(op core.SetOperationUserset_Operation, start *core.ObjectAndRelation, children []*core.RelationTupleTreeNode) *core.RelationTupleTreeNode {
+	return &core.RelationTupleTreeNode{
+		NodeType: &core.RelationTupleTreeNode_IntermediateNode{
+			IntermediateNode: &core.SetOperationUserset{
+				Operation:  op,
+				ChildNodes: children,
+			},
+		},
+		Expanded: start,
+	}
+}
+
 // expandAny returns a tree with all of the children and a union node type.
 func expandAny(ctx context.Context, start *core.ObjectAndRelation, requests []ReduceableExpandFunc) ExpandResult {
 	return expandSetOperation(ctx, start, requests, core.SetOperationUserset_UNION)
@@ -399,10 +584,15 @@ func alwaysFailExpand(_ context.Context, resultChan chan<- ExpandResult) {
 }
 
 func expandResult(treeNode *core.RelationTupleTreeNode, subProblemMetadata *v1.ResponseMeta) ExpandResult {
+	return expandResultWithTruncatedNodes(treeNode, nil, subProblemMetadata)
+}
+
+func expandResultWithTruncatedNodes(treeNode *core.RelationTupleTreeNode, truncatedNodes []*v1.TruncatedExpandNode, subProblemMetadata *v1.ResponseMeta) ExpandResult {
 	return ExpandResult{
 		&v1.DispatchExpandResponse{
-			Metadata: ensureMetadata(subProblemMetadata),
-			TreeNode: treeNode,
+			Metadata:       ensureMetadata(subProblemMetadata),
+			TreeNode:       treeNode,
+			TruncatedNodes: truncatedNodes,
 		},
 		nil,
 	}
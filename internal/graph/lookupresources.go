@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/authzed/spicedb/internal/dispatch"
+	"github.com/authzed/spicedb/internal/taskrunner"
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
@@ -12,16 +13,17 @@ import (
 )
 
 // NewCursoredLookupResources creates and instance of CursoredLookupResources.
-func NewCursoredLookupResources(c dispatch.Check, r dispatch.ReachableResources, concurrencyLimit uint16) *CursoredLookupResources {
-	return &CursoredLookupResources{c, r, concurrencyLimit}
+func NewCursoredLookupResources(c dispatch.Check, r dispatch.ReachableResources, ls dispatch.LookupSubjects, concurrencyLimiter taskrunner.ConcurrencyLimiter) *CursoredLookupResources {
+	return &CursoredLookupResources{c, r, ls, concurrencyLimiter}
 }
 
 // CursoredLookupResources exposes a method to perform LookupResources requests, and delegates subproblems to the
 // provided dispatch.Lookup instance.
 type CursoredLookupResources struct {
-	c                dispatch.Check
-	r                dispatch.ReachableResources
-	concurrencyLimit uint16
+	c                  dispatch.Check
+	r                  dispatch.ReachableResources
+	ls                 dispatch.LookupSubjects
+	concurrencyLimiter taskrunner.ConcurrencyLimiter
 }
 
 // ValidatedLookupResourcesRequest represents a request after it has been validated and parsed for internal
@@ -35,9 +37,15 @@ func (cl *CursoredLookupResources) LookupResources(
 	req ValidatedLookupResourcesRequest,
 	parentStream dispatch.LookupResourcesStream,
 ) error {
-	if req.Subject.ObjectId == tuple.PublicWildcard {
+	// The wildcard can only be the subject when looking up the resources granted to it.
+	isWildcardSubject := req.Subject.ObjectId == tuple.PublicWildcard
+	onlyWildcards := req.WildcardSetting == v1.DispatchLookupResourcesRequest_ONLY_WILDCARDS
+	if isWildcardSubject && !onlyWildcards {
 		return NewErrInvalidArgument(errors.New("cannot perform lookup resources on wildcard"))
 	}
+	if onlyWildcards && !isWildcardSubject {
+		return NewErrInvalidArgument(errors.New("lookup resources of only wildcards requires the wildcard as the subject"))
+	}
 
 	lookupContext := parentStream.Context()
 	limits := newLimitTracker(req.OptionalLimit)
@@ -56,7 +64,7 @@ func (cl *CursoredLookupResources) LookupResources(
 		// to the parent stream, as found resources if they are properly checked.
 		checkingStream := newCheckingResourceStream(lookupContext, reachableContext, func() {
 			cancelReachable(errCanceledBecauseNoAdditionalResourcesNeeded)
-		}, req, cl.c, parentStream, limits, cl.concurrencyLimit)
+		}, req, cl.c, cl.ls, parentStream, limits, cl.concurrencyLimiter.ConcurrencyLimit())
 
 		err := cl.r.DispatchReachableResources(&v1.DispatchReachableResourcesRequest{
 			ResourceRelation: req.ObjectRelation,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"golang.org/x/sync/errgroup"
 
@@ -12,6 +13,7 @@ import (
 	log "github.com/authzed/spicedb/internal/logging"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/namespace"
+	"github.com/authzed/spicedb/internal/taskrunner"
 	"github.com/authzed/spicedb/pkg/datastore"
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	"github.com/authzed/spicedb/pkg/genutil/slicez"
@@ -28,13 +30,13 @@ type ValidatedLookupSubjectsRequest struct {
 }
 
 // NewConcurrentLookupSubjects creates an instance of ConcurrentLookupSubjects.
-func NewConcurrentLookupSubjects(d dispatch.LookupSubjects, concurrencyLimit uint16) *ConcurrentLookupSubjects {
-	return &ConcurrentLookupSubjects{d, concurrencyLimit}
+func NewConcurrentLookupSubjects(d dispatch.LookupSubjects, concurrencyLimiter taskrunner.ConcurrencyLimiter) *ConcurrentLookupSubjects {
+	return &ConcurrentLookupSubjects{d, concurrencyLimiter}
 }
 
 type ConcurrentLookupSubjects struct {
-	d                dispatch.LookupSubjects
-	concurrencyLimit uint16
+	d                  dispatch.LookupSubjects
+	concurrencyLimiter taskrunner.ConcurrencyLimiter
 }
 
 func (cl *ConcurrentLookupSubjects) LookupSubjects(
@@ -47,14 +49,21 @@ func (cl *ConcurrentLookupSubjects) LookupSubjects(
 		return fmt.Errorf("no resources ids given to lookupsubjects dispatch")
 	}
 
+	filter, err := newSubjectsFilter(req.DispatchLookupSubjectsRequest)
+	if err != nil {
+		return err
+	}
+
 	// If the resource type matches the subject type, yield directly.
 	if req.SubjectRelation.Namespace == req.ResourceRelation.Namespace &&
 		req.SubjectRelation.Relation == req.ResourceRelation.Relation {
-		if err := stream.Publish(&v1.DispatchLookupSubjectsResponse{
-			FoundSubjectsByResourceId: subjectsForConcreteIds(req.ResourceIds),
-			Metadata:                  emptyMetadata,
-		}); err != nil {
-			return err
+		if foundSubjects := filter.apply(subjectsForConcreteIds(req.ResourceIds)); len(foundSubjects) > 0 {
+			if err := stream.Publish(&v1.DispatchLookupSubjectsResponse{
+				FoundSubjectsByResourceId: foundSubjects,
+				Metadata:                  emptyMetadata,
+			}); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -71,10 +80,10 @@ func (cl *ConcurrentLookupSubjects) LookupSubjects(
 
 	if relation.UsersetRewrite == nil {
 		// Direct lookup of subjects.
-		return cl.lookupDirectSubjects(ctx, req, stream, relation, reader)
+		return cl.lookupDirectSubjects(ctx, req, stream, relation, reader, filter)
 	}
 
-	return cl.lookupViaRewrite(ctx, req, stream, relation.UsersetRewrite)
+	return cl.lookupViaRewrite(ctx, req, stream, relation.UsersetRewrite, filter)
 }
 
 func subjectsForConcreteIds(subjectIds []string) map[string]*v1.FoundSubjects {
@@ -98,13 +107,31 @@ func (cl *ConcurrentLookupSubjects) lookupDirectSubjects(
 	stream dispatch.LookupSubjectsStream,
 	_ *core.Relation,
 	reader datastore.Reader,
+	filter subjectsFilter,
 ) error {
 	// TODO(jschorr): use type information to skip subject relations that cannot reach the subject type.
-	it, err := reader.QueryRelationships(ctx, datastore.RelationshipsFilter{
+	relsFilter := datastore.RelationshipsFilter{
 		OptionalResourceType:     req.ResourceRelation.Namespace,
 		OptionalResourceRelation: req.ResourceRelation.Relation,
 		OptionalResourceIds:      req.ResourceIds,
-	})
+	}
+
+	// If candidate subjects were given, only load those subjects (and wildcards) of the subject
+	// type, along with the subjects with a relation, which are dispatched further.
+	if len(req.OptionalSubjectIds) > 0 {
+		relsFilter.OptionalSubjectsSelectors = []datastore.SubjectsSelector{
+			{
+				OptionalSubjectType: req.SubjectRelation.Namespace,
+				OptionalSubjectIds:  append(slices.Clone(req.OptionalSubjectIds), tuple.PublicWildcard),
+				RelationFilter:      datastore.SubjectRelationFilter{}.WithRelation(req.SubjectRelation.Relation),
+			},
+			{
+				RelationFilter: datastore.SubjectRelationFilter{}.WithOnlyNonEllipsisRelations(),
+			},
+		}
+	}
+
+	it, err := reader.QueryRelationships(ctx, relsFilter)
 	if err != nil {
 		return err
 	}
@@ -137,15 +164,17 @@ func (cl *ConcurrentLookupSubjects) lookupDirectSubjects(
 	it.Close()
 
 	if !foundSubjectsByResourceID.IsEmpty() {
-		if err := stream.Publish(&v1.DispatchLookupSubjectsResponse{
-			FoundSubjectsByResourceId: foundSubjectsByResourceID.AsMap(),
-			Metadata:                  emptyMetadata,
-		}); err != nil {
-			return err
+		if foundSubjects := filter.apply(foundSubjectsByResourceID.AsMap()); len(foundSubjects) > 0 {
+			if err := stream.Publish(&v1.DispatchLookupSubjectsResponse{
+				FoundSubjectsByResourceId: foundSubjects,
+				Metadata:                  emptyMetadata,
+			}); err != nil {
+				return err
+			}
 		}
 	}
 
-	return cl.dispatchTo(ctx, req, toDispatchByType, relationshipsBySubjectONR, stream)
+	return cl.dispatchTo(ctx, req, toDispatchByType, relationshipsBySubjectONR, stream, filter)
 }
 
 func (cl *ConcurrentLookupSubjects) lookupViaComputed(
@@ -174,18 +203,10 @@ func (cl *ConcurrentLookupSubjects) lookupViaComputed(
 		},
 	}
 
-	return cl.d.DispatchLookupSubjects(&v1.DispatchLookupSubjectsRequest{
-		ResourceRelation: &core.RelationReference{
-			Namespace: parentRequest.ResourceRelation.Namespace,
-			Relation:  cu.Relation,
-		},
-		ResourceIds:     parentRequest.ResourceIds,
-		SubjectRelation: parentRequest.SubjectRelation,
-		Metadata: &v1.ResolverMeta{
-			AtRevision:     parentRequest.Revision.String(),
-			DepthRemaining: parentRequest.Metadata.DepthRemaining - 1,
-		},
-	}, stream)
+	return cl.d.DispatchLookupSubjects(childLookupSubjectsRequest(parentRequest, &core.RelationReference{
+		Namespace: parentRequest.ResourceRelation.Namespace,
+		Relation:  cu.Relation,
+	}, parentRequest.ResourceIds), stream)
 }
 
 func (cl *ConcurrentLookupSubjects) lookupViaTupleToUserset(
@@ -193,6 +214,7 @@ func (cl *ConcurrentLookupSubjects) lookupViaTupleToUserset(
 	parentRequest ValidatedLookupSubjectsRequest,
 	parentStream dispatch.LookupSubjectsStream,
 	ttu *core.TupleToUserset,
+	filter subjectsFilter,
 ) error {
 	ds := datastoremw.MustFromContext(ctx).SnapshotReader(parentRequest.Revision)
 	it, err := ds.QueryRelationships(ctx, datastore.RelationshipsFilter{
@@ -207,6 +229,7 @@ func (cl *ConcurrentLookupSubjects) lookupViaTupleToUserset(
 
 	toDispatchByTuplesetType := datasets.NewSubjectByTypeSet()
 	relationshipsBySubjectONR := mapz.NewMultiMap[string, *core.RelationTuple]()
+	relationshipsByResourceID := mapz.NewMultiMap[string, *core.RelationTuple]()
 	for tpl := it.Next(); tpl != nil; tpl = it.Next() {
 		if it.Err() != nil {
 			return it.Err()
@@ -225,6 +248,7 @@ func (cl *ConcurrentLookupSubjects) lookupViaTupleToUserset(
 			ObjectId:  tpl.Subject.ObjectId,
 			Relation:  ttu.ComputedUserset.Relation,
 		}), tpl)
+		relationshipsByResourceID.Add(tpl.ResourceAndRelation.ObjectId, tpl)
 	}
 	it.Close()
 
@@ -247,7 +271,11 @@ func (cl *ConcurrentLookupSubjects) lookupViaTupleToUserset(
 		return err
 	}
 
-	return cl.dispatchTo(ctx, parentRequest, toDispatchByComputedRelationType, relationshipsBySubjectONR, parentStream)
+	if ttu.Function == core.TupleToUserset_FUNCTION_ALL {
+		return cl.dispatchToIntersection(ctx, parentRequest, toDispatchByComputedRelationType, ttu.ComputedUserset.Relation, relationshipsByResourceID, parentStream, filter)
+	}
+
+	return cl.dispatchTo(ctx, parentRequest, toDispatchByComputedRelationType, relationshipsBySubjectONR, parentStream, filter)
 }
 
 func (cl *ConcurrentLookupSubjects) lookupViaRewrite(
@@ -255,17 +283,32 @@ func (cl *ConcurrentLookupSubjects) lookupViaRewrite(
 	req ValidatedLookupSubjectsRequest,
 	stream dispatch.LookupSubjectsStream,
 	usr *core.UsersetRewrite,
+	filter subjectsFilter,
 ) error {
+	// The combined results of the set operation are restricted by the filter, to apply its limit.
+	filteredStream := &dispatch.WrappedDispatchStream[*v1.DispatchLookupSubjectsResponse]{
+		Stream: stream,
+		Ctx:    ctx,
+		Processor: func(result *v1.DispatchLookupSubjectsResponse) (*v1.DispatchLookupSubjectsResponse, bool, error) {
+			return &v1.DispatchLookupSubjectsResponse{
+				FoundSubjectsByResourceId: filter.apply(result.FoundSubjectsByResourceId),
+				Metadata:                  result.Metadata,
+			}, true, nil
+		},
+	}
+
 	switch rw := usr.RewriteOperation.(type) {
 	case *core.UsersetRewrite_Union:
 		log.Ctx(ctx).Trace().Msg("union")
-		return cl.lookupSetOperation(ctx, req, rw.Union, newLookupSubjectsUnion(stream))
+		return cl.lookupSetOperation(ctx, req, rw.Union, newLookupSubjectsUnion(filteredStream), filter)
 	case *core.UsersetRewrite_Intersection:
+		// The first subjects of an intersection or exclusion need not be amongst the first subjects
+		// of its children, so the limit is only applied once the children are combined.
 		log.Ctx(ctx).Trace().Msg("intersection")
-		return cl.lookupSetOperation(ctx, req, rw.Intersection, newLookupSubjectsIntersection(stream))
+		return cl.lookupSetOperation(ctx, withoutLimit(req), rw.Intersection, newLookupSubjectsIntersection(filteredStream), filter.withoutLimit())
 	case *core.UsersetRewrite_Exclusion:
 		log.Ctx(ctx).Trace().Msg("exclusion")
-		return cl.lookupSetOperation(ctx, req, rw.Exclusion, newLookupSubjectsExclusion(stream))
+		return cl.lookupSetOperation(ctx, withoutLimit(req), rw.Exclusion, newLookupSubjectsExclusion(filteredStream), filter.withoutLimit())
 	default:
 		return fmt.Errorf("unknown kind of rewrite in lookup subjects")
 	}
@@ -276,12 +319,13 @@ func (cl *ConcurrentLookupSubjects) lookupSetOperation(
 	req ValidatedLookupSubjectsRequest,
 	so *core.SetOperation,
 	reducer lookupSubjectsReducer,
+	filter subjectsFilter,
 ) error {
 	cancelCtx, checkCancel := context.WithCancel(ctx)
 	defer checkCancel()
 
 	g, subCtx := errgroup.WithContext(cancelCtx)
-	g.SetLimit(int(cl.concurrencyLimit))
+	g.SetLimit(int(cl.concurrencyLimiter.ConcurrencyLimit()))
 
 	for index, childOneof := range so.Child {
 		stream := reducer.ForIndex(subCtx, index)
@@ -297,12 +341,12 @@ func (cl *ConcurrentLookupSubjects) lookupSetOperation(
 
 		case *core.SetOperation_Child_UsersetRewrite:
 			g.Go(func() error {
-				return cl.lookupViaRewrite(subCtx, req, stream, child.UsersetRewrite)
+				return cl.lookupViaRewrite(subCtx, req, stream, child.UsersetRewrite, filter)
 			})
 
 		case *core.SetOperation_Child_TupleToUserset:
 			g.Go(func() error {
-				return cl.lookupViaTupleToUserset(subCtx, req, stream, child.TupleToUserset)
+				return cl.lookupViaTupleToUserset(subCtx, req, stream, child.TupleToUserset, filter)
 			})
 
 		case *core.SetOperation_Child_XNil:
@@ -328,6 +372,7 @@ func (cl *ConcurrentLookupSubjects) dispatchTo(
 	toDispatchByType *datasets.SubjectByTypeSet,
 	relationshipsBySubjectONR *mapz.MultiMap[string, *core.RelationTuple],
 	parentStream dispatch.LookupSubjectsStream,
+	filter subjectsFilter,
 ) error {
 	if toDispatchByType.IsEmpty() {
 		return nil
@@ -337,7 +382,7 @@ func (cl *ConcurrentLookupSubjects) dispatchTo(
 	defer checkCancel()
 
 	g, subCtx := errgroup.WithContext(cancelCtx)
-	g.SetLimit(int(cl.concurrencyLimit))
+	g.SetLimit(int(cl.concurrencyLimiter.ConcurrencyLimit()))
 
 	toDispatchByType.ForEachType(func(resourceType *core.RelationReference, foundSubjects datasets.SubjectSet) {
 		slice := foundSubjects.AsSlice()
@@ -410,7 +455,7 @@ func (cl *ConcurrentLookupSubjects) dispatchTo(
 				}
 
 				return &v1.DispatchLookupSubjectsResponse{
-					FoundSubjectsByResourceId: mappedFoundSubjects,
+					FoundSubjectsByResourceId: filter.apply(mappedFoundSubjects),
 					Metadata:                  addCallToResponseMetadata(result.Metadata),
 				}, true, nil
 			},
@@ -419,15 +464,7 @@ func (cl *ConcurrentLookupSubjects) dispatchTo(
 		// Dispatch the found subjects as the resources of the next step.
 		slicez.ForEachChunk(resourceIds, maxDispatchChunkSize, func(resourceIdChunk []string) {
 			g.Go(func() error {
-				return cl.d.DispatchLookupSubjects(&v1.DispatchLookupSubjectsRequest{
-					ResourceRelation: resourceType,
-					ResourceIds:      resourceIdChunk,
-					SubjectRelation:  parentRequest.SubjectRelation,
-					Metadata: &v1.ResolverMeta{
-						AtRevision:     parentRequest.Revision.String(),
-						DepthRemaining: parentRequest.Metadata.DepthRemaining - 1,
-					},
-				}, stream)
+				return cl.d.DispatchLookupSubjects(childLookupSubjectsRequest(parentRequest, resourceType, resourceIdChunk), stream)
 			})
 		})
 	})
@@ -435,6 +472,207 @@ func (cl *ConcurrentLookupSubjects) dispatchTo(
 	return g.Wait()
 }
 
+// dispatchToIntersection dispatches the found subjects of an arrow with the `all` function and, for
+// each resource, publishes only those subjects found for *every* subject of its tupleset relationships.
+func (cl *ConcurrentLookupSubjects) dispatchToIntersection(
+	ctx context.Context,
+	parentRequest ValidatedLookupSubjectsRequest,
+	toDispatchByType *datasets.SubjectByTypeSet,
+	computedRelation string,
+	relationshipsByResourceID *mapz.MultiMap[string, *core.RelationTuple],
+	parentStream dispatch.LookupSubjectsStream,
+	filter subjectsFilter,
+) error {
+	if toDispatchByType.IsEmpty() {
+		return nil
+	}
+
+	cancelCtx, checkCancel := context.WithCancel(ctx)
+	defer checkCancel()
+
+	g, subCtx := errgroup.WithContext(cancelCtx)
+	g.SetLimit(int(cl.concurrencyLimiter.ConcurrencyLimit()))
+
+	type typeCollector struct {
+		resourceType *core.RelationReference
+		collector    *dispatch.CollectingDispatchStream[*v1.DispatchLookupSubjectsResponse]
+	}
+
+	collectors := make([]typeCollector, 0, toDispatchByType.Len())
+	toDispatchByType.ForEachType(func(resourceType *core.RelationReference, foundSubjects datasets.SubjectSet) {
+		slice := foundSubjects.AsSlice()
+		resourceIds := make([]string, 0, len(slice))
+		for _, foundSubject := range slice {
+			resourceIds = append(resourceIds, foundSubject.SubjectId)
+		}
+
+		collector := dispatch.NewCollectingDispatchStream[*v1.DispatchLookupSubjectsResponse](subCtx)
+		collectors = append(collectors, typeCollector{resourceType, collector})
+
+		slicez.ForEachChunk(resourceIds, maxDispatchChunkSize, func(resourceIdChunk []string) {
+			g.Go(func() error {
+				// As for intersections, the limit is only applied once the subjects are intersected.
+				return cl.d.DispatchLookupSubjects(childLookupSubjectsRequest(withoutLimit(parentRequest), resourceType, resourceIdChunk), collector)
+			})
+		})
+	})
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// Collect the subjects found for each subject of the tupleset.
+	metadata := emptyMetadata
+	foundSubjectsBySubjectONR := make(map[string]datasets.SubjectSet)
+	for _, tc := range collectors {
+		resourceType := tc.resourceType
+		for _, result := range tc.collector.Results() {
+			metadata = combineResponseMetadata(metadata, result.Metadata)
+			for childResourceID, foundSubjects := range result.FoundSubjectsByResourceId {
+				subjectKey := tuple.StringONR(&core.ObjectAndRelation{
+					Namespace: resourceType.Namespace,
+					ObjectId:  childResourceID,
+					Relation:  resourceType.Relation,
+				})
+
+				existing, ok := foundSubjectsBySubjectONR[subjectKey]
+				if !ok {
+					existing = datasets.NewSubjectSet()
+					foundSubjectsBySubjectONR[subjectKey] = existing
+				}
+
+				if err := existing.UnionWith(foundSubjects.FoundSubjects); err != nil {
+					return fmt.Errorf("could not combine subject sets: %w", err)
+				}
+			}
+		}
+	}
+
+	// Intersect the found subjects across all the tupleset relationships of each resource.
+	mappedFoundSubjects := make(map[string]*v1.FoundSubjects)
+	for _, resourceID := range relationshipsByResourceID.Keys() {
+		relationships, _ := relationshipsByResourceID.Get(resourceID)
+		intersected, err := intersectTuplesetSubjects(relationships, computedRelation, foundSubjectsBySubjectONR)
+		if err != nil {
+			return err
+		}
+
+		if intersected != nil && !intersected.IsEmpty() {
+			mappedFoundSubjects[resourceID] = intersected.AsFoundSubjects()
+		}
+	}
+
+	mappedFoundSubjects = filter.apply(mappedFoundSubjects)
+	if len(mappedFoundSubjects) == 0 {
+		return nil
+	}
+
+	return parentStream.Publish(&v1.DispatchLookupSubjectsResponse{
+		FoundSubjectsByResourceId: mappedFoundSubjects,
+		Metadata:                  addCallToResponseMetadata(metadata),
+	})
+}
+
+// intersectTuplesetSubjects returns the subjects found for the computed relation on *every* subject
+// of the given tupleset relationships of a resource, or nil if there are none.
+//
+// A caveated tupleset relationship only applies when its caveat holds, so its subjects are only
+// required under that caveat, and at least one of the relationships must apply.
+func intersectTuplesetSubjects(
+	relationships []*core.RelationTuple,
+	computedRelation string,
+	foundSubjectsBySubjectONR map[string]datasets.SubjectSet,
+) (*datasets.SubjectSet, error) {
+	foundSubjectsFor := func(relationship *core.RelationTuple) (datasets.SubjectSet, bool) {
+		found, ok := foundSubjectsBySubjectONR[tuple.StringONR(&core.ObjectAndRelation{
+			Namespace: relationship.Subject.Namespace,
+			ObjectId:  relationship.Subject.ObjectId,
+			Relation:  computedRelation,
+		})]
+		return found, ok
+	}
+
+	// Intersect the subjects found across the uncaveated relationships, which must always apply.
+	var intersected *datasets.SubjectSet
+	caveated := make([]*core.RelationTuple, 0, len(relationships))
+	for _, relationship := range relationships {
+		if relationship.Caveat != nil {
+			caveated = append(caveated, relationship)
+			continue
+		}
+
+		found, ok := foundSubjectsFor(relationship)
+		if !ok {
+			return nil, nil
+		}
+
+		if intersected == nil {
+			cloned := found.WithParentCaveatExpression(nil)
+			intersected = &cloned
+			continue
+		}
+
+		if err := intersected.IntersectionDifference(found); err != nil {
+			return nil, err
+		}
+	}
+
+	// If all the relationships are caveated, at least one must apply, so the candidate subjects are
+	// those found on any of them, under its caveat.
+	if intersected == nil {
+		union := datasets.NewSubjectSet()
+		for _, relationship := range caveated {
+			if found, ok := foundSubjectsFor(relationship); ok {
+				if err := union.UnionWithSet(found.WithParentCaveatExpression(wrapCaveat(relationship.Caveat))); err != nil {
+					return nil, err
+				}
+			}
+		}
+		intersected = &union
+	}
+
+	// Each caveated relationship requires its subjects only when its caveat holds, so the candidates
+	// it does not cover remain under the inversion of its caveat.
+	for _, relationship := range caveated {
+		found, ok := foundSubjectsFor(relationship)
+		if !ok {
+			found = datasets.NewSubjectSet()
+		}
+
+		covered := intersected.WithParentCaveatExpression(nil)
+		if err := covered.IntersectionDifference(found); err != nil {
+			return nil, err
+		}
+
+		uncovered := intersected.WithParentCaveatExpression(nil)
+		uncovered.SubtractAll(found)
+
+		if err := covered.UnionWithSet(uncovered.WithParentCaveatExpression(caveatInvert(wrapCaveat(relationship.Caveat)))); err != nil {
+			return nil, err
+		}
+		intersected = &covered
+	}
+
+	return intersected, nil
+}
+
+// childLookupSubjectsRequest returns the request to dispatch for the given resources, carrying
+// over the subject restrictions of the parent request.
+func childLookupSubjectsRequest(parentRequest ValidatedLookupSubjectsRequest, resourceRelation *core.RelationReference, resourceIds []string) *v1.DispatchLookupSubjectsRequest {
+	return &v1.DispatchLookupSubjectsRequest{
+		ResourceRelation: resourceRelation,
+		ResourceIds:      resourceIds,
+		SubjectRelation:  parentRequest.SubjectRelation,
+		Metadata: &v1.ResolverMeta{
+			AtRevision:     parentRequest.Revision.String(),
+			DepthRemaining: parentRequest.Metadata.DepthRemaining - 1,
+		},
+		OptionalSubjectIds: parentRequest.OptionalSubjectIds,
+		OptionalCursor:     parentRequest.OptionalCursor,
+		OptionalLimit:      parentRequest.OptionalLimit,
+	}
+}
+
 func combineFoundSubjects(existing *v1.FoundSubjects, toAdd *v1.FoundSubjects) (*v1.FoundSubjects, error) {
 	if existing == nil {
 		return toAdd, nil
@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/authzed/spicedb/pkg/genutil/mapz"
+	v1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+// lookupSubjectsDispatchVersion defines the "version" of the cursors of lookup subjects. Must be
+// incremented anytime an incompatible change is made to the cursor format.
+const lookupSubjectsDispatchVersion = 1
+
+// NewLookupSubjectsCursor returns the cursor at which to resume looking up subjects, after the
+// subject with the given ID.
+func NewLookupSubjectsCursor(afterSubjectID string) *v1.Cursor {
+	return &v1.Cursor{
+		DispatchVersion: lookupSubjectsDispatchVersion,
+		Sections:        []string{afterSubjectID},
+	}
+}
+
+// subjectsFilter restricts the subjects found by a lookup subjects request to its candidate
+// subject IDs and to those after its cursor and, if it has a limit, to the first of those subjects
+// by ID.
+//
+// As the candidates and cursor restrict each subject ID independently of the others, they can be
+// applied to the subjects found at every step of the lookup without changing the results of
+// intersections and exclusions. The limit, on the other hand, can only be applied to the children
+// of unions.
+type subjectsFilter struct {
+	candidateSubjectIDs *mapz.Set[string]
+	afterSubjectID      string
+	limit               uint32
+}
+
+func newSubjectsFilter(req *v1.DispatchLookupSubjectsRequest) (subjectsFilter, error) {
+	ci, err := newCursorInformation(req.OptionalCursor, newLimitTracker(req.OptionalLimit), lookupSubjectsDispatchVersion)
+	if err != nil {
+		return subjectsFilter{}, err
+	}
+
+	afterSubjectID, _ := ci.headSectionValue()
+
+	var candidateSubjectIDs *mapz.Set[string]
+	if len(req.OptionalSubjectIds) > 0 {
+		candidateSubjectIDs = mapz.NewSet(req.OptionalSubjectIds...)
+	}
+
+	return subjectsFilter{
+		candidateSubjectIDs: candidateSubjectIDs,
+		afterSubjectID:      afterSubjectID,
+		limit:               req.OptionalLimit,
+	}, nil
+}
+
+func (sf subjectsFilter) isEmpty() bool {
+	return sf.candidateSubjectIDs == nil && sf.afterSubjectID == "" && sf.limit == 0
+}
+
+// allows returns whether the subject with the given ID is allowed by the candidates and cursor.
+func (sf subjectsFilter) allows(subjectID string) bool {
+	if subjectID == tuple.PublicWildcard {
+		return true
+	}
+
+	if sf.afterSubjectID != "" && subjectID <= sf.afterSubjectID {
+		return false
+	}
+
+	return sf.candidateSubjectIDs == nil || sf.candidateSubjectIDs.Has(subjectID)
+}
+
+// apply returns the found subjects restricted by the filter.
+func (sf subjectsFilter) apply(foundSubjectsByResourceID map[string]*v1.FoundSubjects) map[string]*v1.FoundSubjects {
+	if sf.isEmpty() {
+		return foundSubjectsByResourceID
+	}
+
+	filtered := make(map[string]*v1.FoundSubjects, len(foundSubjectsByResourceID))
+	for resourceID, foundSubjects := range foundSubjectsByResourceID {
+		subjects := make([]*v1.FoundSubject, 0, len(foundSubjects.FoundSubjects))
+		for _, foundSubject := range foundSubjects.FoundSubjects {
+			if !sf.allows(foundSubject.SubjectId) {
+				continue
+			}
+
+			if len(foundSubject.ExcludedSubjects) > 0 {
+				excludedSubjects := make([]*v1.FoundSubject, 0, len(foundSubject.ExcludedSubjects))
+				for _, excludedSubject := range foundSubject.ExcludedSubjects {
+					if sf.allows(excludedSubject.SubjectId) {
+						excludedSubjects = append(excludedSubjects, excludedSubject)
+					}
+				}
+
+				foundSubject = &v1.FoundSubject{
+					SubjectId:        foundSubject.SubjectId,
+					CaveatExpression: foundSubject.CaveatExpression,
+					ExcludedSubjects: excludedSubjects,
+				}
+			}
+
+			subjects = append(subjects, foundSubject)
+		}
+
+		subjects = sf.limited(subjects)
+		if len(subjects) > 0 {
+			filtered[resourceID] = &v1.FoundSubjects{FoundSubjects: subjects}
+		}
+	}
+
+	return filtered
+}
+
+// limited returns the subjects with the first IDs, up to the limit, along with any wildcards.
+func (sf subjectsFilter) limited(subjects []*v1.FoundSubject) []*v1.FoundSubject {
+	if sf.limit == 0 {
+		return subjects
+	}
+
+	slices.SortStableFunc(subjects, func(a, b *v1.FoundSubject) int {
+		return cmp.Compare(a.SubjectId, b.SubjectId)
+	})
+
+	limited := make([]*v1.FoundSubject, 0, len(subjects))
+	var count uint32
+	var lastSubjectID string
+	for _, subject := range subjects {
+		// The same subject can be found more than once, in which case it is only counted once.
+		if subject.SubjectId != tuple.PublicWildcard && subject.SubjectId != lastSubjectID {
+			if count == sf.limit {
+				continue
+			}
+
+			count++
+			lastSubjectID = subject.SubjectId
+		}
+
+		limited = append(limited, subject)
+	}
+
+	return limited
+}
+
+// withoutLimit returns the filter with its limit removed.
+func (sf subjectsFilter) withoutLimit() subjectsFilter {
+	sf.limit = 0
+	return sf
+}
+
+// withoutLimit returns the request with its limit removed, for the children of intersections and
+// exclusions.
+func withoutLimit(req ValidatedLookupSubjectsRequest) ValidatedLookupSubjectsRequest {
+	if req.OptionalLimit == 0 {
+		return req
+	}
+
+	cloned := req.DispatchLookupSubjectsRequest.CloneVT()
+	cloned.OptionalLimit = 0
+	return ValidatedLookupSubjectsRequest{cloned, req.Revision}
+}
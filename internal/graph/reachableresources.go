@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/authzed/spicedb/internal/dispatch"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/internal/taskrunner"
 	"github.com/authzed/spicedb/pkg/datastore"
 	"github.com/authzed/spicedb/pkg/datastore/options"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
@@ -23,15 +25,15 @@ import (
 const dispatchVersion = 1
 
 // NewCursoredReachableResources creates an instance of CursoredReachableResources.
-func NewCursoredReachableResources(d dispatch.ReachableResources, concurrencyLimit uint16) *CursoredReachableResources {
-	return &CursoredReachableResources{d, concurrencyLimit}
+func NewCursoredReachableResources(d dispatch.ReachableResources, concurrencyLimiter taskrunner.ConcurrencyLimiter) *CursoredReachableResources {
+	return &CursoredReachableResources{d, concurrencyLimiter}
 }
 
 // CursoredReachableResources exposes a method to perform ReachableResources requests, and
 // delegates subproblems to the provided dispatch.ReachableResources instance.
 type CursoredReachableResources struct {
-	d                dispatch.ReachableResources
-	concurrencyLimit uint16
+	d                  dispatch.ReachableResources
+	concurrencyLimiter taskrunner.ConcurrencyLimiter
 }
 
 // ValidatedReachableResourcesRequest represents a request after it has been validated and parsed for internal
@@ -121,7 +123,7 @@ func (crr *CursoredReachableResources) afterSameType(
 	}
 
 	// For each entrypoint, load the necessary data and re-dispatch if a subproblem was found.
-	return withParallelizedStreamingIterableInCursor(ctx, ci, entrypoints, parentStream, crr.concurrencyLimit,
+	return withParallelizedStreamingIterableInCursor(ctx, ci, entrypoints, parentStream, crr.concurrencyLimiter.ConcurrencyLimit(),
 		func(ctx context.Context, ci cursorInformation, entrypoint typesystem.ReachabilityEntrypoint, stream dispatch.ReachableResourcesStream) error {
 			switch entrypoint.EntrypointKind() {
 			case core.ReachabilityEntrypoint_RELATION_ENTRYPOINT:
@@ -199,8 +201,8 @@ func (crr *CursoredReachableResources) lookupRelationEntrypoint(
 			return err
 		}
 
-		if isWildcardAllowed == typesystem.PublicSubjectAllowed {
-			subjectIds = append(subjectIds, "*")
+		if isWildcardAllowed == typesystem.PublicSubjectAllowed && !slices.Contains(subjectIds, tuple.PublicWildcard) {
+			subjectIds = append(subjectIds, tuple.PublicWildcard)
 		}
 	}
 
@@ -231,7 +233,7 @@ func (crr *CursoredReachableResources) lookupRelationEntrypoint(
 			foundResourceType:  relationReference,
 			entrypoint:         entrypoint,
 			rg:                 rg,
-			concurrencyLimit:   crr.concurrencyLimit,
+			concurrencyLimit:   crr.concurrencyLimiter.ConcurrencyLimit(),
 			parentStream:       stream,
 			parentRequest:      req,
 			dispatched:         dispatched,
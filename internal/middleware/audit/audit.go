@@ -0,0 +1,159 @@
+// Package audit implements the audit log of the mutations made through the API. Each call
+// that changes relationships or schema emits a record naming the caller, the request, the
+// revision at which the change was made and the change itself, which is written to a sink.
+//
+// Records are emitted by the service handlers once their change has been committed, so a
+// record failing to be written cannot undo the change: such failures are logged and counted.
+package audit
+
+import (
+	"context"
+	"time"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/authzed/spicedb/internal/auth"
+	log "github.com/authzed/spicedb/internal/logging"
+	"github.com/authzed/spicedb/internal/middleware/tenant"
+	"github.com/authzed/spicedb/pkg/middleware/requestid"
+)
+
+var failedRecordsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "spicedb",
+	Subsystem: "audit",
+	Name:      "failed_records_total",
+	Help:      "Count of audit records that could not be written to the audit log sink.",
+})
+
+// Record is an entry of the audit log, describing a single mutation made through the API.
+type Record struct {
+	// Time is the time at which the mutation was committed.
+	Time time.Time `json:"time"`
+
+	// Method is the full gRPC method name of the call that made the mutation.
+	Method string `json:"method"`
+
+	// Principal identifies the authenticated caller, if any.
+	Principal string `json:"principal,omitempty"`
+
+	// Tenant is the tenant the call was scoped to, in multi-tenant mode.
+	Tenant string `json:"tenant,omitempty"`
+
+	// RequestID is the ID of the request, as found in its `x-request-id` header.
+	RequestID string `json:"request_id,omitempty"`
+
+	// ZedToken is the ZedToken of the revision at which the mutation was committed.
+	ZedToken string `json:"zedtoken"`
+
+	// RelationshipUpdates are the relationships written or deleted, if any. Calls making their
+	// updates in batches, such as bulk imports and deletions, emit a record per batch, each with
+	// the ZedToken of the whole call.
+	RelationshipUpdates []RelationshipUpdate `json:"relationship_updates,omitempty"`
+
+	// RelationshipFilter is the filter of the relationships deleted by a DeleteRelationships call.
+	RelationshipFilter *RelationshipFilter `json:"relationship_filter,omitempty"`
+
+	// SchemaDiff holds the changes made to the schema, if any.
+	SchemaDiff []DefinitionDiff `json:"schema_diff,omitempty"`
+}
+
+// Sink is the destination to which audit records are written.
+type Sink interface {
+	// Write writes a single record.
+	Write(ctx context.Context, record Record) error
+
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// Auditor emits audit records for the calls made to the API.
+type Auditor struct {
+	sink Sink
+	now  func() time.Time
+}
+
+// NewAuditor returns an auditor writing records to the given sink.
+func NewAuditor(sink Sink) *Auditor {
+	return &Auditor{sink: sink, now: time.Now}
+}
+
+// Close closes the sink of the auditor.
+func (a *Auditor) Close() error {
+	return a.sink.Close()
+}
+
+type ctxKeyType struct{}
+
+var callKey ctxKeyType = struct{}{}
+
+// auditedCall is the call whose audit records are emitted in a context.
+type auditedCall struct {
+	auditor *Auditor
+	method  string
+}
+
+func contextWithAuditor(ctx context.Context, a *Auditor, method string) context.Context {
+	return context.WithValue(ctx, callKey, &auditedCall{auditor: a, method: method})
+}
+
+// Enabled returns whether audit records emitted in the context are written anywhere, so that
+// handlers can avoid collecting the contents of records that would be discarded.
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(callKey).(*auditedCall)
+	return ok
+}
+
+// Emit fills in the caller of the record from the context and writes it to the auditor of the
+// context, if any.
+func Emit(ctx context.Context, record Record) {
+	call, ok := ctx.Value(callKey).(*auditedCall)
+	if !ok {
+		return
+	}
+
+	a := call.auditor
+	record.Time = a.now().UTC()
+	record.Method = call.method
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		record.Principal = principal.String()
+	}
+	record.Tenant = tenant.FromContext(ctx)
+	if requestIDs := metadata.ValueFromIncomingContext(ctx, requestid.MetadataKey); len(requestIDs) > 0 {
+		record.RequestID = requestIDs[0]
+	}
+
+	// The record is written even if the call has been canceled since its mutation was committed.
+	if err := a.sink.Write(context.WithoutCancel(ctx), record); err != nil {
+		failedRecordsCounter.Inc()
+		log.Ctx(ctx).Error().Err(err).Str("zedtoken", record.ZedToken).Msg("failed to write audit record")
+	}
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that emits the audit records of
+// calls to the given auditor. A nil auditor disables the audit log.
+func UnaryServerInterceptor(a *Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if a == nil {
+			return handler(ctx, req)
+		}
+		return handler(contextWithAuditor(ctx, a, info.FullMethod), req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that emits the audit records
+// of calls to the given auditor. A nil auditor disables the audit log.
+func StreamServerInterceptor(a *Auditor) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a == nil {
+			return handler(srv, stream)
+		}
+
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = contextWithAuditor(stream.Context(), a, info.FullMethod)
+		return handler(srv, wrapped)
+	}
+}
@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/authzed/spicedb/internal/auth"
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/middleware/tenant"
+	caveatdiff "github.com/authzed/spicedb/pkg/diff/caveats"
+	nsdiff "github.com/authzed/spicedb/pkg/diff/namespace"
+	"github.com/authzed/spicedb/pkg/middleware/requestid"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/schemadsl/compiler"
+	"github.com/authzed/spicedb/pkg/schemadsl/input"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+type recordingSink struct {
+	records []Record
+	err     error
+}
+
+func (s *recordingSink) Write(_ context.Context, record Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &recordingSink{}
+	auditor := NewAuditor(sink)
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	auditor.now = func() time.Time { return now }
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.MetadataKey, "somerequest"))
+	ctx = auth.ContextWithPrincipal(ctx, auth.Principal{Method: auth.MethodJWT, ID: "analytics"})
+	ctx = tenant.ContextWithTenant(ctx, "sometenant")
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		require.True(t, Enabled(ctx))
+		Emit(ctx, Record{ZedToken: "sometoken", RelationshipFilter: &RelationshipFilter{ResourceType: "document"}})
+		return nil, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: v1.PermissionsService_DeleteRelationships_FullMethodName}
+	_, err := UnaryServerInterceptor(auditor)(ctx, nil, info, handler)
+	require.NoError(t, err)
+
+	require.Equal(t, []Record{{
+		Time:               now,
+		Method:             v1.PermissionsService_DeleteRelationships_FullMethodName,
+		Principal:          "jwt:analytics",
+		Tenant:             "sometenant",
+		RequestID:          "somerequest",
+		ZedToken:           "sometoken",
+		RelationshipFilter: &RelationshipFilter{ResourceType: "document"},
+	}}, sink.records)
+
+	// Failures to write a record do not fail the call.
+	sink.err = errors.New("sink failure")
+	_, err = UnaryServerInterceptor(auditor)(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.Len(t, sink.records, 2)
+
+	// Without an auditor, records are discarded.
+	_, err = UnaryServerInterceptor(nil)(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+		require.False(t, Enabled(ctx))
+		Emit(ctx, Record{ZedToken: "othertoken"})
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, sink.records, 2)
+}
+
+func TestRelationshipUpdates(t *testing.T) {
+	updates, err := RelationshipUpdates([]*core.RelationTupleUpdate{
+		tuple.Touch(tuple.MustParse("document:doc1#viewer@user:alice")),
+		tuple.Delete(tuple.MustWithCaveat(tuple.MustParse("document:doc1#viewer@user:bob"), "somecaveat", map[string]any{"x": 1})),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []RelationshipUpdate{
+		{Operation: "TOUCH", Relationship: "document:doc1#viewer@user:alice"},
+		{Operation: "DELETE", Relationship: `document:doc1#viewer@user:bob[somecaveat:{"x":1}]`},
+	}, updates)
+
+	updates, err = CreatedRelationships([]*v1.Relationship{tuple.MustToRelationship(tuple.MustParse("document:doc1#viewer@group:eng#member"))})
+	require.NoError(t, err)
+	require.Equal(t, []RelationshipUpdate{{Operation: "CREATE", Relationship: "document:doc1#viewer@group:eng#member"}}, updates)
+}
+
+func compileDefinitions(t *testing.T, schema string) *compiler.CompiledSchema {
+	compiled, err := compiler.Compile(compiler.InputSchema{
+		Source:       input.Source("schema"),
+		SchemaString: schema,
+	}, compiler.AllowUnprefixedObjectType())
+	require.NoError(t, err)
+	return compiled
+}
+
+func TestSchemaDiff(t *testing.T) {
+	existing := compileDefinitions(t, `
+		caveat somecaveat(x int) { x == 1 }
+		definition user {}
+		definition document {
+			relation viewer: user
+		}`)
+	updated := compileDefinitions(t, `
+		caveat somecaveat(x uint) { x == 1 }
+		definition user {}
+		definition document {
+			relation viewer: user | user:*
+		}
+		definition folder {}`)
+
+	documentDiff, err := nsdiff.DiffNamespaces(existing.ObjectDefinitions[1], updated.ObjectDefinitions[1])
+	require.NoError(t, err)
+
+	folderDiff, err := nsdiff.DiffNamespaces(nil, updated.ObjectDefinitions[2])
+	require.NoError(t, err)
+
+	caveatDiff, err := caveatdiff.DiffCaveats(existing.CaveatDefinitions[0], updated.CaveatDefinitions[0])
+	require.NoError(t, err)
+
+	schemaDiff, err := SchemaDiff(
+		map[string]*nsdiff.Diff{"folder": folderDiff, "document": documentDiff},
+		map[string]*caveatdiff.Diff{"somecaveat": caveatDiff},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []DefinitionDiff{
+		{Definition: "document", Deltas: []DefinitionDelta{{Type: "relation-allowed-type-added", RelationName: "viewer", AllowedType: "user:*"}}},
+		{Definition: "folder", Deltas: []DefinitionDelta{{Type: "namespace-added"}}},
+		{Definition: "somecaveat", Caveat: true, Deltas: []DefinitionDelta{
+			{Type: "parameter-type-changed", ParameterName: "x", PreviousType: "int", CurrentType: "uint"},
+			{Type: "expression-has-changed"},
+		}},
+	}, schemaDiff)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	records := []Record{
+		{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Method: "somemethod", ZedToken: "firsttoken"},
+		{Time: time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC), Method: "somemethod", ZedToken: "secondtoken", RelationshipUpdates: []RelationshipUpdate{{Operation: "CREATE", Relationship: "document:doc1#viewer@user:alice"}}},
+	}
+	for _, record := range records {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+	require.NoError(t, sink.Close())
+
+	// Records are appended to an existing file.
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), records[0]))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var read []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		read = append(read, record)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, append(records, records[0]), read)
+}
+
+func TestDatastoreSink(t *testing.T) {
+	ds, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	sink, err := NewDatastoreSink(ds)
+	require.NoError(t, err)
+
+	record := Record{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Method: "somemethod", ZedToken: "sometoken"}
+	require.NoError(t, sink.Write(context.Background(), record))
+
+	stored, err := sink.ds.ReadAuditRecords(context.Background(), record.Time, 10)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, record.Time, stored[0].Timestamp)
+
+	var read Record
+	require.NoError(t, json.Unmarshal(stored[0].Data, &read))
+	require.Equal(t, record, read)
+}
+
+func TestSpool(t *testing.T) {
+	sink := &recordingSink{}
+	ctx := contextWithAuditor(context.Background(), NewAuditor(sink), v1.ExperimentalService_BulkImportRelationships_FullMethodName)
+
+	spool, err := NewSpool(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, spool)
+
+	// Batches appended before a reset are discarded.
+	require.NoError(t, spool.Append([]RelationshipUpdate{{Operation: "CREATE", Relationship: "document:discarded#viewer@user:alice"}}))
+	require.NoError(t, spool.Reset())
+
+	large := make([]RelationshipUpdate, 0, MaxRecordUpdates+1)
+	for i := 0; i <= MaxRecordUpdates; i++ {
+		large = append(large, RelationshipUpdate{Operation: "CREATE", Relationship: fmt.Sprintf("document:doc%d#viewer@user:alice", i)})
+	}
+	require.NoError(t, spool.Append(large))
+	require.NoError(t, spool.Append(nil))
+	require.NoError(t, spool.Append([]RelationshipUpdate{{Operation: "CREATE", Relationship: "document:last#viewer@user:bob"}}))
+
+	spool.Emit(ctx, Record{ZedToken: "sometoken"})
+	require.Len(t, sink.records, 3)
+	require.Equal(t, large[:MaxRecordUpdates], sink.records[0].RelationshipUpdates)
+	require.Equal(t, large[MaxRecordUpdates:], sink.records[1].RelationshipUpdates)
+	require.Equal(t, []RelationshipUpdate{{Operation: "CREATE", Relationship: "document:last#viewer@user:bob"}}, sink.records[2].RelationshipUpdates)
+	for _, record := range sink.records {
+		require.Equal(t, "sometoken", record.ZedToken)
+	}
+
+	path := spool.file.Name()
+	require.NoError(t, spool.Close())
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	// A spool without batches emits the record alone.
+	empty, err := NewSpool(ctx)
+	require.NoError(t, err)
+	defer empty.Close()
+	empty.Emit(ctx, Record{ZedToken: "othertoken", RelationshipFilter: &RelationshipFilter{ResourceType: "document"}})
+	require.Len(t, sink.records, 4)
+	require.Equal(t, "othertoken", sink.records[3].ZedToken)
+	require.Empty(t, sink.records[3].RelationshipUpdates)
+
+	// Without an auditor, no spool is created.
+	disabled, err := NewSpool(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, disabled)
+	require.NoError(t, disabled.Append(large))
+	disabled.Emit(context.Background(), Record{ZedToken: "othertoken"})
+	require.NoError(t, disabled.Close())
+	require.Len(t, sink.records, 4)
+}
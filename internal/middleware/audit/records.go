@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"golang.org/x/exp/maps"
+
+	caveattypes "github.com/authzed/spicedb/pkg/caveats/types"
+	caveatdiff "github.com/authzed/spicedb/pkg/diff/caveats"
+	nsdiff "github.com/authzed/spicedb/pkg/diff/namespace"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+	"github.com/authzed/spicedb/pkg/typesystem"
+)
+
+// RelationshipUpdate is a relationship written or deleted by a call.
+type RelationshipUpdate struct {
+	// Operation is the operation applied to the relationship: CREATE, TOUCH or DELETE.
+	Operation string `json:"operation"`
+
+	// Relationship is the relationship, in its string form.
+	Relationship string `json:"relationship"`
+}
+
+// RelationshipFilter is the filter of the relationships deleted by a call.
+type RelationshipFilter struct {
+	ResourceType             string `json:"resource_type,omitempty"`
+	OptionalResourceID       string `json:"optional_resource_id,omitempty"`
+	OptionalResourceIDPrefix string `json:"optional_resource_id_prefix,omitempty"`
+	OptionalRelation         string `json:"optional_relation,omitempty"`
+	OptionalSubjectType      string `json:"optional_subject_type,omitempty"`
+	OptionalSubjectID        string `json:"optional_subject_id,omitempty"`
+	OptionalSubjectRelation  string `json:"optional_subject_relation,omitempty"`
+	OptionalLimit            uint32 `json:"optional_limit,omitempty"`
+}
+
+// DefinitionDiff holds the changes made to a single object or caveat definition of the schema.
+type DefinitionDiff struct {
+	// Definition is the name of the definition.
+	Definition string `json:"definition"`
+
+	// Caveat is true if the definition is that of a caveat.
+	Caveat bool `json:"caveat,omitempty"`
+
+	// Deltas are the changes made to the definition, as computed by pkg/diff.
+	Deltas []DefinitionDelta `json:"deltas"`
+}
+
+// DefinitionDelta is a single change made to a definition of the schema.
+type DefinitionDelta struct {
+	Type          string `json:"type"`
+	RelationName  string `json:"relation_name,omitempty"`
+	AllowedType   string `json:"allowed_type,omitempty"`
+	ParameterName string `json:"parameter_name,omitempty"`
+	PreviousType  string `json:"previous_type,omitempty"`
+	CurrentType   string `json:"current_type,omitempty"`
+}
+
+// RelationshipUpdates returns the audited form of the given relationship updates.
+func RelationshipUpdates(updates []*core.RelationTupleUpdate) ([]RelationshipUpdate, error) {
+	audited := make([]RelationshipUpdate, 0, len(updates))
+	for _, update := range updates {
+		relationship, err := tuple.String(update.Tuple)
+		if err != nil {
+			return nil, err
+		}
+
+		audited = append(audited, RelationshipUpdate{
+			Operation:    update.Operation.String(),
+			Relationship: relationship,
+		})
+	}
+	return audited, nil
+}
+
+// CreatedRelationships returns the audited form of the creation of the given relationships.
+func CreatedRelationships(relationships []*v1.Relationship) ([]RelationshipUpdate, error) {
+	updates := make([]*core.RelationTupleUpdate, 0, len(relationships))
+	for _, relationship := range relationships {
+		updates = append(updates, tuple.Create(tuple.FromRelationship[*v1.ObjectReference, *v1.SubjectReference, *v1.ContextualizedCaveat](relationship)))
+	}
+	return RelationshipUpdates(updates)
+}
+
+// DeletedRelationships returns the audited form of the filter of a relationship deletion.
+func DeletedRelationships(filter *v1.RelationshipFilter, limit uint32) *RelationshipFilter {
+	return &RelationshipFilter{
+		ResourceType:             filter.GetResourceType(),
+		OptionalResourceID:       filter.GetOptionalResourceId(),
+		OptionalResourceIDPrefix: filter.GetOptionalResourceIdPrefix(),
+		OptionalRelation:         filter.GetOptionalRelation(),
+		OptionalSubjectType:      filter.GetOptionalSubjectFilter().GetSubjectType(),
+		OptionalSubjectID:        filter.GetOptionalSubjectFilter().GetOptionalSubjectId(),
+		OptionalSubjectRelation:  filter.GetOptionalSubjectFilter().GetOptionalRelation().GetRelation(),
+		OptionalLimit:            limit,
+	}
+}
+
+// SchemaDiff returns the audited form of the diffs of the object and caveat definitions changed
+// by a schema write, keyed by definition name. Definitions are ordered by name, caveats last.
+func SchemaDiff(objectDefDiffs map[string]*nsdiff.Diff, caveatDefDiffs map[string]*caveatdiff.Diff) ([]DefinitionDiff, error) {
+	audited := make([]DefinitionDiff, 0, len(objectDefDiffs)+len(caveatDefDiffs))
+	objectDefNames := maps.Keys(objectDefDiffs)
+	sort.Strings(objectDefNames)
+	for _, name := range objectDefNames {
+		deltas := objectDefDiffs[name].Deltas()
+		definitionDiff := DefinitionDiff{Definition: name, Deltas: make([]DefinitionDelta, 0, len(deltas))}
+		for _, delta := range deltas {
+			auditedDelta := DefinitionDelta{Type: string(delta.Type), RelationName: delta.RelationName}
+			if delta.AllowedType != nil {
+				auditedDelta.AllowedType = typesystem.SourceForAllowedRelation(delta.AllowedType)
+			}
+			definitionDiff.Deltas = append(definitionDiff.Deltas, auditedDelta)
+		}
+		audited = append(audited, definitionDiff)
+	}
+
+	caveatDefNames := maps.Keys(caveatDefDiffs)
+	sort.Strings(caveatDefNames)
+	for _, name := range caveatDefNames {
+		deltas := caveatDefDiffs[name].Deltas()
+		definitionDiff := DefinitionDiff{Definition: name, Caveat: true, Deltas: make([]DefinitionDelta, 0, len(deltas))}
+		for _, delta := range deltas {
+			previousType, err := caveatTypeString(delta.PreviousType)
+			if err != nil {
+				return nil, err
+			}
+
+			currentType, err := caveatTypeString(delta.CurrentType)
+			if err != nil {
+				return nil, err
+			}
+
+			definitionDiff.Deltas = append(definitionDiff.Deltas, DefinitionDelta{
+				Type:          string(delta.Type),
+				ParameterName: delta.ParameterName,
+				PreviousType:  previousType,
+				CurrentType:   currentType,
+			})
+		}
+		audited = append(audited, definitionDiff)
+	}
+	return audited, nil
+}
+
+func caveatTypeString(typeRef *core.CaveatTypeReference) (string, error) {
+	if typeRef == nil {
+		return "", nil
+	}
+
+	varType, err := caveattypes.DecodeParameterType(typeRef)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode caveat parameter type: %w", err)
+	}
+	return varType.String(), nil
+}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+// FileSink writes audit records to a file as JSON lines, one record per line.
+type FileSink struct {
+	sync.Mutex
+	file *os.File
+}
+
+// NewFileSink returns a sink appending records to the file at the given path, which is created
+// if it does not exist.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open audit log file: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Write(_ context.Context, record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("unable to encode audit record: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Each record is written with a single write, so that records are never interleaved.
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("unable to write audit record: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.file.Close()
+}
+
+// DatastoreSink writes audit records to the audit log table of a datastore.
+type DatastoreSink struct {
+	ds datastore.AuditLogDatastore
+}
+
+// NewDatastoreSink returns a sink writing records to the given datastore, which must support
+// an audit log.
+func NewDatastoreSink(ds datastore.Datastore) (*DatastoreSink, error) {
+	auditLog := datastore.UnwrapAs[datastore.AuditLogDatastore](ds)
+	if auditLog == nil {
+		return nil, fmt.Errorf("the datastore does not support an audit log")
+	}
+	return &DatastoreSink{ds: auditLog}, nil
+}
+
+func (s *DatastoreSink) Write(ctx context.Context, record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("unable to encode audit record: %w", err)
+	}
+
+	return s.ds.WriteAuditRecord(ctx, datastore.AuditRecord{
+		Timestamp: record.Time,
+		Data:      data,
+	})
+}
+
+// Close does nothing, as the datastore is closed by its owner.
+func (s *DatastoreSink) Close() error {
+	return nil
+}
+
+var (
+	_ Sink = &FileSink{}
+	_ Sink = &DatastoreSink{}
+)
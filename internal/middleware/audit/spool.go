@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/authzed/spicedb/internal/logging"
+)
+
+// MaxRecordUpdates is the maximum number of relationship updates of a record emitted through a
+// spool. Larger batches are split into several records.
+const MaxRecordUpdates = 1000
+
+// Spool holds the audited relationship updates of a call that makes them in batches within a
+// single transaction, such as a bulk import or a deletion, until the transaction commits. The
+// batches are kept in a temporary file rather than in memory, and one record is emitted per
+// batch once the transaction has committed.
+//
+// A nil spool, as returned when the audit log is disabled, discards the updates appended to it.
+type Spool struct {
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewSpool returns an empty spool backed by a new temporary file, or nil if the audit records
+// emitted in the context are not written anywhere. The spool must be closed once its records
+// have been emitted.
+func NewSpool(ctx context.Context) (*Spool, error) {
+	if !Enabled(ctx) {
+		return nil, nil
+	}
+
+	file, err := os.CreateTemp("", "spicedb-audit-*.jsonl")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create audit spool: %w", err)
+	}
+	return &Spool{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+// Append adds a batch of audited updates to the spool.
+func (s *Spool) Append(updates []RelationshipUpdate) error {
+	if s == nil {
+		return nil
+	}
+
+	for len(updates) > 0 {
+		batch := updates[:min(len(updates), MaxRecordUpdates)]
+		if err := s.encoder.Encode(batch); err != nil {
+			return fmt.Errorf("unable to write to audit spool: %w", err)
+		}
+		updates = updates[len(batch):]
+	}
+	return nil
+}
+
+// Reset discards the batches of the spool, such as when the transaction making the updates is
+// retried.
+func (s *Spool) Reset() error {
+	if s == nil {
+		return nil
+	}
+
+	if err := s.file.Truncate(0); err != nil {
+		return fmt.Errorf("unable to reset audit spool: %w", err)
+	}
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("unable to reset audit spool: %w", err)
+	}
+	return nil
+}
+
+// Emit emits a copy of the record for each batch of the spool, holding the updates of the batch,
+// or the record alone if the spool holds no batch.
+func (s *Spool) Emit(ctx context.Context, record Record) {
+	if s == nil {
+		Emit(ctx, record)
+		return
+	}
+
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		failedRecordsCounter.Inc()
+		log.Ctx(ctx).Error().Err(err).Str("zedtoken", record.ZedToken).Msg("failed to read audit spool")
+		return
+	}
+
+	decoder := json.NewDecoder(s.file)
+	emitted := false
+	for {
+		var updates []RelationshipUpdate
+		if err := decoder.Decode(&updates); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			failedRecordsCounter.Inc()
+			log.Ctx(ctx).Error().Err(err).Str("zedtoken", record.ZedToken).Msg("failed to read audit spool")
+			return
+		}
+
+		batchRecord := record
+		batchRecord.RelationshipUpdates = updates
+		Emit(ctx, batchRecord)
+		emitted = true
+	}
+
+	if !emitted {
+		Emit(ctx, record)
+	}
+}
+
+// Close removes the temporary file of the spool.
+func (s *Spool) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	if removeErr := os.Remove(s.file.Name()); removeErr != nil && err == nil {
+		err = removeErr
+	}
+	return err
+}
@@ -0,0 +1,405 @@
+// Package fairqueue implements a middleware which bounds the number of API requests processed
+// concurrently and, once the bound is reached, queues requests per tenant and serves them using
+// weighted fair queuing, so that a single tenant cannot starve the others.
+//
+// Long-lived streaming methods, such as Watch, and the health and reflection services are not
+// queued, as their requests would otherwise hold a slot for as long as they are open.
+//
+// Tenants are identified by the authenticated principal of the request, or by a request header if
+// one is configured. Requests for bulk methods, such as LookupResources or BulkCheckPermission, are
+// weighted lower than those for interactive methods, such as CheckPermission, so that interactive
+// traffic is served ahead of bulk traffic of the same tenant.
+package fairqueue
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/auth"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+)
+
+const (
+	defaultBulkWeight = 0.1
+
+	anonymousTenant = "anonymous"
+	otherTenant     = "other"
+
+	classInteractive = "interactive"
+	classBulk        = "bulk"
+
+	// maxIdleFlows is the number of flows beyond which idle flows are pruned.
+	maxIdleFlows = 1024
+)
+
+// BulkMethods are the full names of the methods whose requests are weighted as bulk traffic.
+var BulkMethods = []string{
+	v1.PermissionsService_LookupResources_FullMethodName,
+	v1.PermissionsService_LookupSubjects_FullMethodName,
+	v1.PermissionsService_CheckBulkPermissions_FullMethodName,
+	v1.ExperimentalService_BulkCheckPermission_FullMethodName,
+	v1.ExperimentalService_BulkExportRelationships_FullMethodName,
+	v1.ExperimentalService_BulkImportRelationships_FullMethodName,
+	extensionsv1.ExtensionsService_LookupPermissions_FullMethodName,
+	extensionsv1.ExtensionsService_LookupSubjects_FullMethodName,
+	extensionsv1.ExtensionsService_LookupResources_FullMethodName,
+}
+
+// LongLivedMethods are the full names of the streaming methods whose requests remain open
+// indefinitely, and so are processed without being queued.
+var LongLivedMethods = []string{
+	v1.WatchService_Watch_FullMethodName,
+	extensionsv1.ExtensionsService_Watch_FullMethodName,
+}
+
+// bypassServiceWhitelist holds the services whose requests are processed without being queued.
+var bypassServiceWhitelist = map[string]struct{}{
+	"/grpc.reflection.v1alpha.ServerReflection/": {},
+	"/grpc.reflection.v1.ServerReflection/":      {},
+	"/grpc.health.v1.Health/":                    {},
+}
+
+func isBypassed(fullMethod string) bool {
+	if slices.Contains(LongLivedMethods, fullMethod) {
+		return true
+	}
+
+	for bypass := range bypassServiceWhitelist {
+		if strings.HasPrefix(fullMethod, bypass) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	queueWaitHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "spicedb",
+		Subsystem: "fairqueue",
+		Name:      "wait_seconds",
+		Help:      "time spent by requests waiting to be processed",
+		Buckets:   []float64{.0005, .001, .005, .01, .05, .1, .5, 1, 5, 10},
+	}, []string{"tenant", "class"})
+
+	rejectedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "spicedb",
+		Subsystem: "fairqueue",
+		Name:      "rejected_total",
+		Help:      "number of requests rejected without being processed",
+	}, []string{"tenant", "reason"})
+)
+
+// Config configures the fair queuing of requests.
+type Config struct {
+	// MaxConcurrentRequests is the maximum number of requests processed concurrently, beyond
+	// which requests are queued.
+	MaxConcurrentRequests uint32
+
+	// MaxQueuedRequests is the maximum number of queued requests, beyond which requests are
+	// rejected with RESOURCE_EXHAUSTED. Zero means unlimited.
+	MaxQueuedRequests uint32
+
+	// TenantHeader is the request header identifying the tenant of a request. If empty or
+	// missing from a request, the tenant is identified by the authenticated principal of the
+	// request.
+	// As clients can set any header, it should only be used behind a trusted proxy.
+	TenantHeader string
+
+	// TenantWeights are the weights of the tenants, which default to 1. A tenant with twice
+	// the weight of another is served twice as many requests while both have queued requests.
+	TenantWeights map[string]int
+
+	// TenantRateLimits are the maximum rates of requests, per second, of the tenants, beyond
+	// which requests are rejected with RESOURCE_EXHAUSTED. Tenants without a rate limit are
+	// unlimited.
+	TenantRateLimits map[string]int
+
+	// BulkWeight is the factor applied to the weight of the tenant for requests to bulk
+	// methods. Defaults to 0.1.
+	BulkWeight float64
+}
+
+// Scheduler schedules requests using weighted fair queuing.
+type Scheduler struct {
+	config       Config
+	rateLimiters map[string]*rate.Limiter
+
+	lock        sync.Mutex
+	inFlight    uint32
+	virtualTime float64
+	sequence    uint64
+	flows       map[flowKey]float64
+	queue       waiterHeap
+}
+
+// flowKey identifies the requests of a tenant and class, whose value is the virtual finish
+// time of the last request of the flow.
+type flowKey struct {
+	tenant string
+	bulk   bool
+}
+
+// NewScheduler creates a new Scheduler.
+func NewScheduler(config Config) (*Scheduler, error) {
+	if config.MaxConcurrentRequests == 0 {
+		return nil, errors.New("fair queuing requires a positive maximum of concurrent requests")
+	}
+
+	if config.BulkWeight <= 0 {
+		config.BulkWeight = defaultBulkWeight
+	}
+
+	for tenant, weight := range config.TenantWeights {
+		if weight <= 0 {
+			return nil, errors.New("fair queuing weight of tenant `" + tenant + "` must be positive")
+		}
+	}
+
+	rateLimiters := make(map[string]*rate.Limiter, len(config.TenantRateLimits))
+	for tenant, limit := range config.TenantRateLimits {
+		if limit <= 0 {
+			return nil, errors.New("fair queuing rate limit of tenant `" + tenant + "` must be positive")
+		}
+		rateLimiters[tenant] = rate.NewLimiter(rate.Limit(limit), limit)
+	}
+
+	return &Scheduler{
+		config:       config,
+		rateLimiters: rateLimiters,
+		flows:        make(map[flowKey]float64),
+	}, nil
+}
+
+// Acquire waits until the request of the tenant can be processed, returning a function to call
+// once it has been processed. An error is returned if the context is canceled or the request is
+// rejected.
+func (s *Scheduler) Acquire(ctx context.Context, tenant string, bulk bool) (func(), error) {
+	metricsTenant := s.metricsTenant(tenant)
+	if limiter, ok := s.rateLimiters[tenant]; ok && !limiter.Allow() {
+		rejectedCounter.WithLabelValues(metricsTenant, "rate_limited").Inc()
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded; please retry later")
+	}
+
+	class := classInteractive
+	if bulk {
+		class = classBulk
+	}
+
+	waitStarted := time.Now()
+	observeWait := func() {
+		queueWaitHistogram.WithLabelValues(metricsTenant, class).Observe(time.Since(waitStarted).Seconds())
+	}
+
+	s.lock.Lock()
+	key := flowKey{tenant, bulk}
+	start := math.Max(s.virtualTime, s.flows[key])
+	w := &waiter{
+		start:  start,
+		finish: start + 1/s.weight(tenant, bulk),
+		ready:  make(chan struct{}),
+	}
+
+	if s.inFlight < s.config.MaxConcurrentRequests {
+		s.inFlight++
+		s.flows[key] = w.finish
+		s.virtualTime = w.start
+		s.lock.Unlock()
+
+		observeWait()
+		return s.release, nil
+	}
+
+	if s.config.MaxQueuedRequests > 0 && uint32(len(s.queue)) >= s.config.MaxQueuedRequests {
+		s.lock.Unlock()
+		rejectedCounter.WithLabelValues(metricsTenant, "queue_full").Inc()
+		return nil, status.Errorf(codes.ResourceExhausted, "too many requests queued; please retry later")
+	}
+
+	s.flows[key] = w.finish
+	s.sequence++
+	w.sequence = s.sequence
+	heap.Push(&s.queue, w)
+	s.lock.Unlock()
+
+	select {
+	case <-w.ready:
+		observeWait()
+		return s.release, nil
+
+	case <-ctx.Done():
+		s.lock.Lock()
+		if w.index >= 0 {
+			heap.Remove(&s.queue, w.index)
+			s.lock.Unlock()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		s.lock.Unlock()
+
+		// The request was granted concurrently with the cancelation, so hand over its slot.
+		s.release()
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// release hands over the slot of a processed request to the next queued request, if any.
+func (s *Scheduler) release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.queue) > 0 {
+		w := heap.Pop(&s.queue).(*waiter)
+		s.virtualTime = math.Max(s.virtualTime, w.start)
+		close(w.ready)
+		return
+	}
+
+	s.inFlight--
+	if len(s.flows) > maxIdleFlows {
+		for key, finish := range s.flows {
+			if finish <= s.virtualTime {
+				delete(s.flows, key)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) weight(tenant string, bulk bool) float64 {
+	weight := 1.0
+	if configured, ok := s.config.TenantWeights[tenant]; ok {
+		weight = float64(configured)
+	}
+
+	if bulk {
+		weight *= s.config.BulkWeight
+	}
+	return weight
+}
+
+// metricsTenant returns the tenant label of the metrics of the requests of the tenant. Only the
+// configured tenants and anonymous requests are labeled, to bound the cardinality of the metrics,
+// as tenants identified by a header or by a principal, such as the subject of a JWT, are
+// unbounded.
+func (s *Scheduler) metricsTenant(tenant string) string {
+	if tenant == anonymousTenant {
+		return tenant
+	}
+
+	if _, ok := s.config.TenantWeights[tenant]; ok {
+		return tenant
+	}
+	if _, ok := s.config.TenantRateLimits[tenant]; ok {
+		return tenant
+	}
+	return otherTenant
+}
+
+// tenantFromContext returns the tenant of the request with the given context.
+func (s *Scheduler) tenantFromContext(ctx context.Context) string {
+	if s.config.TenantHeader != "" {
+		if values := metadata.ValueFromIncomingContext(ctx, s.config.TenantHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return anonymousTenant
+	}
+	return principal.String()
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that schedules requests using
+// weighted fair queuing with the given scheduler. If the scheduler is nil, requests are
+// processed immediately.
+func UnaryServerInterceptor(s *Scheduler) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if s == nil || isBypassed(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		release, err := s.Acquire(ctx, s.tenantFromContext(ctx), slices.Contains(BulkMethods, info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that schedules requests using
+// weighted fair queuing with the given scheduler. If the scheduler is nil, requests are
+// processed immediately.
+func StreamServerInterceptor(s *Scheduler) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if s == nil || isBypassed(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		ctx := stream.Context()
+		release, err := s.Acquire(ctx, s.tenantFromContext(ctx), slices.Contains(BulkMethods, info.FullMethod))
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, stream)
+	}
+}
+
+type waiter struct {
+	start    float64
+	finish   float64
+	sequence uint64
+	ready    chan struct{}
+	index    int
+}
+
+// waiterHeap orders the queued requests by their virtual finish time, then by their arrival.
+type waiterHeap []*waiter
+
+func (wh waiterHeap) Len() int { return len(wh) }
+
+func (wh waiterHeap) Less(i, j int) bool {
+	if wh[i].finish != wh[j].finish {
+		return wh[i].finish < wh[j].finish
+	}
+	return wh[i].sequence < wh[j].sequence
+}
+
+func (wh waiterHeap) Swap(i, j int) {
+	wh[i], wh[j] = wh[j], wh[i]
+	wh[i].index = i
+	wh[j].index = j
+}
+
+func (wh *waiterHeap) Push(x any) {
+	w := x.(*waiter)
+	w.index = len(*wh)
+	*wh = append(*wh, w)
+}
+
+func (wh *waiterHeap) Pop() any {
+	old := *wh
+	n := len(old)
+	w := old[n-1]
+	old[n-1] = nil
+	w.index = -1
+	*wh = old[:n-1]
+	return w
+}
@@ -0,0 +1,322 @@
+package fairqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/auth"
+)
+
+type servedRequest struct {
+	tenant  string
+	release func()
+}
+
+// enqueue starts a request of the tenant which must be queued, waiting for it to be queued
+// so that the order in which requests are queued is deterministic.
+func enqueue(t *testing.T, s *Scheduler, tenant string, bulk bool, served chan<- servedRequest) {
+	s.lock.Lock()
+	queued := len(s.queue)
+	s.lock.Unlock()
+
+	go func() {
+		release, err := s.Acquire(context.Background(), tenant, bulk)
+		if err != nil {
+			panic(err)
+		}
+		served <- servedRequest{tenant, release}
+	}()
+
+	require.Eventually(t, func() bool {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		return len(s.queue) == queued+1
+	}, time.Second, time.Millisecond)
+}
+
+// serveAll releases the held request and serves the queued requests one at a time, returning
+// the tenants of the served requests in order.
+func serveAll(t *testing.T, release func(), count int, served <-chan servedRequest) []string {
+	release()
+
+	order := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		select {
+		case request := <-served:
+			order = append(order, request.tenant)
+			request.release()
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for a queued request")
+		}
+	}
+	return order
+}
+
+func TestSchedulerBoundsConcurrentRequests(t *testing.T) {
+	s, err := NewScheduler(Config{MaxConcurrentRequests: 2})
+	require.NoError(t, err)
+
+	release1, err := s.Acquire(context.Background(), "a", false)
+	require.NoError(t, err)
+
+	release2, err := s.Acquire(context.Background(), "a", false)
+	require.NoError(t, err)
+
+	served := make(chan servedRequest, 1)
+	enqueue(t, s, "a", false, served)
+
+	release2()
+	request := <-served
+	require.Equal(t, "a", request.tenant)
+
+	request.release()
+	release1()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	require.Zero(t, s.inFlight)
+}
+
+func TestSchedulerWeightedFairness(t *testing.T) {
+	s, err := NewScheduler(Config{
+		MaxConcurrentRequests: 1,
+		TenantWeights:         map[string]int{"heavy": 3},
+	})
+	require.NoError(t, err)
+
+	release, err := s.Acquire(context.Background(), "holder", false)
+	require.NoError(t, err)
+
+	served := make(chan servedRequest, 16)
+	for i := 0; i < 8; i++ {
+		enqueue(t, s, "light", false, served)
+	}
+	for i := 0; i < 8; i++ {
+		enqueue(t, s, "heavy", false, served)
+	}
+
+	order := serveAll(t, release, 16, served)
+
+	// While both tenants have queued requests, the heavy tenant is served three times as often.
+	counts := map[string]int{}
+	for _, tenant := range order[:8] {
+		counts[tenant]++
+	}
+	require.Equal(t, map[string]int{"light": 2, "heavy": 6}, counts)
+}
+
+func TestSchedulerInteractiveBeforeBulk(t *testing.T) {
+	s, err := NewScheduler(Config{MaxConcurrentRequests: 1})
+	require.NoError(t, err)
+
+	release, err := s.Acquire(context.Background(), "holder", false)
+	require.NoError(t, err)
+
+	served := make(chan servedRequest, 4)
+	for i := 0; i < 3; i++ {
+		enqueue(t, s, "bulk", true, served)
+	}
+	enqueue(t, s, "interactive", false, served)
+
+	order := serveAll(t, release, 4, served)
+	require.Equal(t, []string{"interactive", "bulk", "bulk", "bulk"}, order)
+}
+
+func TestSchedulerRateLimit(t *testing.T) {
+	s, err := NewScheduler(Config{
+		MaxConcurrentRequests: 10,
+		TenantRateLimits:      map[string]int{"limited": 1},
+	})
+	require.NoError(t, err)
+
+	release, err := s.Acquire(context.Background(), "limited", false)
+	require.NoError(t, err)
+	release()
+
+	_, err = s.Acquire(context.Background(), "limited", false)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	release, err = s.Acquire(context.Background(), "unlimited", false)
+	require.NoError(t, err)
+	release()
+}
+
+func TestSchedulerQueueFull(t *testing.T) {
+	s, err := NewScheduler(Config{MaxConcurrentRequests: 1, MaxQueuedRequests: 1})
+	require.NoError(t, err)
+
+	release, err := s.Acquire(context.Background(), "a", false)
+	require.NoError(t, err)
+
+	served := make(chan servedRequest, 1)
+	enqueue(t, s, "a", false, served)
+
+	_, err = s.Acquire(context.Background(), "b", false)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	order := serveAll(t, release, 1, served)
+	require.Equal(t, []string{"a"}, order)
+}
+
+func TestSchedulerCanceledWhileQueued(t *testing.T) {
+	s, err := NewScheduler(Config{MaxConcurrentRequests: 1})
+	require.NoError(t, err)
+
+	release, err := s.Acquire(context.Background(), "a", false)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := s.Acquire(ctx, "b", false)
+		errs <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		return len(s.queue) == 1
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	require.Equal(t, codes.Canceled, status.Code(<-errs))
+
+	release()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	require.Empty(t, s.queue)
+	require.Zero(t, s.inFlight)
+}
+
+func TestNewSchedulerValidation(t *testing.T) {
+	_, err := NewScheduler(Config{})
+	require.Error(t, err)
+
+	_, err = NewScheduler(Config{MaxConcurrentRequests: 1, TenantWeights: map[string]int{"a": 0}})
+	require.Error(t, err)
+
+	_, err = NewScheduler(Config{MaxConcurrentRequests: 1, TenantRateLimits: map[string]int{"a": -1}})
+	require.Error(t, err)
+}
+
+func TestTenantFromContext(t *testing.T) {
+	tokenScheduler, err := NewScheduler(Config{MaxConcurrentRequests: 1})
+	require.NoError(t, err)
+
+	headerScheduler, err := NewScheduler(Config{
+		MaxConcurrentRequests: 1,
+		TenantHeader:          "x-tenant",
+		TenantWeights:         map[string]int{"known": 2},
+	})
+	require.NoError(t, err)
+
+	principal := auth.Principal{Method: auth.MethodPresharedKey, ID: "1"}
+	withToken := auth.ContextWithPrincipal(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer sometoken")), principal)
+	withTokenAndHeader := auth.ContextWithPrincipal(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer sometoken", "x-tenant", "known")), principal)
+	unauthenticated := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer sometoken"))
+
+	require.Equal(t, anonymousTenant, tokenScheduler.tenantFromContext(context.Background()))
+	require.Equal(t, anonymousTenant, tokenScheduler.tenantFromContext(unauthenticated))
+	require.Equal(t, "presharedkey:1", tokenScheduler.tenantFromContext(withToken))
+	require.Equal(t, "presharedkey:1", tokenScheduler.tenantFromContext(withTokenAndHeader))
+
+	// Clients presenting different tokens for the same principal, such as JWTs, share a tenant.
+	withOtherToken := auth.ContextWithPrincipal(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer othertoken")), principal)
+	require.Equal(t, "presharedkey:1", tokenScheduler.tenantFromContext(withOtherToken))
+
+	require.Equal(t, "presharedkey:1", headerScheduler.tenantFromContext(withToken))
+	require.Equal(t, "known", headerScheduler.tenantFromContext(withTokenAndHeader))
+
+	require.Equal(t, "known", headerScheduler.metricsTenant("known"))
+	require.Equal(t, otherTenant, headerScheduler.metricsTenant("unknown"))
+	require.Equal(t, otherTenant, tokenScheduler.metricsTenant("presharedkey:1"))
+	require.Equal(t, anonymousTenant, tokenScheduler.metricsTenant(anonymousTenant))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: BulkMethods[0]}
+
+	resp, err := UnaryServerInterceptor(nil)(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+
+	s, err := NewScheduler(Config{MaxConcurrentRequests: 1})
+	require.NoError(t, err)
+
+	resp, err = UnaryServerInterceptor(s)(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	require.Zero(t, s.inFlight)
+	require.Contains(t, s.flows, flowKey{anonymousTenant, true})
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (css contextServerStream) Context() context.Context {
+	return css.ctx
+}
+
+func TestStreamServerInterceptorDoesNotQueueWatch(t *testing.T) {
+	s, err := NewScheduler(Config{MaxConcurrentRequests: 1, MaxQueuedRequests: 1})
+	require.NoError(t, err)
+
+	watchOpened := make(chan struct{})
+	closeWatch := make(chan struct{})
+	watchClosed := make(chan error, 1)
+	go func() {
+		info := &grpc.StreamServerInfo{FullMethod: v1.WatchService_Watch_FullMethodName, IsServerStream: true}
+		watchClosed <- StreamServerInterceptor(s)(nil, contextServerStream{ctx: context.Background()}, info, func(srv any, stream grpc.ServerStream) error {
+			close(watchOpened)
+			<-closeWatch
+			return nil
+		})
+	}()
+	<-watchOpened
+
+	// While the watch is open, requests are still processed.
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+	for _, method := range []string{
+		v1.PermissionsService_CheckPermission_FullMethodName,
+		"/grpc.health.v1.Health/Check",
+	} {
+		resp, err := UnaryServerInterceptor(s)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		require.NoError(t, err)
+		require.Equal(t, "ok", resp)
+	}
+
+	// Health checks are processed even while every slot is taken.
+	release, err := s.Acquire(context.Background(), anonymousTenant, false)
+	require.NoError(t, err)
+
+	resp, err := UnaryServerInterceptor(s)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	release()
+
+	close(closeWatch)
+	require.NoError(t, <-watchClosed)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	require.Zero(t, s.inFlight)
+}
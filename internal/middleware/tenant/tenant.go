@@ -0,0 +1,306 @@
+// Package tenant implements the middleware of multi-tenant mode, which scopes each request to a
+// single tenant: the schema and relationships read and written by the request, along with the
+// dispatches and cache entries it produces, are those of the tenant alone.
+//
+// The tenant of an API request is that bound to its credentials or, for credentials granted any
+// tenant, the one named in a request header. The tenant of a request is forwarded to the other
+// nodes of the cluster with the dispatches made for it.
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/auth"
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+)
+
+// DispatchTenantHeader is the metadata header carrying the tenant of dispatch requests.
+const DispatchTenantHeader = "spicedb-dispatch-tenant"
+
+// tenantIDRegex matches valid tenant IDs, which must be valid prefixes of definition names.
+var tenantIDRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{1,61}[a-z0-9]$`)
+
+// ValidateTenantID returns an error if the tenant ID is invalid.
+func ValidateTenantID(tenant string) error {
+	if !tenantIDRegex.MatchString(tenant) {
+		return fmt.Errorf("invalid tenant ID `%s`: tenant IDs must match %s", tenant, tenantIDRegex)
+	}
+	return nil
+}
+
+type ctxKeyType struct{}
+
+var tenantKey ctxKeyType = struct{}{}
+
+// ContextWithTenant returns a context scoped to the given tenant.
+func ContextWithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// FromContext returns the tenant the context is scoped to, or an empty string if it is not
+// scoped to any tenant.
+func FromContext(ctx context.Context) string {
+	if tenant, ok := ctx.Value(tenantKey).(string); ok {
+		return tenant
+	}
+	return ""
+}
+
+// AnyTenant binds credentials to any tenant, which requests made with them name in the tenant
+// header.
+const AnyTenant = "*"
+
+// Config configures how the tenant of API requests is determined.
+type Config struct {
+	// TenantHeader is the request header naming the tenant of requests made with credentials
+	// bound to AnyTenant.
+	TenantHeader string
+
+	// TokenTenants binds credentials to the tenants they grant access to. Credentials are
+	// identified by their preshared key or by the identity of their principal, such as
+	// `certificate:spiffe://example.org/app` or `jwt:someapp`. Requests made with credentials
+	// bound to a tenant are always scoped to it, while requests made with credentials bound to
+	// none are rejected. Requests are matched by the principal authenticated for them, so custom
+	// authentication functions must add it to the context with auth.ContextWithPrincipal.
+	TokenTenants map[string]string
+}
+
+// Resolver determines the tenant of API requests.
+type Resolver struct {
+	config Config
+
+	// principalTenants are the tenants bound to each credential, keyed by the identity of the
+	// principal authenticated with it.
+	principalTenants map[string]string
+}
+
+// NewResolver creates a new Resolver with the given configuration. The preshared keys are those
+// accepted by the API, whose principals are identified by their index.
+func NewResolver(config Config, presharedKeys []string) (*Resolver, error) {
+	if len(config.TokenTenants) == 0 {
+		return nil, fmt.Errorf("credentials bound to tenants are required")
+	}
+
+	for _, tenant := range config.TokenTenants {
+		if tenant == AnyTenant {
+			if config.TenantHeader == "" {
+				return nil, fmt.Errorf("a tenant header is required for credentials bound to any tenant")
+			}
+			continue
+		}
+
+		if err := ValidateTenantID(tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	principalTenants := make(map[string]string, len(config.TokenTenants))
+	for token, tenant := range config.TokenTenants {
+		if index := slices.Index(presharedKeys, token); index >= 0 {
+			token = auth.Principal{Method: auth.MethodPresharedKey, ID: strconv.Itoa(index + 1)}.String()
+		}
+		principalTenants[token] = tenant
+	}
+
+	return &Resolver{config: config, principalTenants: principalTenants}, nil
+}
+
+// boundTenant returns the tenant bound to the authenticated principal of the API request of the
+// context, if any. The bearer token of the request is never used on its own, as a client
+// authenticated otherwise could present the identity of another principal as its token.
+func (r *Resolver) boundTenant(ctx context.Context) (string, bool) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	tenant, ok := r.principalTenants[principal.String()]
+	return tenant, ok
+}
+
+// TenantFromContext returns the tenant of the API request of the context.
+func (r *Resolver) TenantFromContext(ctx context.Context) (string, error) {
+	var headerTenant string
+	if r.config.TenantHeader != "" {
+		if values := metadata.ValueFromIncomingContext(ctx, r.config.TenantHeader); len(values) > 0 {
+			headerTenant = values[0]
+		}
+	}
+
+	boundTenant, ok := r.boundTenant(ctx)
+	if !ok {
+		return "", status.Errorf(codes.PermissionDenied, "the credentials are not bound to any tenant")
+	}
+
+	if boundTenant != AnyTenant {
+		if headerTenant != "" && headerTenant != boundTenant {
+			return "", status.Errorf(codes.PermissionDenied, "the credentials do not grant access to tenant `%s`", headerTenant)
+		}
+		return boundTenant, nil
+	}
+
+	if headerTenant == "" {
+		return "", status.Errorf(codes.InvalidArgument, "a tenant is required; please specify it in the `%s` header", r.config.TenantHeader)
+	}
+
+	if err := ValidateTenantID(headerTenant); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return headerTenant, nil
+}
+
+// scopeContext scopes the context, and the datastore added to it, to the tenant.
+func scopeContext(ctx context.Context, tenant string) (context.Context, error) {
+	ds := datastoremw.FromContext(ctx)
+	if ds == nil {
+		return nil, status.Errorf(codes.Internal, "the datastore must be added to the context before scoping it to a tenant")
+	}
+
+	if err := datastoremw.SetInContext(ctx, proxy.NewTenantDatastoreProxy(ds, tenant)); err != nil {
+		return nil, err
+	}
+	return ContextWithTenant(ctx, tenant), nil
+}
+
+// bypassServiceWhitelist holds the services whose requests are not scoped to any tenant.
+var bypassServiceWhitelist = map[string]struct{}{
+	"/grpc.reflection.v1alpha.ServerReflection/": {},
+	"/grpc.reflection.v1.ServerReflection/":      {},
+	"/grpc.health.v1.Health/":                    {},
+}
+
+func isBypassed(fullMethod string) bool {
+	for bypass := range bypassServiceWhitelist {
+		if strings.HasPrefix(fullMethod, bypass) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that scopes API requests to their
+// tenant. If the resolver is nil, requests are not scoped to any tenant.
+func UnaryServerInterceptor(r *Resolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if r == nil || isBypassed(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		tenant, err := r.TenantFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		scopedCtx, err := scopeContext(ctx, tenant)
+		if err != nil {
+			return nil, err
+		}
+		return handler(scopedCtx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that scopes API requests to
+// their tenant. If the resolver is nil, requests are not scoped to any tenant.
+func StreamServerInterceptor(r *Resolver) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if r == nil || isBypassed(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		tenant, err := r.TenantFromContext(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext, err = scopeContext(wrapped.WrappedContext, tenant)
+		if err != nil {
+			return err
+		}
+		return handler(srv, wrapped)
+	}
+}
+
+// dispatchTenantFromContext returns the tenant of the dispatch request of the context, if any.
+func dispatchTenantFromContext(ctx context.Context) (string, error) {
+	values := metadata.ValueFromIncomingContext(ctx, DispatchTenantHeader)
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	if err := ValidateTenantID(values[0]); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return values[0], nil
+}
+
+// DispatchUnaryServerInterceptor returns a new unary server interceptor that scopes dispatch
+// requests to the tenant they were made for, if any.
+func DispatchUnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	tenant, err := dispatchTenantFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tenant == "" {
+		return handler(ctx, req)
+	}
+
+	scopedCtx, err := scopeContext(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return handler(scopedCtx, req)
+}
+
+// DispatchStreamServerInterceptor returns a new stream server interceptor that scopes dispatch
+// requests to the tenant they were made for, if any.
+func DispatchStreamServerInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	tenant, err := dispatchTenantFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	if tenant == "" {
+		return handler(srv, stream)
+	}
+
+	wrapped := middleware.WrapServerStream(stream)
+	wrapped.WrappedContext, err = scopeContext(wrapped.WrappedContext, tenant)
+	if err != nil {
+		return err
+	}
+	return handler(srv, wrapped)
+}
+
+// outgoingContext adds the tenant of the context, if any, to its outgoing metadata.
+func outgoingContext(ctx context.Context) context.Context {
+	if tenant := FromContext(ctx); tenant != "" {
+		return metadata.AppendToOutgoingContext(ctx, DispatchTenantHeader, tenant)
+	}
+	return ctx
+}
+
+// UnaryClientInterceptor is a unary client interceptor that forwards the tenant of dispatches
+// to the nodes they are dispatched to.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor is a stream client interceptor that forwards the tenant of dispatches
+// to the nodes they are dispatched to.
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+}
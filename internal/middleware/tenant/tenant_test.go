@@ -0,0 +1,194 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/auth"
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/pkg/datastore"
+	ns "github.com/authzed/spicedb/pkg/namespace"
+)
+
+func TestValidateTenantID(t *testing.T) {
+	require.NoError(t, ValidateTenantID("acme"))
+	require.NoError(t, ValidateTenantID("acme_corp2"))
+
+	require.Error(t, ValidateTenantID(""))
+	require.Error(t, ValidateTenantID("a"))
+	require.Error(t, ValidateTenantID("Acme"))
+	require.Error(t, ValidateTenantID("acme/corp"))
+	require.Error(t, ValidateTenantID("acme-corp"))
+}
+
+func TestNewResolverValidation(t *testing.T) {
+	_, err := NewResolver(Config{}, nil)
+	require.Error(t, err)
+
+	_, err = NewResolver(Config{TokenTenants: map[string]string{"sometoken": "Invalid"}}, nil)
+	require.Error(t, err)
+
+	_, err = NewResolver(Config{TenantHeader: "x-tenant"}, nil)
+	require.Error(t, err)
+
+	_, err = NewResolver(Config{TokenTenants: map[string]string{"sometoken": AnyTenant}}, nil)
+	require.Error(t, err)
+
+	_, err = NewResolver(Config{TenantHeader: "x-tenant", TokenTenants: map[string]string{"sometoken": AnyTenant}}, []string{"sometoken"})
+	require.NoError(t, err)
+}
+
+func incomingContext(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func TestTenantFromContext(t *testing.T) {
+	r, err := NewResolver(Config{
+		TenantHeader: "x-tenant",
+		TokenTenants: map[string]string{
+			"boundtoken":                          "bound",
+			"anytoken":                            AnyTenant,
+			"jwt:someapp":                         "bound",
+			"certificate:spiffe://example.org/ci": AnyTenant,
+		},
+	}, []string{"boundtoken", "anytoken", "othertoken"})
+	require.NoError(t, err)
+
+	withPrincipal := func(ctx context.Context, method auth.Method, id string) context.Context {
+		return auth.ContextWithPrincipal(ctx, auth.Principal{Method: method, ID: id})
+	}
+	withKey := func(index string, pairs ...string) context.Context {
+		return withPrincipal(incomingContext(pairs...), auth.MethodPresharedKey, index)
+	}
+
+	tcs := []struct {
+		name           string
+		ctx            context.Context
+		expectedTenant string
+		expectedCode   codes.Code
+	}{
+		{"bound token", withKey("1", "authorization", "bearer boundtoken"), "bound", codes.OK},
+		{"bound token with its tenant", withKey("1", "authorization", "bearer boundtoken", "x-tenant", "bound"), "bound", codes.OK},
+		{"bound token with another tenant", withKey("1", "authorization", "bearer boundtoken", "x-tenant", "other"), "", codes.PermissionDenied},
+		{"any tenant token with tenant", withKey("2", "authorization", "bearer anytoken", "x-tenant", "other"), "other", codes.OK},
+		{"any tenant token without tenant", withKey("2", "authorization", "bearer anytoken"), "", codes.InvalidArgument},
+		{"invalid tenant", withKey("2", "authorization", "bearer anytoken", "x-tenant", "Other"), "", codes.InvalidArgument},
+		{"unbound token with tenant", withKey("3", "authorization", "bearer othertoken", "x-tenant", "other"), "", codes.PermissionDenied},
+		{"unauthenticated bound token", incomingContext("authorization", "bearer boundtoken"), "", codes.PermissionDenied},
+		{"bound JWT principal", withPrincipal(incomingContext("authorization", "bearer a.b.c"), auth.MethodJWT, "someapp"), "bound", codes.OK},
+		{"bound JWT principal with another tenant", withPrincipal(incomingContext("authorization", "bearer a.b.c", "x-tenant", "other"), auth.MethodJWT, "someapp"), "", codes.PermissionDenied},
+		{"unbound JWT principal with tenant", withPrincipal(incomingContext("authorization", "bearer a.b.c", "x-tenant", "other"), auth.MethodJWT, "otherapp"), "", codes.PermissionDenied},
+		{"any tenant certificate principal with tenant", withPrincipal(incomingContext("x-tenant", "other"), auth.MethodCertificate, "spiffe://example.org/ci"), "other", codes.OK},
+		{"certificate principal presenting another identity", withPrincipal(incomingContext("authorization", "bearer certificate:spiffe://example.org/ci", "x-tenant", "other"), auth.MethodCertificate, "spiffe://example.org/other"), "", codes.PermissionDenied},
+		{"certificate principal presenting a bound identity", withPrincipal(incomingContext("authorization", "bearer jwt:someapp"), auth.MethodCertificate, "spiffe://example.org/other"), "", codes.PermissionDenied},
+		{"certificate principal presenting a preshared key", withPrincipal(incomingContext("authorization", "bearer anytoken", "x-tenant", "other"), auth.MethodCertificate, "spiffe://example.org/other"), "", codes.PermissionDenied},
+		{"no metadata", context.Background(), "", codes.PermissionDenied},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tenant, err := r.TenantFromContext(tc.ctx)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Equal(t, tc.expectedTenant, tenant)
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	_, err = db.ReadWriteTx(context.Background(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		return rwt.WriteNamespaces(ctx, ns.Namespace("acme/user"), ns.Namespace("user"))
+	})
+	require.NoError(t, err)
+
+	r, err := NewResolver(Config{TenantHeader: "x-tenant", TokenTenants: map[string]string{"sometoken": AnyTenant}}, []string{"sometoken"})
+	require.NoError(t, err)
+
+	var handledCtx context.Context
+	handler := func(ctx context.Context, req any) (any, error) {
+		handledCtx = ctx
+		return "ok", nil
+	}
+
+	ctx := datastoremw.ContextWithDatastore(incomingContext("authorization", "bearer sometoken", "x-tenant", "acme"), db)
+	ctx = auth.ContextWithPrincipal(ctx, auth.Principal{Method: auth.MethodPresharedKey, ID: "1"})
+	resp, err := UnaryServerInterceptor(r)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	require.Equal(t, "acme", FromContext(handledCtx))
+
+	// The datastore of the request only exposes the definitions of the tenant.
+	ds := datastoremw.MustFromContext(handledCtx)
+	headRevision, err := ds.HeadRevision(handledCtx)
+	require.NoError(t, err)
+
+	namespaces, err := ds.SnapshotReader(headRevision).ListAllNamespaces(handledCtx)
+	require.NoError(t, err)
+	require.Len(t, namespaces, 1)
+	require.Equal(t, "user", namespaces[0].Definition.Name)
+
+	// Health checks are not scoped, and require no tenant.
+	handledCtx = nil
+	healthCtx := datastoremw.ContextWithDatastore(context.Background(), db)
+	_, err = UnaryServerInterceptor(r)(healthCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	require.NoError(t, err)
+	require.Empty(t, FromContext(handledCtx))
+
+	// Without a resolver, requests are not scoped.
+	handledCtx = nil
+	_, err = UnaryServerInterceptor(nil)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Empty(t, FromContext(handledCtx))
+}
+
+func TestDispatchInterceptors(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	// The client interceptor forwards the tenant of the dispatch.
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	require.NoError(t, UnaryClientInterceptor(ContextWithTenant(context.Background(), "acme"), "", nil, nil, nil, invoker))
+	require.Equal(t, []string{"acme"}, outgoing.Get(DispatchTenantHeader))
+
+	outgoing = nil
+	require.NoError(t, UnaryClientInterceptor(context.Background(), "", nil, nil, nil, invoker))
+	require.Empty(t, outgoing.Get(DispatchTenantHeader))
+
+	// The server interceptor scopes the dispatch to the forwarded tenant.
+	var handledCtx context.Context
+	handler := func(ctx context.Context, req any) (any, error) {
+		handledCtx = ctx
+		return "ok", nil
+	}
+
+	ctx := datastoremw.ContextWithDatastore(incomingContext(DispatchTenantHeader, "acme"), db)
+	_, err = DispatchUnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "acme", FromContext(handledCtx))
+	require.NotEqual(t, db, datastoremw.MustFromContext(handledCtx))
+
+	ctx = datastoremw.ContextWithDatastore(context.Background(), db)
+	_, err = DispatchUnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	require.Empty(t, FromContext(handledCtx))
+	require.Equal(t, db, datastoremw.MustFromContext(handledCtx))
+
+	ctx = datastoremw.ContextWithDatastore(incomingContext(DispatchTenantHeader, "Invalid"), db)
+	_, err = DispatchUnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
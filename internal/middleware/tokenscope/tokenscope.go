@@ -0,0 +1,325 @@
+// Package tokenscope implements the middleware restricting the API requests made with a bearer
+// token to the capabilities granted to that token, such as read-only access, writes to some
+// namespaces only, or access to the Watch API alone. Scopes may also be granted to the
+// principals authenticated by client certificate or JWT, by their identity.
+//
+// Tokens and principals without a configured scope keep full access to the API.
+package tokenscope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/auth"
+	"github.com/authzed/spicedb/internal/datastore/proxy"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+)
+
+// Capability is a set of API methods that can be granted to a token.
+type Capability string
+
+const (
+	// CapabilityRead grants access to the methods reading the schema and relationships, and
+	// checking and looking up permissions.
+	CapabilityRead Capability = "read"
+
+	// CapabilityWrite grants access to the methods writing and deleting relationships,
+	// optionally restricted to the relationships of some resource types.
+	CapabilityWrite Capability = "write"
+
+	// CapabilitySchema grants access to WriteSchema.
+	CapabilitySchema Capability = "schema"
+
+	// CapabilityWatch grants access to the methods watching changes.
+	CapabilityWatch Capability = "watch"
+)
+
+// methodCapabilities maps the methods of the API to the capability they require. Methods of the
+// API not listed here are denied to scoped tokens.
+var methodCapabilities = map[string]Capability{
+	v1.PermissionsService_ReadRelationships_FullMethodName:               CapabilityRead,
+	v1.PermissionsService_CheckPermission_FullMethodName:                 CapabilityRead,
+	v1.PermissionsService_CheckBulkPermissions_FullMethodName:            CapabilityRead,
+	v1.PermissionsService_ExpandPermissionTree_FullMethodName:            CapabilityRead,
+	v1.PermissionsService_LookupResources_FullMethodName:                 CapabilityRead,
+	v1.PermissionsService_LookupSubjects_FullMethodName:                  CapabilityRead,
+	v1.ExperimentalService_BulkCheckPermission_FullMethodName:            CapabilityRead,
+	v1.ExperimentalService_BulkExportRelationships_FullMethodName:        CapabilityRead,
+	v1.SchemaService_ReadSchema_FullMethodName:                           CapabilityRead,
+	extensionsv1.ExtensionsService_ExplainCheckPermission_FullMethodName: CapabilityRead,
+	extensionsv1.ExtensionsService_LookupPermissions_FullMethodName:      CapabilityRead,
+	extensionsv1.ExtensionsService_ExpandPermissionTree_FullMethodName:   CapabilityRead,
+	extensionsv1.ExtensionsService_LookupSubjects_FullMethodName:         CapabilityRead,
+	extensionsv1.ExtensionsService_LookupResources_FullMethodName:        CapabilityRead,
+
+	v1.PermissionsService_WriteRelationships_FullMethodName:       CapabilityWrite,
+	v1.PermissionsService_DeleteRelationships_FullMethodName:      CapabilityWrite,
+	v1.ExperimentalService_BulkImportRelationships_FullMethodName: CapabilityWrite,
+
+	v1.SchemaService_WriteSchema_FullMethodName: CapabilitySchema,
+
+	v1.WatchService_Watch_FullMethodName:                CapabilityWatch,
+	extensionsv1.ExtensionsService_Watch_FullMethodName: CapabilityWatch,
+}
+
+// apiServicePrefixes are the prefixes of the methods of the API, to which scopes apply. Other
+// methods, such as those of the health and reflection services, are not restricted.
+var apiServicePrefixes = []string{"/authzed.api.", "/extensions.v1."}
+
+// Scope is the set of capabilities granted to a token.
+type Scope struct {
+	capabilities map[Capability]struct{}
+
+	// writeResourceTypes restricts writes to the relationships of these resource types, if
+	// non-empty.
+	writeResourceTypes []string
+}
+
+// ParseScope parses a scope of the form `read+write:document:folder+watch`: a list of
+// capabilities separated by `+`, where the write capability may be followed by the resource
+// types whose relationships can be written, separated by `:`.
+func ParseScope(scope string) (Scope, error) {
+	parsed := Scope{capabilities: map[Capability]struct{}{}}
+	for _, part := range strings.Split(scope, "+") {
+		name, resourceTypes, hasResourceTypes := strings.Cut(strings.TrimSpace(part), ":")
+
+		capability := Capability(name)
+		switch capability {
+		case CapabilityRead, CapabilitySchema, CapabilityWatch:
+			if hasResourceTypes {
+				return Scope{}, fmt.Errorf("invalid scope `%s`: capability `%s` cannot be restricted to resource types", scope, capability)
+			}
+		case CapabilityWrite:
+			if hasResourceTypes {
+				for _, resourceType := range strings.Split(resourceTypes, ":") {
+					if resourceType == "" {
+						return Scope{}, fmt.Errorf("invalid scope `%s`: empty resource type", scope)
+					}
+					parsed.writeResourceTypes = append(parsed.writeResourceTypes, resourceType)
+				}
+			}
+		default:
+			return Scope{}, fmt.Errorf("invalid scope `%s`: unknown capability `%s`", scope, name)
+		}
+
+		if _, ok := parsed.capabilities[capability]; ok {
+			return Scope{}, fmt.Errorf("invalid scope `%s`: capability `%s` is repeated", scope, capability)
+		}
+		parsed.capabilities[capability] = struct{}{}
+	}
+
+	sort.Strings(parsed.writeResourceTypes)
+	return parsed, nil
+}
+
+// Has returns whether the scope grants the capability.
+func (s Scope) Has(capability Capability) bool {
+	_, ok := s.capabilities[capability]
+	return ok
+}
+
+// String returns the scope in the format accepted by ParseScope.
+func (s Scope) String() string {
+	parts := make([]string, 0, len(s.capabilities))
+	for _, capability := range []Capability{CapabilityRead, CapabilityWrite, CapabilitySchema, CapabilityWatch} {
+		if !s.Has(capability) {
+			continue
+		}
+
+		part := string(capability)
+		if capability == CapabilityWrite && len(s.writeResourceTypes) > 0 {
+			part += ":" + strings.Join(s.writeResourceTypes, ":")
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "+")
+}
+
+// canWriteResourceType returns whether the scope grants writes to the relationships of the
+// resource type.
+func (s Scope) canWriteResourceType(resourceType string) bool {
+	if !s.Has(CapabilityWrite) {
+		return false
+	}
+	return len(s.writeResourceTypes) == 0 || slices.Contains(s.writeResourceTypes, resourceType)
+}
+
+// Authorizer restricts API requests to the scope of their token.
+type Authorizer struct {
+	// scopes are the scopes granted to each credential, keyed by the identity of the principal
+	// authenticated with it.
+	scopes map[string]Scope
+}
+
+// NewAuthorizer creates a new Authorizer from the scopes of tokens, in the format accepted by
+// ParseScope. The preshared keys are those configured for authentication, in order, so that
+// scopes of preshared keys apply to the principals authenticated with them.
+//
+// Scopes apply to the principal added to the context by the authentication middleware, so
+// custom authentication functions must call auth.ContextWithPrincipal for the scopes of their
+// principals to apply.
+func NewAuthorizer(tokenScopes map[string]string, presharedKeys []string) (*Authorizer, error) {
+	scopes := make(map[string]Scope, len(tokenScopes))
+	for token, scope := range tokenScopes {
+		parsed, err := ParseScope(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		if index := slices.Index(presharedKeys, token); index >= 0 {
+			token = auth.Principal{Method: auth.MethodPresharedKey, ID: strconv.Itoa(index + 1)}.String()
+		}
+		scopes[token] = parsed
+	}
+	return &Authorizer{scopes: scopes}, nil
+}
+
+// scopeFromContext returns the scope of the authenticated principal of the request of the
+// context, if any. The bearer token of the request is never used on its own, as a client
+// authenticated otherwise could present the identity of another principal as its token.
+func (a *Authorizer) scopeFromContext(ctx context.Context) (Scope, bool) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return Scope{}, false
+	}
+
+	scope, ok := a.scopes[principal.String()]
+	return scope, ok
+}
+
+// authorizeMethod returns an error if the scope does not grant access to the method.
+func authorizeMethod(scope Scope, fullMethod string) error {
+	capability, ok := methodCapabilities[fullMethod]
+	if !ok {
+		for _, prefix := range apiServicePrefixes {
+			if strings.HasPrefix(fullMethod, prefix) {
+				return status.Errorf(codes.PermissionDenied, "the token does not grant access to %s", fullMethod)
+			}
+		}
+		return nil
+	}
+
+	if !scope.Has(capability) {
+		return status.Errorf(codes.PermissionDenied, "the token does not grant the `%s` capability required by %s", capability, fullMethod)
+	}
+	return nil
+}
+
+// authorizeRequest returns an error if the scope does not grant writes to all the relationships
+// written by the request.
+func authorizeRequest(scope Scope, req any) error {
+	var resourceTypes []string
+	switch req := req.(type) {
+	case *v1.WriteRelationshipsRequest:
+		for _, update := range req.Updates {
+			resourceTypes = append(resourceTypes, update.GetRelationship().GetResource().GetObjectType())
+		}
+	case *v1.DeleteRelationshipsRequest:
+		resourceTypes = append(resourceTypes, req.GetRelationshipFilter().GetResourceType())
+	case *v1.BulkImportRelationshipsRequest:
+		for _, relationship := range req.Relationships {
+			resourceTypes = append(resourceTypes, relationship.GetResource().GetObjectType())
+		}
+	}
+
+	for _, resourceType := range resourceTypes {
+		if !scope.canWriteResourceType(resourceType) {
+			if resourceType == "" {
+				return status.Errorf(codes.PermissionDenied, "the token only grants writes to relationships of resource types %s, which must be specified", strings.Join(scope.writeResourceTypes, ", "))
+			}
+			return status.Errorf(codes.PermissionDenied, "the token does not grant writes to relationships of resource type `%s`", resourceType)
+		}
+	}
+	return nil
+}
+
+// restrictContext makes the datastore of the context readonly if the scope grants no writes.
+func restrictContext(ctx context.Context, scope Scope) error {
+	if scope.Has(CapabilityWrite) || scope.Has(CapabilitySchema) {
+		return nil
+	}
+
+	ds := datastoremw.FromContext(ctx)
+	if ds == nil {
+		return status.Errorf(codes.Internal, "the datastore must be added to the context before restricting it to the scope of the token")
+	}
+	return datastoremw.SetInContext(ctx, proxy.NewReadonlyDatastore(ds))
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that restricts API requests to
+// the scope of their token. If the authorizer is nil, requests are not restricted.
+func UnaryServerInterceptor(a *Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if a == nil {
+			return handler(ctx, req)
+		}
+
+		scope, ok := a.scopeFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := authorizeMethod(scope, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		if err := authorizeRequest(scope, req); err != nil {
+			return nil, err
+		}
+
+		if err := restrictContext(ctx, scope); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that restricts API requests
+// to the scope of their token. If the authorizer is nil, requests are not restricted.
+func StreamServerInterceptor(a *Authorizer) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a == nil {
+			return handler(srv, stream)
+		}
+
+		scope, ok := a.scopeFromContext(stream.Context())
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		if err := authorizeMethod(scope, info.FullMethod); err != nil {
+			return err
+		}
+
+		wrapped := middleware.WrapServerStream(stream)
+		if err := restrictContext(wrapped.WrappedContext, scope); err != nil {
+			return err
+		}
+		return handler(srv, &scopedServerStream{wrapped, scope})
+	}
+}
+
+// scopedServerStream checks that the messages received by a stream only write relationships
+// the scope grants writes to.
+type scopedServerStream struct {
+	*middleware.WrappedServerStream
+	scope Scope
+}
+
+func (s *scopedServerStream) RecvMsg(m any) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizeRequest(s.scope, m)
+}
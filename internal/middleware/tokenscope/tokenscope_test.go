@@ -0,0 +1,271 @@
+package tokenscope
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/auth"
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/pkg/datastore"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+)
+
+func TestParseScope(t *testing.T) {
+	tcs := []struct {
+		scope    string
+		expected string
+		valid    bool
+	}{
+		{"read", "read", true},
+		{"watch+read", "read+watch", true},
+		{"read+write:folder:document+schema", "read+write:document:folder+schema", true},
+		{"write", "write", true},
+		{"", "", false},
+		{"admin", "", false},
+		{"read+read", "", false},
+		{"read:document", "", false},
+		{"write:document::folder", "", false},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.scope, func(t *testing.T) {
+			scope, err := ParseScope(tc.scope)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, scope.String())
+		})
+	}
+}
+
+// presharedKeys are the preshared keys configured in the tests, in order.
+var presharedKeys = []string{"readtoken", "writetoken", "watchtoken", "schematoken", "othertoken"}
+
+// contextWithToken returns the context of a request authenticated with the preshared key.
+func contextWithToken(token string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+token))
+	return auth.ContextWithPrincipal(ctx, auth.Principal{Method: auth.MethodPresharedKey, ID: strconv.Itoa(slices.Index(presharedKeys, token) + 1)})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	a, err := NewAuthorizer(map[string]string{
+		"readtoken":   "read",
+		"writetoken":  "read+write:document",
+		"watchtoken":  "watch",
+		"schematoken": "schema",
+	}, presharedKeys)
+	require.NoError(t, err)
+
+	var handledCtx context.Context
+	handler := func(ctx context.Context, req any) (any, error) {
+		handledCtx = ctx
+		return "ok", nil
+	}
+
+	writeDocument := &v1.WriteRelationshipsRequest{Updates: []*v1.RelationshipUpdate{{
+		Operation: v1.RelationshipUpdate_OPERATION_TOUCH,
+		Relationship: &v1.Relationship{
+			Resource: &v1.ObjectReference{ObjectType: "document", ObjectId: "doc1"},
+			Relation: "viewer",
+			Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: "user", ObjectId: "alice"}},
+		},
+	}}}
+	writeFolder := &v1.WriteRelationshipsRequest{Updates: []*v1.RelationshipUpdate{{
+		Operation: v1.RelationshipUpdate_OPERATION_TOUCH,
+		Relationship: &v1.Relationship{
+			Resource: &v1.ObjectReference{ObjectType: "folder", ObjectId: "folder1"},
+			Relation: "viewer",
+			Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: "user", ObjectId: "alice"}},
+		},
+	}}}
+
+	tcs := []struct {
+		name         string
+		token        string
+		method       string
+		req          any
+		expectedCode codes.Code
+	}{
+		{"unscoped token writes schema", "othertoken", v1.SchemaService_WriteSchema_FullMethodName, nil, codes.OK},
+		{"read token checks", "readtoken", v1.PermissionsService_CheckPermission_FullMethodName, nil, codes.OK},
+		{"read token reads schema", "readtoken", v1.SchemaService_ReadSchema_FullMethodName, nil, codes.OK},
+		{"read token writes", "readtoken", v1.PermissionsService_WriteRelationships_FullMethodName, writeDocument, codes.PermissionDenied},
+		{"read token writes schema", "readtoken", v1.SchemaService_WriteSchema_FullMethodName, nil, codes.PermissionDenied},
+		{"read token explains", "readtoken", extensionsv1.ExtensionsService_ExplainCheckPermission_FullMethodName, nil, codes.OK},
+		{"read token calls unknown method", "readtoken", "/authzed.api.v1.PermissionsService/Unknown", nil, codes.PermissionDenied},
+		{"read token checks health", "readtoken", "/grpc.health.v1.Health/Check", nil, codes.OK},
+		{"write token writes allowed type", "writetoken", v1.PermissionsService_WriteRelationships_FullMethodName, writeDocument, codes.OK},
+		{"write token writes other type", "writetoken", v1.PermissionsService_WriteRelationships_FullMethodName, writeFolder, codes.PermissionDenied},
+		{"write token deletes allowed type", "writetoken", v1.PermissionsService_DeleteRelationships_FullMethodName, &v1.DeleteRelationshipsRequest{RelationshipFilter: &v1.RelationshipFilter{ResourceType: "document"}}, codes.OK},
+		{"write token deletes any type", "writetoken", v1.PermissionsService_DeleteRelationships_FullMethodName, &v1.DeleteRelationshipsRequest{RelationshipFilter: &v1.RelationshipFilter{}}, codes.PermissionDenied},
+		{"watch token checks", "watchtoken", v1.PermissionsService_CheckPermission_FullMethodName, nil, codes.PermissionDenied},
+		{"schema token writes schema", "schematoken", v1.SchemaService_WriteSchema_FullMethodName, nil, codes.OK},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			handledCtx = nil
+			ctx := datastoremw.ContextWithDatastore(contextWithToken(tc.token), db)
+			_, err := UnaryServerInterceptor(a)(ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			if err != nil {
+				require.Nil(t, handledCtx)
+			}
+		})
+	}
+
+	// Tokens granted no writes get a readonly datastore.
+	ctx := datastoremw.ContextWithDatastore(contextWithToken("readtoken"), db)
+	_, err = UnaryServerInterceptor(a)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: v1.PermissionsService_CheckPermission_FullMethodName}, handler)
+	require.NoError(t, err)
+
+	_, err = datastoremw.MustFromContext(handledCtx).ReadWriteTx(handledCtx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		return nil
+	})
+	require.ErrorAs(t, err, &datastore.ErrReadOnly{})
+
+	// Without an authorizer, requests are not restricted.
+	_, err = UnaryServerInterceptor(nil)(contextWithToken("watchtoken"), nil, &grpc.UnaryServerInfo{FullMethod: v1.SchemaService_WriteSchema_FullMethodName}, handler)
+	require.NoError(t, err)
+}
+
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*v1.BulkImportRelationshipsRequest
+}
+
+func (s *importStream) Context() context.Context { return s.ctx }
+
+func (s *importStream) RecvMsg(m any) error {
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	m.(*v1.BulkImportRelationshipsRequest).Relationships = request.Relationships
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	a, err := NewAuthorizer(map[string]string{"writetoken": "write:document"}, presharedKeys)
+	require.NoError(t, err)
+
+	relationship := func(resourceType string) *v1.Relationship {
+		return &v1.Relationship{
+			Resource: &v1.ObjectReference{ObjectType: resourceType, ObjectId: "someid"},
+			Relation: "viewer",
+			Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: "user", ObjectId: "alice"}},
+		}
+	}
+
+	stream := &importStream{
+		ctx: datastoremw.ContextWithDatastore(contextWithToken("writetoken"), db),
+		requests: []*v1.BulkImportRelationshipsRequest{
+			{Relationships: []*v1.Relationship{relationship("document")}},
+			{Relationships: []*v1.Relationship{relationship("document"), relationship("folder")}},
+		},
+	}
+
+	var recvErrs []error
+	handler := func(srv any, stream grpc.ServerStream) error {
+		for i := 0; i < 2; i++ {
+			recvErrs = append(recvErrs, stream.RecvMsg(&v1.BulkImportRelationshipsRequest{}))
+		}
+		return nil
+	}
+
+	info := &grpc.StreamServerInfo{FullMethod: v1.ExperimentalService_BulkImportRelationships_FullMethodName}
+	require.NoError(t, StreamServerInterceptor(a)(nil, stream, info, handler))
+	require.NoError(t, recvErrs[0])
+	require.Equal(t, codes.PermissionDenied, status.Code(recvErrs[1]))
+
+	info = &grpc.StreamServerInfo{FullMethod: v1.WatchService_Watch_FullMethodName}
+	err = StreamServerInterceptor(a)(nil, middleware.WrapServerStream(stream), info, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestPrincipalScopes(t *testing.T) {
+	a, err := NewAuthorizer(map[string]string{"jwt:analytics": "read"}, nil)
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	analyticsCtx := datastoremw.ContextWithDatastore(auth.ContextWithPrincipal(context.Background(), auth.Principal{Method: auth.MethodJWT, ID: "analytics"}), db)
+	adminCtx := datastoremw.ContextWithDatastore(auth.ContextWithPrincipal(context.Background(), auth.Principal{Method: auth.MethodJWT, ID: "admin"}), db)
+
+	_, err = UnaryServerInterceptor(a)(analyticsCtx, nil, &grpc.UnaryServerInfo{FullMethod: v1.PermissionsService_CheckPermission_FullMethodName}, handler)
+	require.NoError(t, err)
+
+	_, err = UnaryServerInterceptor(a)(analyticsCtx, nil, &grpc.UnaryServerInfo{FullMethod: v1.SchemaService_WriteSchema_FullMethodName}, handler)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = UnaryServerInterceptor(a)(adminCtx, nil, &grpc.UnaryServerInfo{FullMethod: v1.SchemaService_WriteSchema_FullMethodName}, handler)
+	require.NoError(t, err)
+}
+
+func TestScopesIgnoreSpoofedTokens(t *testing.T) {
+	a, err := NewAuthorizer(map[string]string{
+		"readtoken":                           "read",
+		"jwt:analytics":                       "read",
+		"jwt:admin":                           "read+write+schema",
+		"certificate:spiffe://example.org/ci": "schema",
+	}, presharedKeys)
+	require.NoError(t, err)
+
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	db, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(t, err)
+
+	withPrincipal := func(token string, method auth.Method, id string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+token))
+		return datastoremw.ContextWithDatastore(auth.ContextWithPrincipal(ctx, auth.Principal{Method: method, ID: id}), db)
+	}
+
+	tcs := []struct {
+		name         string
+		ctx          context.Context
+		expectedCode codes.Code
+	}{
+		{"read principal presenting a broader identity", withPrincipal("jwt:admin", auth.MethodJWT, "analytics"), codes.PermissionDenied},
+		{"certificate principal presenting a broader identity", withPrincipal("jwt:admin", auth.MethodCertificate, "spiffe://example.org/ci"), codes.PermissionDenied},
+		{"read principal presenting an unscoped preshared key", withPrincipal("othertoken", auth.MethodJWT, "analytics"), codes.PermissionDenied},
+		{"unscoped preshared key presenting a scoped identity", withPrincipal("jwt:analytics", auth.MethodPresharedKey, "5"), codes.OK},
+		{"admin principal", withPrincipal("a.b.c", auth.MethodJWT, "admin"), codes.OK},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnaryServerInterceptor(a)(tc.ctx, nil, &grpc.UnaryServerInfo{FullMethod: v1.PermissionsService_WriteRelationships_FullMethodName}, handler)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package usagemetrics
 import (
 	"context"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/authzed/authzed-go/pkg/responsemeta"
@@ -12,6 +13,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc"
 
+	"github.com/authzed/spicedb/internal/auth"
 	log "github.com/authzed/spicedb/internal/logging"
 	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 )
@@ -31,8 +33,54 @@ var (
 		Help:      "Histogram of cluster dispatches performed by the instance.",
 		Buckets:   []float64{1, 5, 10, 25, 50, 100, 250},
 	}, DispatchedCountLabels)
+
+	// PrincipalDispatchedCounter is the metric that SpiceDB uses to keep track
+	// of the number of downstream dispatches performed for each authenticated
+	// principal, as identified by client certificate, JWT or preshared key index.
+	// Principals authenticated by client certificate or JWT are only labeled
+	// when set with SetLabeledPrincipals, and are otherwise counted as `other`.
+	PrincipalDispatchedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "spicedb",
+		Subsystem: "services",
+		Name:      "principal_dispatches_total",
+		Help:      "Count of cluster dispatches performed by the instance for each authenticated principal.",
+	}, []string{"principal", "cached"})
 )
 
+const otherPrincipal = "other"
+
+var labeledPrincipals atomic.Pointer[map[string]struct{}]
+
+// SetLabeledPrincipals sets the identities of the principals authenticated by
+// client certificate or JWT, as returned by auth.Principal.String, whose
+// dispatches are counted under their own label by PrincipalDispatchedCounter.
+// Principals authenticated by preshared key are always labeled, as they are
+// bounded by the configured keys.
+func SetLabeledPrincipals(identities []string) {
+	principals := make(map[string]struct{}, len(identities))
+	for _, identity := range identities {
+		principals[identity] = struct{}{}
+	}
+	labeledPrincipals.Store(&principals)
+}
+
+// metricsPrincipal returns the principal label of the metrics of the requests
+// of the principal. Only principals authenticated by preshared key and the
+// labeled principals are labeled, to bound the cardinality of the metrics, as
+// principals authenticated by client certificate or JWT are unbounded.
+func metricsPrincipal(principal auth.Principal) string {
+	if principal.Method == auth.MethodPresharedKey {
+		return principal.String()
+	}
+
+	if principals := labeledPrincipals.Load(); principals != nil {
+		if _, ok := (*principals)[principal.String()]; ok {
+			return principal.String()
+		}
+	}
+	return otherPrincipal
+}
+
 type reporter struct{}
 
 func (r *reporter) ServerReporter(ctx context.Context, callMeta interceptors.CallMeta) (interceptors.Reporter, context.Context) {
@@ -82,6 +130,12 @@ func annotateAndReportForMetadata(ctx context.Context, methodName string, metada
 	DispatchedCountHistogram.WithLabelValues(methodName, "false").Observe(float64(metadata.DispatchCount))
 	DispatchedCountHistogram.WithLabelValues(methodName, "true").Observe(float64(metadata.CachedDispatchCount))
 
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		label := metricsPrincipal(principal)
+		PrincipalDispatchedCounter.WithLabelValues(label, "false").Add(float64(metadata.DispatchCount))
+		PrincipalDispatchedCounter.WithLabelValues(label, "true").Add(float64(metadata.CachedDispatchCount))
+	}
+
 	return responsemeta.SetResponseTrailerMetadata(ctx, map[responsemeta.ResponseMetadataTrailerKey]string{
 		responsemeta.DispatchedOperationsCount: strconv.Itoa(int(metadata.DispatchCount)),
 		responsemeta.CachedOperationsCount:     strconv.Itoa(int(metadata.CachedDispatchCount)),
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/authzed/spicedb/internal/auth"
 	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 )
 
@@ -132,3 +133,14 @@ func (s *metricsMiddlewareTestSuite) TestTrailers_Stream() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), 1, cachedCount)
 }
+
+func TestMetricsPrincipal(t *testing.T) {
+	SetLabeledPrincipals([]string{"jwt:alice", "certificate:spiffe://example.org/service"})
+	t.Cleanup(func() { SetLabeledPrincipals(nil) })
+
+	require.Equal(t, "presharedkey:2", metricsPrincipal(auth.Principal{Method: auth.MethodPresharedKey, ID: "2"}))
+	require.Equal(t, "jwt:alice", metricsPrincipal(auth.Principal{Method: auth.MethodJWT, ID: "alice"}))
+	require.Equal(t, "certificate:spiffe://example.org/service", metricsPrincipal(auth.Principal{Method: auth.MethodCertificate, ID: "spiffe://example.org/service"}))
+	require.Equal(t, "other", metricsPrincipal(auth.Principal{Method: auth.MethodJWT, ID: "bob"}))
+	require.Equal(t, "other", metricsPrincipal(auth.Principal{Method: auth.MethodCertificate, ID: "spiffe://example.org/other"}))
+}
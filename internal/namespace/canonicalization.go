@@ -148,7 +148,7 @@ func convertToBdd(relation *core.Relation, bdd *rudd.BDD, so *core.SetOperation,
 			values = append(values, node)
 
 		case *core.SetOperation_Child_TupleToUserset:
-			arrowIndex, err := varMap.GetArrow(child.TupleToUserset.Tupleset.Relation, child.TupleToUserset.ComputedUserset.Relation)
+			arrowIndex, err := varMap.GetArrow(child.TupleToUserset)
 			if err != nil {
 				return nil, err
 			}
@@ -170,8 +170,8 @@ type bddVarMap struct {
 	varMap   map[string]int
 }
 
-func (bvm bddVarMap) GetArrow(tuplesetName string, relName string) (int, error) {
-	key := tuplesetName + "->" + relName
+func (bvm bddVarMap) GetArrow(ttu *core.TupleToUserset) (int, error) {
+	key := arrowKey(ttu)
 	index, ok := bvm.varMap[key]
 	if !ok {
 		return -1, spiceerrors.MustBugf("missing arrow key %s in varMap", key)
@@ -179,6 +179,15 @@ func (bvm bddVarMap) GetArrow(tuplesetName string, relName string) (int, error)
 	return index, nil
 }
 
+// arrowKey returns the variable key for an arrow. Arrows with the `any` function are equivalent to
+// the plain `->` arrow, while those with the `all` function must be distinguished from them.
+func arrowKey(ttu *core.TupleToUserset) string {
+	if ttu.Function == core.TupleToUserset_FUNCTION_ALL {
+		return ttu.Tupleset.Relation + ".all(" + ttu.ComputedUserset.Relation + ")"
+	}
+	return ttu.Tupleset.Relation + "->" + ttu.ComputedUserset.Relation
+}
+
 func (bvm bddVarMap) Nil() int {
 	return len(bvm.varMap)
 }
@@ -216,7 +225,7 @@ func buildBddVarMap(relations []*core.Relation, aliasMap map[string]string) (bdd
 		_, err := graph.WalkRewrite(rewrite, func(childOneof *core.SetOperation_Child) interface{} {
 			switch child := childOneof.ChildType.(type) {
 			case *core.SetOperation_Child_TupleToUserset:
-				key := child.TupleToUserset.Tupleset.Relation + "->" + child.TupleToUserset.ComputedUserset.Relation
+				key := arrowKey(child.TupleToUserset)
 				if _, ok := varMap[key]; !ok {
 					varMap[key] = len(varMap)
 				}
@@ -502,6 +502,24 @@ func TestCanonicalizationComparison(t *testing.T) {
 			"(owner & nil) & editor",
 			true,
 		},
+		{
+			"any arrow equivalent to arrow",
+			"viewer.any(owner)",
+			"viewer->owner",
+			true,
+		},
+		{
+			"all arrow different from arrow",
+			"viewer.all(owner)",
+			"viewer->owner",
+			false,
+		},
+		{
+			"all arrow union associativity",
+			"viewer.all(owner) + editor",
+			"editor + viewer.all(owner)",
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -83,6 +83,36 @@ func ValidateRelationshipsForCreateOrTouch(
 	return nil
 }
 
+// ValidateEachRelationshipForCreateOrTouch performs validation on each of the given relationships to
+// be written, returning the validation error of each relationship, or nil for those which are
+// valid. An error is only returned if the namespaces and caveats could not be loaded.
+//
+// NOTE: This method *cannot* be used for relationships that will be deleted.
+func ValidateEachRelationshipForCreateOrTouch(
+	ctx context.Context,
+	reader datastore.Reader,
+	rels []*core.RelationTuple,
+) ([]error, error) {
+	// Load namespaces and caveats.
+	referencedNamespaceMap, referencedCaveatMap, err := loadNamespacesAndCaveats(ctx, rels, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate each relationship's types.
+	errs := make([]error, len(rels))
+	for index, rel := range rels {
+		errs[index] = ValidateOneRelationship(
+			referencedNamespaceMap,
+			referencedCaveatMap,
+			rel,
+			ValidateRelationshipForCreateOrTouch,
+		)
+	}
+
+	return errs, nil
+}
+
 func loadNamespacesAndCaveats(ctx context.Context, rels []*core.RelationTuple, reader datastore.Reader) (map[string]*typesystem.TypeSystem, map[string]*core.CaveatDefinition, error) {
 	referencedNamespaceNames := mapz.NewSet[string]()
 	referencedCaveatNamesWithContext := mapz.NewSet[string]()
@@ -44,12 +44,12 @@ func NewDispatchServer(localDispatch dispatch.Dispatcher) dispatchv1.DispatchSer
 }
 
 func (ds *dispatchServer) DispatchCheck(ctx context.Context, req *dispatchv1.DispatchCheckRequest) (*dispatchv1.DispatchCheckResponse, error) {
-	resp, err := ds.localDispatch.DispatchCheck(ctx, req)
+	resp, err := ds.localDispatch.DispatchCheck(dispatch.IncomingAdmittedContext(ctx), req)
 	return resp, rewriteGraphError(ctx, err)
 }
 
 func (ds *dispatchServer) DispatchExpand(ctx context.Context, req *dispatchv1.DispatchExpandRequest) (*dispatchv1.DispatchExpandResponse, error) {
-	resp, err := ds.localDispatch.DispatchExpand(ctx, req)
+	resp, err := ds.localDispatch.DispatchExpand(dispatch.IncomingAdmittedContext(ctx), req)
 	return resp, rewriteGraphError(ctx, err)
 }
 
@@ -57,24 +57,30 @@ func (ds *dispatchServer) DispatchReachableResources(
 	req *dispatchv1.DispatchReachableResourcesRequest,
 	resp dispatchv1.DispatchService_DispatchReachableResourcesServer,
 ) error {
-	return ds.localDispatch.DispatchReachableResources(req,
-		dispatch.WrapGRPCStream[*dispatchv1.DispatchReachableResourcesResponse](resp))
+	return ds.localDispatch.DispatchReachableResources(req, incomingAdmittedStream(
+		dispatch.WrapGRPCStream[*dispatchv1.DispatchReachableResourcesResponse](resp)))
 }
 
 func (ds *dispatchServer) DispatchLookupResources(
 	req *dispatchv1.DispatchLookupResourcesRequest,
 	resp dispatchv1.DispatchService_DispatchLookupResourcesServer,
 ) error {
-	return ds.localDispatch.DispatchLookupResources(req,
-		dispatch.WrapGRPCStream[*dispatchv1.DispatchLookupResourcesResponse](resp))
+	return ds.localDispatch.DispatchLookupResources(req, incomingAdmittedStream(
+		dispatch.WrapGRPCStream[*dispatchv1.DispatchLookupResourcesResponse](resp)))
 }
 
 func (ds *dispatchServer) DispatchLookupSubjects(
 	req *dispatchv1.DispatchLookupSubjectsRequest,
 	resp dispatchv1.DispatchService_DispatchLookupSubjectsServer,
 ) error {
-	return ds.localDispatch.DispatchLookupSubjects(req,
-		dispatch.WrapGRPCStream[*dispatchv1.DispatchLookupSubjectsResponse](resp))
+	return ds.localDispatch.DispatchLookupSubjects(req, incomingAdmittedStream(
+		dispatch.WrapGRPCStream[*dispatchv1.DispatchLookupSubjectsResponse](resp)))
+}
+
+// incomingAdmittedStream returns the stream with its context marked as that of an admitted
+// dispatch, if the request was made for the subproblem of one.
+func incomingAdmittedStream[T any](stream dispatch.Stream[T]) dispatch.Stream[T] {
+	return dispatch.StreamWithContext(dispatch.IncomingAdmittedContext(stream.Context()), stream)
 }
 
 func (ds *dispatchServer) Close() error {
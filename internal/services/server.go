@@ -12,6 +12,7 @@ import (
 	"github.com/authzed/spicedb/internal/dispatch"
 	"github.com/authzed/spicedb/internal/services/health"
 	v1svc "github.com/authzed/spicedb/internal/services/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
 )
 
 // SchemaServiceOption defines the options for enabling or disabling the V1 Schema service.
@@ -67,6 +68,12 @@ func RegisterGrpcServices(
 		healthManager.RegisterReportedService(v1.WatchService_ServiceDesc.ServiceName)
 	}
 
+	extensionsv1.RegisterExtensionsServiceServer(srv, v1svc.NewExtensionsServer(dispatch, permSysConfig, v1svc.ExtensionsServerConfig{
+		WatchEnabled:           watchServiceOption == WatchServiceEnabled,
+		WatchHeartbeatDuration: watchHeartbeatDuration,
+	}))
+	healthManager.RegisterReportedService(extensionsv1.ExtensionsService_ServiceDesc.ServiceName)
+
 	if schemaServiceOption == V1SchemaServiceEnabled || schemaServiceOption == V1SchemaServiceAdditiveOnly {
 		v1.RegisterSchemaServiceServer(srv, v1svc.NewSchemaServer(schemaServiceOption == V1SchemaServiceAdditiveOnly))
 		healthManager.RegisterReportedService(v1.SchemaService_ServiceDesc.ServiceName)
@@ -88,6 +88,14 @@ type AppliedSchemaChanges struct {
 
 	// RemovedCaveatDefNames contains the names of the removed caveat definitions.
 	RemovedCaveatDefNames []string
+
+	// ObjectDefDiffs contains the diffs of the added, changed and removed object definitions,
+	// keyed by definition name.
+	ObjectDefDiffs map[string]*nsdiff.Diff
+
+	// CaveatDefDiffs contains the diffs of the added, changed and removed caveat definitions,
+	// keyed by caveat name.
+	CaveatDefDiffs map[string]*caveatdiff.Diff
 }
 
 // ApplySchemaChanges applies schema changes found in the validated changes struct, via the specified
@@ -126,6 +134,7 @@ func ApplySchemaChangesOverExisting(
 
 	// For each caveat definition, perform a diff and ensure the changes will not result in type errors.
 	caveatDefsWithChanges := make([]*core.CaveatDefinition, 0, len(validated.compiled.CaveatDefinitions))
+	caveatDefDiffs := make(map[string]*caveatdiff.Diff, len(validated.compiled.CaveatDefinitions))
 	for _, caveatDef := range validated.compiled.CaveatDefinitions {
 		diff, err := sanityCheckCaveatChanges(ctx, rwt, caveatDef, existingCaveatDefMap)
 		if err != nil {
@@ -134,6 +143,7 @@ func ApplySchemaChangesOverExisting(
 
 		if len(diff.Deltas()) > 0 {
 			caveatDefsWithChanges = append(caveatDefsWithChanges, caveatDef)
+			caveatDefDiffs[caveatDef.Name] = diff
 		}
 	}
 
@@ -150,6 +160,7 @@ func ApplySchemaChangesOverExisting(
 	// For each definition, perform a diff and ensure the changes will not result in any
 	// breaking changes.
 	objectDefsWithChanges := make([]*core.NamespaceDefinition, 0, len(validated.compiled.ObjectDefinitions))
+	objectDefDiffs := make(map[string]*nsdiff.Diff, len(validated.compiled.ObjectDefinitions))
 	for _, nsdef := range validated.compiled.ObjectDefinitions {
 		diff, err := sanityCheckNamespaceChanges(ctx, rwt, nsdef, existingObjectDefMap)
 		if err != nil {
@@ -158,6 +169,7 @@ func ApplySchemaChangesOverExisting(
 
 		if len(diff.Deltas()) > 0 {
 			objectDefsWithChanges = append(objectDefsWithChanges, nsdef)
+			objectDefDiffs[nsdef.Name] = diff
 
 			vts, ok := validated.validatedTypeSystems[nsdef.Name]
 			if !ok {
@@ -209,6 +221,14 @@ func ApplySchemaChangesOverExisting(
 			if err := rwt.DeleteNamespaces(ctx, removedObjectDefNames.AsSlice()...); err != nil {
 				return nil, err
 			}
+
+			for _, nsdefName := range removedObjectDefNames.AsSlice() {
+				diff, err := nsdiff.DiffNamespaces(existingObjectDefMap[nsdefName], nil)
+				if err != nil {
+					return nil, err
+				}
+				objectDefDiffs[nsdefName] = diff
+			}
 		}
 
 		// Delete the removed caveats.
@@ -216,6 +236,14 @@ func ApplySchemaChangesOverExisting(
 			if err := rwt.DeleteCaveats(ctx, removedCaveatDefNames.AsSlice()); err != nil {
 				return nil, err
 			}
+
+			for _, caveatDefName := range removedCaveatDefNames.AsSlice() {
+				diff, err := caveatdiff.DiffCaveats(existingCaveatDefMap[caveatDefName], nil)
+				if err != nil {
+					return nil, err
+				}
+				caveatDefDiffs[caveatDefName] = diff
+			}
 		}
 	}
 
@@ -234,6 +262,8 @@ func ApplySchemaChangesOverExisting(
 		RemovedObjectDefNames: removedObjectDefNames.AsSlice(),
 		NewCaveatDefNames:     validated.newCaveatDefNames.Subtract(existingCaveatDefNames).AsSlice(),
 		RemovedCaveatDefNames: removedCaveatDefNames.AsSlice(),
+		ObjectDefDiffs:        objectDefDiffs,
+		CaveatDefDiffs:        caveatDefDiffs,
 	}, nil
 }
 
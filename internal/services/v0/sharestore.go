@@ -9,12 +9,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+
+	log "github.com/authzed/spicedb/internal/logging"
 )
 
 const (
@@ -139,14 +146,26 @@ func (s3s *s3ShareStore) createBucketForTesting() error {
 // NOTE: Copied from base64.go for URL-encoding, since it isn't exported.
 const encodeURL = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
 
-func (s3s *s3ShareStore) key(reference string) (string, error) {
-	// Ensure it is a local safe identifier.
+// validateReference ensures the reference is a local safe identifier.
+func validateReference(reference string) error {
+	if len(reference) == 0 {
+		return fmt.Errorf("invalid reference")
+	}
+
 	for _, r := range reference {
 		if !strings.ContainsRune(encodeURL, r) {
-			return "", fmt.Errorf("invalid reference")
+			return fmt.Errorf("invalid reference")
 		}
 	}
 
+	return nil
+}
+
+func (s3s *s3ShareStore) key(reference string) (string, error) {
+	if err := validateReference(reference); err != nil {
+		return "", err
+	}
+
 	return "shared/" + reference, nil
 }
 
@@ -202,6 +221,217 @@ func (s3s *s3ShareStore) StoreShared(shared SharedDataV2) (string, error) {
 	return reference, err
 }
 
+// expiredSweepInterval is the minimum interval between sweeps of expired shares from a directory
+// share store.
+const expiredSweepInterval = 1 * time.Hour
+
+type directoryShareStore struct {
+	directory string
+	salt      string
+	ttl       time.Duration
+	now       func() time.Time
+
+	sweepLock sync.Mutex
+	lastSweep time.Time
+
+	// storeLock serializes storing shares with removing expired shares, so that a share whose
+	// TTL is being refreshed is never removed.
+	storeLock sync.Mutex
+}
+
+// NewDirectoryShareStore creates a new share store which persists the shared data as files in the
+// given local directory, with the given salt for hash computation. If the TTL is non-zero, shares
+// that have not been stored within the TTL are considered expired and are removed.
+func NewDirectoryShareStore(directory string, salt string, ttl time.Duration) (ShareStore, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create share store directory: %w", err)
+	}
+
+	dss := &directoryShareStore{
+		directory: directory,
+		salt:      salt,
+		ttl:       ttl,
+		now:       time.Now,
+	}
+
+	now := dss.now()
+	if err := dss.removeExpired(now); err != nil {
+		return nil, err
+	}
+	dss.lastSweep = now
+
+	return dss, nil
+}
+
+func (dss *directoryShareStore) path(reference string) (string, error) {
+	if err := validateReference(reference); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dss.directory, reference+".json"), nil
+}
+
+func (dss *directoryShareStore) isExpired(info fs.FileInfo, now time.Time) bool {
+	return dss.ttl > 0 && info.ModTime().Add(dss.ttl).Before(now)
+}
+
+func (dss *directoryShareStore) LookupSharedByReference(reference string) (SharedDataV2, LookupStatus, error) {
+	path, err := dss.path(reference)
+	if err != nil {
+		return SharedDataV2{}, LookupError, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return SharedDataV2{}, LookupNotFound, nil
+		}
+		return SharedDataV2{}, LookupError, err
+	}
+
+	// Expired shares are left for removeExpired to remove, as the share may concurrently be
+	// stored again.
+	if dss.isExpired(info, dss.now()) {
+		return SharedDataV2{}, LookupNotFound, nil
+	}
+
+	contentBytes, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return SharedDataV2{}, LookupNotFound, nil
+		}
+		return SharedDataV2{}, LookupError, err
+	}
+
+	return unmarshalShared(contentBytes)
+}
+
+func (dss *directoryShareStore) StoreShared(shared SharedDataV2) (string, error) {
+	data, reference, err := marshalShared(shared, dss.salt)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := dss.path(reference)
+	if err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file and rename it into place, so that lookups never observe
+	// partially written data. Storing existing data again refreshes its TTL.
+	tmpFile, err := os.CreateTemp(dss.directory, reference+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return "", err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return "", err
+	}
+
+	now := dss.now()
+	if err := dss.replace(tmpFile.Name(), path, now); err != nil {
+		return "", err
+	}
+
+	dss.sweepLock.Lock()
+	shouldSweep := now.Sub(dss.lastSweep) >= expiredSweepInterval
+	if shouldSweep {
+		dss.lastSweep = now
+	}
+	dss.sweepLock.Unlock()
+
+	// The share is already stored, so sweep in the background: the caller neither waits for
+	// the sweep nor receives its errors.
+	if shouldSweep {
+		go dss.sweepExpired(now)
+	}
+
+	return reference, nil
+}
+
+// replace renames the file into place at the path and sets its modification time, which
+// refreshes the TTL of the share.
+func (dss *directoryShareStore) replace(file string, path string, now time.Time) error {
+	dss.storeLock.Lock()
+	defer dss.storeLock.Unlock()
+
+	if err := os.Rename(file, path); err != nil {
+		return err
+	}
+	return os.Chtimes(path, now, now)
+}
+
+func (dss *directoryShareStore) sweepExpired(now time.Time) {
+	if err := dss.removeExpired(now); err != nil {
+		log.Warn().Err(err).Str("directory", dss.directory).Msg("failed to remove expired shares")
+	}
+}
+
+// removeExpired removes all shares from the directory which have expired as of the given time.
+func (dss *directoryShareStore) removeExpired(now time.Time) error {
+	if dss.ttl == 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(dss.directory)
+	if err != nil {
+		return fmt.Errorf("failed to read share store directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+
+		if dss.isExpired(info, now) {
+			if err := dss.removeIfExpired(filepath.Join(dss.directory, entry.Name()), now); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeIfExpired removes the share at the path if it has expired as of the given time. The
+// share is stat'ed again under the store lock, as it may have been stored again since it was
+// listed.
+func (dss *directoryShareStore) removeIfExpired(path string, now time.Time) error {
+	dss.storeLock.Lock()
+	defer dss.storeLock.Unlock()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if !dss.isExpired(info, now) {
+		return nil
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
 func computeShareHash(salt string, data []byte) string {
 	h := sha256.New()
 	_, _ = io.WriteString(h, salt+":")
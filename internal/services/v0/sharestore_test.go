@@ -2,7 +2,10 @@ package v0
 
 import (
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -55,3 +58,133 @@ func TestS3ShareStore(t *testing.T) {
 	require.Equal(LookupSuccess, status)
 	require.Equal("foo", sd.Schema)
 }
+
+func TestDirectoryShareStore(t *testing.T) {
+	require := require.New(t)
+
+	directory := t.TempDir()
+	sharestore, err := NewDirectoryShareStore(directory, "bar", 0)
+	require.NoError(err)
+
+	// Check for invalid share.
+	_, _, err = sharestore.LookupSharedByReference("../foobar")
+	require.Error(err)
+
+	// Check for non-existent share.
+	_, status, err := sharestore.LookupSharedByReference("someref")
+	require.NoError(err)
+	require.Equal(LookupNotFound, status)
+
+	// Add a share.
+	reference, err := sharestore.StoreShared(SharedDataV2{
+		Version: sharedDataVersion,
+		Schema:  "foo",
+	})
+	require.NoError(err)
+
+	// Ensure the reference matches that computed by the other stores.
+	inMemoryReference, err := NewInMemoryShareStore("bar").StoreShared(SharedDataV2{
+		Version: sharedDataVersion,
+		Schema:  "foo",
+	})
+	require.NoError(err)
+	require.Equal(inMemoryReference, reference)
+
+	// Lookup the share from a new store over the same directory and compare.
+	reopened, err := NewDirectoryShareStore(directory, "bar", 0)
+	require.NoError(err)
+
+	sd, status, err := reopened.LookupSharedByReference(reference)
+	require.NoError(err)
+	require.Equal(LookupSuccess, status)
+	require.Equal("foo", sd.Schema)
+}
+
+func TestDirectoryShareStoreExpiration(t *testing.T) {
+	require := require.New(t)
+
+	sharestore, err := NewDirectoryShareStore(t.TempDir(), "bar", 1*time.Hour)
+	require.NoError(err)
+
+	now := time.Now()
+	dss := sharestore.(*directoryShareStore)
+	dss.now = func() time.Time { return now }
+
+	reference, err := sharestore.StoreShared(SharedDataV2{
+		Version: sharedDataVersion,
+		Schema:  "foo",
+	})
+	require.NoError(err)
+
+	// Within the TTL, the share is found.
+	now = now.Add(59 * time.Minute)
+	_, status, err := sharestore.LookupSharedByReference(reference)
+	require.NoError(err)
+	require.Equal(LookupSuccess, status)
+
+	// After the TTL, the share is no longer found, but is left for the sweep to remove.
+	now = now.Add(2 * time.Minute)
+	_, status, err = sharestore.LookupSharedByReference(reference)
+	require.NoError(err)
+	require.Equal(LookupNotFound, status)
+
+	_, err = os.Stat(filepath.Join(dss.directory, reference+".json"))
+	require.NoError(err)
+
+	// Storing the share again refreshes its TTL.
+	storedReference, err := sharestore.StoreShared(SharedDataV2{
+		Version: sharedDataVersion,
+		Schema:  "foo",
+	})
+	require.NoError(err)
+	require.Equal(reference, storedReference)
+
+	_, status, err = sharestore.LookupSharedByReference(reference)
+	require.NoError(err)
+	require.Equal(LookupSuccess, status)
+
+	// Expired shares are removed by the sweep.
+	now = now.Add(2 * time.Hour)
+	require.NoError(dss.removeExpired(now))
+
+	entries, err := os.ReadDir(dss.directory)
+	require.NoError(err)
+	require.Empty(entries)
+}
+
+func TestDirectoryShareStoreSweepsInBackground(t *testing.T) {
+	require := require.New(t)
+
+	sharestore, err := NewDirectoryShareStore(t.TempDir(), "bar", 1*time.Hour)
+	require.NoError(err)
+
+	dss := sharestore.(*directoryShareStore)
+	start := time.Now()
+	dss.now = func() time.Time { return start }
+
+	expiredReference, err := sharestore.StoreShared(SharedDataV2{
+		Version: sharedDataVersion,
+		Schema:  "foo",
+	})
+	require.NoError(err)
+
+	// Storing a share after the sweep interval sweeps the expired shares in the background.
+	later := start.Add(2 * time.Hour)
+	dss.now = func() time.Time { return later }
+
+	reference, err := sharestore.StoreShared(SharedDataV2{
+		Version: sharedDataVersion,
+		Schema:  "bar",
+	})
+	require.NoError(err)
+	require.NotEmpty(reference)
+
+	require.Eventually(func() bool {
+		_, err := os.Stat(filepath.Join(dss.directory, expiredReference+".json"))
+		return os.IsNotExist(err)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, status, err := sharestore.LookupSharedByReference(reference)
+	require.NoError(err)
+	require.Equal(LookupSuccess, status)
+}
@@ -0,0 +1,160 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/middleware/audit"
+	tf "github.com/authzed/spicedb/internal/testfixtures"
+	"github.com/authzed/spicedb/internal/testserver"
+	"github.com/authzed/spicedb/pkg/middleware/requestid"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+type recordingSink struct {
+	sync.Mutex
+	records []audit.Record
+}
+
+func (s *recordingSink) Write(_ context.Context, record audit.Record) error {
+	s.Lock()
+	defer s.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func TestAuditLog(t *testing.T) {
+	require := require.New(t)
+
+	sink := &recordingSink{}
+	conn, cleanup, _, _ := testserver.NewTestServerWithConfig(require, 0, memdb.DisableGC, true,
+		testserver.ServerConfig{
+			MaxUpdatesPerWrite:    1000,
+			MaxPreconditionsCount: 1000,
+			StreamingAPITimeout:   30 * time.Second,
+			Auditor:               audit.NewAuditor(sink),
+		},
+		tf.EmptyDatastore)
+	t.Cleanup(cleanup)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), requestid.MetadataKey, "somerequest")
+
+	schemaResp, err := v1.NewSchemaServiceClient(conn).WriteSchema(ctx, &v1.WriteSchemaRequest{
+		Schema: `definition user {}
+		definition document {
+			relation viewer: user
+		}`,
+	})
+	require.NoError(err)
+
+	writeResp, err := v1.NewPermissionsServiceClient(conn).WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			tuple.UpdateToRelationshipUpdate(tuple.Touch(tuple.MustParse("document:doc1#viewer@user:alice"))),
+		},
+	})
+	require.NoError(err)
+
+	importer, err := v1.NewExperimentalServiceClient(conn).BulkImportRelationships(ctx)
+	require.NoError(err)
+	for _, rel := range []string{"document:doc2#viewer@user:alice", "document:doc3#viewer@user:bob"} {
+		require.NoError(importer.Send(&v1.BulkImportRelationshipsRequest{
+			Relationships: []*v1.Relationship{tuple.MustToRelationship(tuple.MustParse(rel))},
+		}))
+	}
+	importResp, err := importer.CloseAndRecv()
+	require.NoError(err)
+	require.Equal(uint64(2), importResp.NumLoaded)
+
+	deleteResp, err := v1.NewPermissionsServiceClient(conn).DeleteRelationships(ctx, &v1.DeleteRelationshipsRequest{
+		RelationshipFilter: &v1.RelationshipFilter{ResourceType: "document", OptionalResourceId: "doc1"},
+	})
+	require.NoError(err)
+
+	limitedDeleteResp, err := v1.NewPermissionsServiceClient(conn).DeleteRelationships(ctx, &v1.DeleteRelationshipsRequest{
+		RelationshipFilter:            &v1.RelationshipFilter{ResourceType: "document"},
+		OptionalLimit:                 1,
+		OptionalAllowPartialDeletions: true,
+	})
+	require.NoError(err)
+	require.Equal(v1.DeleteRelationshipsResponse_DELETION_PROGRESS_PARTIAL, limitedDeleteResp.DeletionProgress)
+
+	emptyDeleteResp, err := v1.NewPermissionsServiceClient(conn).DeleteRelationships(ctx, &v1.DeleteRelationshipsRequest{
+		RelationshipFilter: &v1.RelationshipFilter{ResourceType: "document", OptionalResourceId: "unknown"},
+	})
+	require.NoError(err)
+
+	sink.Lock()
+	defer sink.Unlock()
+	require.Len(sink.records, 7)
+	for _, record := range sink.records {
+		require.Equal("somerequest", record.RequestID)
+		require.False(record.Time.IsZero())
+	}
+
+	require.Equal(v1.SchemaService_WriteSchema_FullMethodName, sink.records[0].Method)
+	require.Equal(schemaResp.WrittenAt.Token, sink.records[0].ZedToken)
+	require.Equal([]audit.DefinitionDiff{
+		{Definition: "document", Deltas: []audit.DefinitionDelta{{Type: "namespace-added"}}},
+		{Definition: "user", Deltas: []audit.DefinitionDelta{{Type: "namespace-added"}}},
+	}, sink.records[0].SchemaDiff)
+
+	require.Equal(v1.PermissionsService_WriteRelationships_FullMethodName, sink.records[1].Method)
+	require.Equal(writeResp.WrittenAt.Token, sink.records[1].ZedToken)
+	require.Equal([]audit.RelationshipUpdate{
+		{Operation: "TOUCH", Relationship: "document:doc1#viewer@user:alice"},
+	}, sink.records[1].RelationshipUpdates)
+
+	// Bulk imports emit a record per batch.
+	for index, rel := range []string{"document:doc2#viewer@user:alice", "document:doc3#viewer@user:bob"} {
+		require.Equal(v1.ExperimentalService_BulkImportRelationships_FullMethodName, sink.records[2+index].Method)
+		require.NotEmpty(sink.records[2+index].ZedToken)
+		require.Equal([]audit.RelationshipUpdate{{Operation: "CREATE", Relationship: rel}}, sink.records[2+index].RelationshipUpdates)
+	}
+	require.Equal(sink.records[2].ZedToken, sink.records[3].ZedToken)
+
+	// Deletions record the deleted relationships along with their filter.
+	require.Equal(v1.PermissionsService_DeleteRelationships_FullMethodName, sink.records[4].Method)
+	require.Equal(deleteResp.DeletedAt.Token, sink.records[4].ZedToken)
+	require.Equal(&audit.RelationshipFilter{ResourceType: "document", OptionalResourceID: "doc1"}, sink.records[4].RelationshipFilter)
+	require.Equal([]audit.RelationshipUpdate{
+		{Operation: "DELETE", Relationship: "document:doc1#viewer@user:alice"},
+	}, sink.records[4].RelationshipUpdates)
+
+	require.Equal(limitedDeleteResp.DeletedAt.Token, sink.records[5].ZedToken)
+	require.Equal(&audit.RelationshipFilter{ResourceType: "document", OptionalLimit: 1}, sink.records[5].RelationshipFilter)
+	require.Len(sink.records[5].RelationshipUpdates, 1)
+	deletedRel := tuple.MustParse(sink.records[5].RelationshipUpdates[0].Relationship)
+
+	require.Equal(emptyDeleteResp.DeletedAt.Token, sink.records[6].ZedToken)
+	require.Empty(sink.records[6].RelationshipUpdates)
+
+	// Exactly the audited relationship was deleted by the limited deletion.
+	reader, err := v1.NewPermissionsServiceClient(conn).ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
+		Consistency:        &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}},
+		RelationshipFilter: &v1.RelationshipFilter{ResourceType: "document"},
+	})
+	require.NoError(err)
+
+	var remaining []string
+	for {
+		resp, err := reader.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(err)
+		remaining = append(remaining, tuple.MustStringRelationship(resp.Relationship))
+	}
+	require.Len(remaining, 1)
+	require.NotContains(remaining, tuple.MustString(deletedRel))
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	"github.com/authzed/spicedb/pkg/genutil/slicez"
 	"github.com/authzed/spicedb/pkg/middleware/consistency"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	dispatchv1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/spiceerrors"
+	"github.com/authzed/spicedb/pkg/tuple"
 )
 
 // bulkChecker contains the logic to allow ExperimentalService/BulkCheckPermission and
@@ -61,8 +63,10 @@ func (bc *bulkChecker) checkBulkPermissions(ctx context.Context, req *v1.CheckBu
 	}
 
 	// Identify checks with same permission+subject over different resources and group them. This is doable because
-	// the dispatching system already internally supports this kind of batching for performance.
-	groupedItems, err := groupItems(ctx, groupingParameters{
+	// the dispatching system already internally supports this kind of batching for performance. Checks of a single
+	// resource for different subjects are then grouped as well, as the dispatching system also supports batching
+	// over subjects.
+	groupedItemsByHash, err := groupItems(ctx, groupingParameters{
 		atRevision:           atRevision,
 		maxCaveatContextSize: bc.maxCaveatContextSize,
 		maximumAPIDepth:      bc.maxAPIDepth,
@@ -70,6 +74,7 @@ func (bc *bulkChecker) checkBulkPermissions(ctx context.Context, req *v1.CheckBu
 	if err != nil {
 		return nil, err
 	}
+	groupedItems := groupItemsBySubject(groupedItemsByHash)
 
 	bulkResponseMutex := sync.Mutex{}
 
@@ -158,28 +163,94 @@ func (bc *bulkChecker) checkBulkPermissions(ctx context.Context, req *v1.CheckBu
 		return nil
 	}
 
+	checkNamespaceAndRelations := func(ctx context.Context, params *computed.CheckParameters) error {
+		ds := datastoremw.MustFromContext(ctx).SnapshotReader(atRevision)
+
+		// Ensure the check namespaces and relations are valid.
+		return namespace.CheckNamespaceAndRelations(ctx,
+			[]namespace.TypeAndRelationToCheck{
+				{
+					NamespaceName: params.ResourceType.Namespace,
+					RelationName:  params.ResourceType.Relation,
+					AllowEllipsis: false,
+				},
+				{
+					NamespaceName: params.Subject.Namespace,
+					RelationName:  stringz.DefaultEmpty(params.Subject.Relation, graph.Ellipsis),
+					AllowEllipsis: true,
+				},
+			}, ds)
+	}
+
+	// withSubject returns the parameters for checking the given subject.
+	withSubject := func(params *computed.CheckParameters, subject *core.ObjectAndRelation) *computed.CheckParameters {
+		subjectParams := *params
+		subjectParams.Subject = subject
+		return &subjectParams
+	}
+
+	appendResultsForSubjectsError := func(params *computed.CheckParameters, resourceID string, subjects []*core.ObjectAndRelation, err error) error {
+		for _, subject := range subjects {
+			if err := appendResultsForError(withSubject(params, subject), []string{resourceID}, err); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	appendResultsForSubjectsCheck := func(params *computed.CheckParameters, resourceID string, subjects []*core.ObjectAndRelation, metadata *dispatchv1.ResponseMeta, results map[string]*dispatchv1.ResourceCheckResult) error {
+		bulkResponseMutex.Lock()
+		defer bulkResponseMutex.Unlock()
+
+		for _, subject := range subjects {
+			reqItem, err := requestItemFromResourceAndParameters(withSubject(params, subject), resourceID)
+			if err != nil {
+				return err
+			}
+
+			if err := addPair(&v1.CheckBulkPermissionsPair{
+				Request:  reqItem,
+				Response: pairItemFromCheckResult(results[tuple.StringONR(subject)]),
+			}); err != nil {
+				return err
+			}
+		}
+
+		respMetadata.DispatchCount += metadata.DispatchCount
+		respMetadata.CachedDispatchCount += metadata.CachedDispatchCount
+		return nil
+	}
+
 	for _, group := range groupedItems {
 		group := group
 
+		if len(group.additionalSubjects) > 0 {
+			subjects := append([]*core.ObjectAndRelation{group.params.Subject}, group.additionalSubjects...)
+			resourceID := group.resourceIDs[0]
+
+			slicez.ForEachChunk(subjects, MaxBulkCheckDispatchChunkSize, func(subjects []*core.ObjectAndRelation) {
+				tr.Add(func(ctx context.Context) error {
+					// The subjects of the group share their type and relation, so they are all
+					// valid or invalid alike.
+					if err := checkNamespaceAndRelations(ctx, group.params); err != nil {
+						return appendResultsForSubjectsError(group.params, resourceID, subjects, err)
+					}
+
+					// Call bulk check to compute the check result(s) for the subject(s).
+					rcr, metadata, err := computed.ComputeBulkCheckForSubjects(ctx, bc.dispatch, *group.params, resourceID, subjects)
+					if err != nil {
+						return appendResultsForSubjectsError(group.params, resourceID, subjects, err)
+					}
+
+					return appendResultsForSubjectsCheck(group.params, resourceID, subjects, metadata, rcr)
+				})
+			})
+			continue
+		}
+
 		slicez.ForEachChunk(group.resourceIDs, MaxBulkCheckDispatchChunkSize, func(resourceIDs []string) {
 			tr.Add(func(ctx context.Context) error {
-				ds := datastoremw.MustFromContext(ctx).SnapshotReader(atRevision)
-
-				// Ensure the check namespaces and relations are valid.
-				err := namespace.CheckNamespaceAndRelations(ctx,
-					[]namespace.TypeAndRelationToCheck{
-						{
-							NamespaceName: group.params.ResourceType.Namespace,
-							RelationName:  group.params.ResourceType.Relation,
-							AllowEllipsis: false,
-						},
-						{
-							NamespaceName: group.params.Subject.Namespace,
-							RelationName:  stringz.DefaultEmpty(group.params.Subject.Relation, graph.Ellipsis),
-							AllowEllipsis: true,
-						},
-					}, ds)
-				if err != nil {
+				if err := checkNamespaceAndRelations(ctx, group.params); err != nil {
 					return appendResultsForError(group.params, resourceIDs, err)
 				}
 
@@ -11,6 +11,7 @@ import (
 
 	cexpr "github.com/authzed/spicedb/internal/caveats"
 	"github.com/authzed/spicedb/pkg/datastore"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/schemadsl/compiler"
 	"github.com/authzed/spicedb/pkg/schemadsl/generator"
@@ -93,46 +94,11 @@ func convertCheckTrace(ctx context.Context, caveatContext map[string]any, ct *di
 
 	var caveatEvalInfo *v1.CaveatEvalInfo
 	if permissionship == v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION && len(partialResults) == 1 {
-		partialCheckResult := partialResults[0]
-		computedResult, err := cexpr.RunCaveatExpression(ctx, partialCheckResult.Expression, caveatContext, reader, cexpr.RunCaveatExpressionWithDebugInformation)
+		converted, err := convertCaveatEvaluation(ctx, partialResults[0].Expression, caveatContext, reader)
 		if err != nil {
 			return nil, err
 		}
-
-		var partialCaveatInfo *v1.PartialCaveatInfo
-		caveatResult := v1.CaveatEvalInfo_RESULT_FALSE
-		if computedResult.Value() {
-			caveatResult = v1.CaveatEvalInfo_RESULT_TRUE
-		} else if computedResult.IsPartial() {
-			caveatResult = v1.CaveatEvalInfo_RESULT_MISSING_SOME_CONTEXT
-			missingNames, _ := computedResult.MissingVarNames()
-			partialCaveatInfo = &v1.PartialCaveatInfo{
-				MissingRequiredContext: missingNames,
-			}
-		}
-
-		contextStruct, err := computedResult.ContextStruct()
-		if err != nil {
-			return nil, fmt.Errorf("could not serialize context: %w. please report this error", err)
-		}
-
-		exprString, err := computedResult.ExpressionString()
-		if err != nil {
-			return nil, err
-		}
-
-		caveatName := ""
-		if partialCheckResult.Expression.GetCaveat() != nil {
-			caveatName = partialCheckResult.Expression.GetCaveat().CaveatName
-		}
-
-		caveatEvalInfo = &v1.CaveatEvalInfo{
-			Expression:        exprString,
-			Result:            caveatResult,
-			Context:           contextStruct,
-			PartialCaveatInfo: partialCaveatInfo,
-			CaveatName:        caveatName,
-		}
+		caveatEvalInfo = converted
 	}
 
 	if len(ct.SubProblems) > 0 {
@@ -197,3 +163,47 @@ func convertCheckTrace(ctx context.Context, caveatContext map[string]any, ct *di
 		Duration: ct.Duration,
 	}, nil
 }
+
+// convertCaveatEvaluation evaluates the given caveat expression over the caveat context and
+// returns the result as CaveatEvalInfo.
+func convertCaveatEvaluation(ctx context.Context, expr *core.CaveatExpression, caveatContext map[string]any, reader datastore.CaveatReader) (*v1.CaveatEvalInfo, error) {
+	computedResult, err := cexpr.RunCaveatExpression(ctx, expr, caveatContext, reader, cexpr.RunCaveatExpressionWithDebugInformation)
+	if err != nil {
+		return nil, err
+	}
+
+	var partialCaveatInfo *v1.PartialCaveatInfo
+	caveatResult := v1.CaveatEvalInfo_RESULT_FALSE
+	if computedResult.Value() {
+		caveatResult = v1.CaveatEvalInfo_RESULT_TRUE
+	} else if computedResult.IsPartial() {
+		caveatResult = v1.CaveatEvalInfo_RESULT_MISSING_SOME_CONTEXT
+		missingNames, _ := computedResult.MissingVarNames()
+		partialCaveatInfo = &v1.PartialCaveatInfo{
+			MissingRequiredContext: missingNames,
+		}
+	}
+
+	contextStruct, err := computedResult.ContextStruct()
+	if err != nil {
+		return nil, fmt.Errorf("could not serialize context: %w. please report this error", err)
+	}
+
+	exprString, err := computedResult.ExpressionString()
+	if err != nil {
+		return nil, err
+	}
+
+	caveatName := ""
+	if expr.GetCaveat() != nil {
+		caveatName = expr.GetCaveat().CaveatName
+	}
+
+	return &v1.CaveatEvalInfo{
+		Expression:        exprString,
+		Result:            caveatResult,
+		Context:           contextStruct,
+		PartialCaveatInfo: partialCaveatInfo,
+		CaveatName:        caveatName,
+	}, nil
+}
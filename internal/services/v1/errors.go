@@ -126,6 +126,57 @@ func NewExceedsMaximumPreconditionsErr(preconditionCount uint64, maxCountAllowed
 	}
 }
 
+// maxAllowedLimit is the largest limit accepted by the limited calls of the v1 API.
+const maxAllowedLimit = 1000
+
+// ErrExceedsMaximumLimit occurs when a limit that is too large is given to a call.
+type ErrExceedsMaximumLimit struct {
+	error
+	providedLimit   uint64
+	maxLimitAllowed uint64
+}
+
+// MarshalZerologObject implements zerolog object marshalling.
+func (err ErrExceedsMaximumLimit) MarshalZerologObject(e *zerolog.Event) {
+	e.Err(err.error).Uint64("providedLimit", err.providedLimit).Uint64("maxLimitAllowed", err.maxLimitAllowed)
+}
+
+// GRPCStatus implements retrieving the gRPC status for the error.
+func (err ErrExceedsMaximumLimit) GRPCStatus() *status.Status {
+	return spiceerrors.WithCodeAndDetails(
+		err,
+		codes.InvalidArgument,
+		spiceerrors.ForReason(
+			v1.ErrorReason_ERROR_REASON_EXCEEDS_MAXIMUM_ALLOWABLE_LIMIT,
+			map[string]string{
+				"limit_provided":        strconv.FormatUint(err.providedLimit, 10),
+				"maximum_limit_allowed": strconv.FormatUint(err.maxLimitAllowed, 10),
+			},
+		),
+	)
+}
+
+// NewExceedsMaximumLimitErr creates a new error representing that the limit given to a call
+// is greater than the maximum allowed.
+func NewExceedsMaximumLimitErr(fieldName string, providedLimit uint64, maxLimitAllowed uint64) ErrExceedsMaximumLimit {
+	return ErrExceedsMaximumLimit{
+		error: fmt.Errorf(
+			"invalid %s: value must be inside range [0, %d]",
+			fieldName,
+			maxLimitAllowed),
+		providedLimit:   providedLimit,
+		maxLimitAllowed: maxLimitAllowed,
+	}
+}
+
+// validateLimit ensures that the given limit is not greater than the maximum allowed limit.
+func validateLimit(fieldName string, limit uint32) error {
+	if limit > maxAllowedLimit {
+		return NewExceedsMaximumLimitErr(fieldName, uint64(limit), maxAllowedLimit)
+	}
+	return nil
+}
+
 // ErrPreconditionFailed occurs when the precondition to a write tuple call does not match.
 type ErrPreconditionFailed struct {
 	error
@@ -405,3 +456,35 @@ func defaultIfZero[T comparable](value T, defaultValue T) T {
 	}
 	return value
 }
+
+// ErrInvalidTransactionMetadata indicates the transaction metadata given in a request or in its
+// headers was invalid.
+type ErrInvalidTransactionMetadata struct {
+	error
+	source string
+}
+
+// NewInvalidTransactionMetadataErr constructs a new invalid transaction metadata error, for the
+// metadata given in the request field or header named by source.
+func NewInvalidTransactionMetadataErr(source string, reason string) ErrInvalidTransactionMetadata {
+	return ErrInvalidTransactionMetadata{
+		error: fmt.Errorf(
+			"the transaction metadata provided in `%s` is not valid: %s", source, reason,
+		),
+		source: source,
+	}
+}
+
+// GRPCStatus implements retrieving the gRPC status for the error.
+func (err ErrInvalidTransactionMetadata) GRPCStatus() *status.Status {
+	return spiceerrors.WithCodeAndDetails(
+		err,
+		codes.InvalidArgument,
+		spiceerrors.ForReason(
+			v1.ErrorReason_ERROR_REASON_UNSPECIFIED,
+			map[string]string{
+				"source": err.source,
+			},
+		),
+	)
+}
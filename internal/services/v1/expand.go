@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/internal/middleware/usagemetrics"
+	"github.com/authzed/spicedb/internal/namespace"
+	"github.com/authzed/spicedb/pkg/cursor"
+	"github.com/authzed/spicedb/pkg/middleware/consistency"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+)
+
+func (es *extensionsServer) ExpandPermissionTree(ctx context.Context, req *extensionsv1.ExpandPermissionTreeRequest) (*extensionsv1.ExpandPermissionTreeResponse, error) {
+	atRevision, expandedAt, err := consistency.RevisionFromContext(ctx)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	ds := datastoremw.MustFromContext(ctx).SnapshotReader(atRevision)
+
+	err = namespace.CheckNamespaceAndRelation(ctx, req.Resource.ObjectType, req.Permission, false, ds)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	var dispatchCursor *dispatch.Cursor
+	if req.OptionalCursor != nil {
+		eptRequestHash, err := computeEPTRequestHash(req)
+		if err != nil {
+			return nil, es.rewriteError(ctx, err)
+		}
+
+		dispatchCursor, err = cursor.DecodeToDispatchCursor(req.OptionalCursor, eptRequestHash)
+		if err != nil {
+			return nil, es.rewriteError(ctx, err)
+		}
+	}
+
+	bf, err := dispatch.NewTraversalBloomFilter(uint(es.permServerConfig.MaximumAPIDepth))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := es.dispatch.DispatchExpand(ctx, &dispatch.DispatchExpandRequest{
+		Metadata: &dispatch.ResolverMeta{
+			AtRevision:     atRevision.String(),
+			DepthRemaining: es.permServerConfig.MaximumAPIDepth,
+			TraversalBloom: bf,
+		},
+		ResourceAndRelation: &core.ObjectAndRelation{
+			Namespace: req.Resource.ObjectType,
+			ObjectId:  req.Resource.ObjectId,
+			Relation:  req.Permission,
+		},
+		ExpansionMode:        dispatch.DispatchExpandRequest_SHALLOW,
+		OptionalMaxDepth:     req.OptionalMaxDepth,
+		OptionalSubjectLimit: req.OptionalSubjectLimit,
+		OptionalCursor:       dispatchCursor,
+	})
+	usagemetrics.SetInContext(ctx, resp.Metadata)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	truncatedNodes := make([]*extensionsv1.TruncatedExpansion, 0, len(resp.TruncatedNodes))
+	for _, truncated := range resp.TruncatedNodes {
+		resource := &v1.ObjectReference{
+			ObjectType: truncated.Expanded.Namespace,
+			ObjectId:   truncated.Expanded.ObjectId,
+		}
+
+		// The cursor resumes the expansion of the truncated node, rather than that of the
+		// requested permission, so it is bound to a request for the truncated node.
+		var encodedCursor *v1.Cursor
+		if truncated.OptionalCursor != nil {
+			nodeRequestHash, err := computeEPTRequestHash(&extensionsv1.ExpandPermissionTreeRequest{
+				Consistency:          req.Consistency,
+				Resource:             resource,
+				Permission:           truncated.Expanded.Relation,
+				OptionalMaxDepth:     req.OptionalMaxDepth,
+				OptionalSubjectLimit: req.OptionalSubjectLimit,
+			})
+			if err != nil {
+				return nil, es.rewriteError(ctx, err)
+			}
+
+			encodedCursor, err = cursor.EncodeFromDispatchCursor(truncated.OptionalCursor, nodeRequestHash, atRevision)
+			if err != nil {
+				return nil, es.rewriteError(ctx, err)
+			}
+		}
+
+		truncatedNodes = append(truncatedNodes, &extensionsv1.TruncatedExpansion{
+			Resource:       resource,
+			Relation:       truncated.Expanded.Relation,
+			OptionalCursor: encodedCursor,
+		})
+	}
+
+	return &extensionsv1.ExpandPermissionTreeResponse{
+		ExpandedAt:     expandedAt,
+		TreeRoot:       TranslateExpansionTree(resp.TreeNode),
+		TruncatedNodes: truncatedNodes,
+	}, nil
+}
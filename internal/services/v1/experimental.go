@@ -15,6 +15,7 @@ import (
 	"github.com/authzed/spicedb/internal/dispatch"
 	log "github.com/authzed/spicedb/internal/logging"
 	"github.com/authzed/spicedb/internal/middleware"
+	"github.com/authzed/spicedb/internal/middleware/audit"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/middleware/handwrittenvalidation"
 	"github.com/authzed/spicedb/internal/middleware/streamtimeout"
@@ -31,6 +32,7 @@ import (
 	implv1 "github.com/authzed/spicedb/pkg/proto/impl/v1"
 	"github.com/authzed/spicedb/pkg/tuple"
 	"github.com/authzed/spicedb/pkg/typesystem"
+	"github.com/authzed/spicedb/pkg/zedtoken"
 )
 
 const (
@@ -116,10 +118,28 @@ type bulkLoadAdapter struct {
 	currentBatch []*v1.Relationship
 	numSent      int
 	err          error
+
+	// spool collects the batches loaded for the audit log, if enabled.
+	spool *audit.Spool
 }
 
 func (a *bulkLoadAdapter) Next(_ context.Context) (*core.RelationTuple, error) {
 	for a.err == nil && a.numSent == len(a.currentBatch) {
+		// Spool the batch that has been loaded before receiving the next one.
+		if a.spool != nil && len(a.currentBatch) > 0 {
+			audited, err := audit.CreatedRelationships(a.currentBatch)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := a.spool.Append(audited); err != nil {
+				return nil, err
+			}
+
+			a.currentBatch = nil
+			a.numSent = 0
+		}
+
 		// Load a new batch
 		batch, err := a.stream.Recv()
 		if err != nil {
@@ -196,8 +216,15 @@ func (es *experimentalServer) rewriteError(ctx context.Context, err error) error
 func (es *experimentalServer) BulkImportRelationships(stream v1.ExperimentalService_BulkImportRelationshipsServer) error {
 	ds := datastoremw.MustFromContext(stream.Context())
 
+	// The imported relationships are spooled for the audit log until the import commits.
+	spool, err := audit.NewSpool(stream.Context())
+	if err != nil {
+		return es.rewriteError(stream.Context(), err)
+	}
+	defer spool.Close()
+
 	var numWritten uint64
-	if _, err := ds.ReadWriteTx(stream.Context(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+	revision, err := ds.ReadWriteTx(stream.Context(), func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
 		loadedNamespaces := make(map[string]*typesystem.TypeSystem)
 		loadedCaveats := make(map[string]*core.CaveatDefinition)
 
@@ -210,6 +237,7 @@ func (es *experimentalServer) BulkImportRelationships(stream v1.ExperimentalServ
 				Subject:             &core.ObjectAndRelation{},
 			},
 			caveat: core.ContextualizedCaveat{},
+			spool:  spool,
 		}
 
 		var streamWritten uint64
@@ -248,12 +276,16 @@ func (es *experimentalServer) BulkImportRelationships(stream v1.ExperimentalServ
 			}
 		}
 		numWritten += streamWritten
-
 		return err
-	}, dsoptions.WithDisableRetries(true)); err != nil {
+	}, dsoptions.WithDisableRetries(true))
+	if err != nil {
 		return es.rewriteError(stream.Context(), err)
 	}
 
+	spool.Emit(stream.Context(), audit.Record{
+		ZedToken: zedtoken.MustNewFromRevision(revision).Token,
+	})
+
 	usagemetrics.SetInContext(stream.Context(), &dispatchv1.ResponseMeta{
 		// One request for the whole load
 		DispatchCount: 1,
@@ -9,6 +9,7 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/authzed/authzed-go/pkg/responsemeta"
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
@@ -18,6 +19,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/authzed/spicedb/internal/datastore/memdb"
 	"github.com/authzed/spicedb/internal/namespace"
@@ -106,6 +108,62 @@ func TestBulkImportRelationships(t *testing.T) {
 	}
 }
 
+func TestBulkImportRelationshipsWithExpiration(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, tf.StandardDatastoreWithSchema)
+	client := v1.NewExperimentalServiceClient(conn)
+	t.Cleanup(cleanup)
+
+	ctx := context.Background()
+
+	expiresAt := timestamppb.New(time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond))
+	unexpired := rel(tf.DocumentNS.Name, "doc1", "viewer", tf.UserNS.Name, "contractor", "")
+	unexpired.OptionalExpiresAt = expiresAt
+
+	expired := rel(tf.DocumentNS.Name, "doc1", "viewer", tf.UserNS.Name, "formercontractor", "")
+	expired.OptionalExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+
+	writer, err := client.BulkImportRelationships(ctx)
+	require.NoError(err)
+
+	err = writer.Send(&v1.BulkImportRelationshipsRequest{
+		Relationships: []*v1.Relationship{
+			unexpired,
+			expired,
+			rel(tf.DocumentNS.Name, "doc1", "viewer", tf.UserNS.Name, "employee", ""),
+		},
+	})
+	require.NoError(err)
+
+	resp, err := writer.CloseAndRecv()
+	require.NoError(err)
+	require.Equal(uint64(3), resp.NumLoaded)
+
+	readerClient := v1.NewPermissionsServiceClient(conn)
+	stream, err := readerClient.ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
+		RelationshipFilter: &v1.RelationshipFilter{
+			ResourceType: tf.DocumentNS.Name,
+		},
+		Consistency: &v1.Consistency{
+			Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true},
+		},
+	})
+	require.NoError(err)
+
+	expiresAtBySubject := map[string]*timestamppb.Timestamp{}
+	res, err := stream.Recv()
+	for ; err == nil; res, err = stream.Recv() {
+		expiresAtBySubject[res.Relationship.Subject.Object.ObjectId] = res.Relationship.OptionalExpiresAt
+	}
+	require.ErrorIs(err, io.EOF)
+
+	require.Len(expiresAtBySubject, 2)
+	require.True(expiresAt.AsTime().Equal(expiresAtBySubject["contractor"].AsTime()))
+	require.Contains(expiresAtBySubject, "employee")
+	require.Nil(expiresAtBySubject["employee"])
+}
+
 func constBatch(size int) func() int {
 	return func() int {
 		return size
@@ -422,7 +480,7 @@ func TestBulkCheckPermission(t *testing.T) {
 					resp: v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
 				},
 			},
-			expectedDispatchCount: 49,
+			expectedDispatchCount: 17,
 		},
 		{
 			name: "different resources, same permission and subject",
@@ -0,0 +1,571 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+
+	cexpr "github.com/authzed/spicedb/internal/caveats"
+	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/genutil/mapz"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+	"github.com/authzed/spicedb/pkg/schemadsl/compiler"
+	"github.com/authzed/spicedb/pkg/schemadsl/generator"
+	"github.com/authzed/spicedb/pkg/spiceerrors"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+// checkExplainer builds explanations of check results out of the debug traces of checks run with
+// trace debugging enabled.
+//
+// Each step of the trace is reduced to the minimal set of relationships and sub-steps needed to
+// justify its result: a single grant for a granted union, every failed branch for a denied union,
+// every branch for a granted intersection, and so on. Caveats are evaluated against the caveat
+// context of the check, so that steps whose caveats evaluate to false are reported as denied.
+type checkExplainer struct {
+	caveatContext map[string]any
+	reader        datastore.Reader
+
+	definitions map[string]*core.NamespaceDefinition
+	caveatNames *mapz.Set[string]
+}
+
+func newCheckExplainer(caveatContext map[string]any, reader datastore.Reader) *checkExplainer {
+	return &checkExplainer{
+		caveatContext: caveatContext,
+		reader:        reader,
+		definitions:   make(map[string]*core.NamespaceDefinition),
+		caveatNames:   mapz.NewSet[string](),
+	}
+}
+
+// explainedRelationship is a relationship found for a step, along with its result once any caveat
+// on it has been evaluated.
+type explainedRelationship struct {
+	tpl       *core.RelationTuple
+	explained *extensionsv1.ExplainedRelationship
+	result    v1.CheckDebugTrace_Permissionship
+}
+
+// explainedStep is an explanation along with the trace from which it was built.
+type explainedStep struct {
+	trace       *dispatch.CheckDebugTrace
+	explanation *extensionsv1.CheckExplanation
+}
+
+// explainRoot explains the root trace of a check of the given permission. If the permission was
+// resolved through an alias, the explanation of the aliased relation is wrapped in a step for the
+// permission itself.
+func (ce *checkExplainer) explainRoot(ctx context.Context, permission *core.RelationReference, trace *dispatch.CheckDebugTrace) (*extensionsv1.CheckExplanation, error) {
+	explanation, err := ce.explain(ctx, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	if trace.Request.ResourceRelation.Relation == permission.Relation {
+		return explanation, nil
+	}
+
+	relation, schema, err := ce.lookupRelation(ctx, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensionsv1.CheckExplanation{
+		Resource:             explanation.Resource,
+		Permission:           permission.Relation,
+		PermissionType:       permissionTypeOf(relation),
+		Schema:               schema,
+		Result:               explanation.Result,
+		CaveatEvaluationInfo: explanation.CaveatEvaluationInfo,
+		Steps:                []*extensionsv1.CheckExplanation{explanation},
+	}, nil
+}
+
+func (ce *checkExplainer) explain(ctx context.Context, trace *dispatch.CheckDebugTrace) (*extensionsv1.CheckExplanation, error) {
+	if trace == nil || trace.Request == nil {
+		return nil, spiceerrors.MustBugf("missing request in check debug trace")
+	}
+
+	req := trace.Request
+	relation, schema, err := ce.lookupRelation(ctx, req.ResourceRelation)
+	if err != nil {
+		return nil, err
+	}
+
+	result, caveatEvalInfo, err := ce.resultOf(ctx, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	subProblems := slices.Clone(trace.SubProblems)
+	slices.SortFunc(subProblems, func(a, b *dispatch.CheckDebugTrace) int {
+		return cmp.Compare(traceKey(a), traceKey(b))
+	})
+
+	steps := make([]explainedStep, 0, len(subProblems))
+	for _, subProblem := range subProblems {
+		explanation, err := ce.explain(ctx, subProblem)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, explainedStep{subProblem, explanation})
+	}
+
+	var relationships []explainedRelationship
+	if relation.UsersetRewrite == nil {
+		relationships, steps, err = ce.explainRelation(ctx, req, result, steps)
+	} else {
+		relationships, steps, err = ce.explainPermission(ctx, req, relation.UsersetRewrite, result, steps)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &extensionsv1.CheckExplanation{
+		Resource: &v1.ObjectReference{
+			ObjectType: req.ResourceRelation.Namespace,
+			ObjectId:   strings.Join(req.ResourceIds, ","),
+		},
+		Permission:           req.ResourceRelation.Relation,
+		PermissionType:       permissionTypeOf(relation),
+		Schema:               schema,
+		Result:               result,
+		CaveatEvaluationInfo: caveatEvalInfo,
+	}
+
+	for _, rel := range relationships {
+		explanation.Relationships = append(explanation.Relationships, rel.explained)
+	}
+	for _, step := range steps {
+		explanation.Steps = append(explanation.Steps, step.explanation)
+	}
+	return explanation, nil
+}
+
+// explainRelation selects the relationships and subject set steps which explain the result of a
+// relation. A relation is the union of its relationships, so a single relationship suffices to
+// explain a grant, while a denial is explained by every relationship and subject set found.
+func (ce *checkExplainer) explainRelation(
+	ctx context.Context,
+	req *dispatch.DispatchCheckRequest,
+	result v1.CheckDebugTrace_Permissionship,
+	steps []explainedStep,
+) ([]explainedRelationship, []explainedStep, error) {
+	selectors := []datastore.SubjectsSelector{
+		{
+			OptionalSubjectType: req.Subject.Namespace,
+			OptionalSubjectIds:  []string{req.Subject.ObjectId, tuple.PublicWildcard},
+			RelationFilter:      datastore.SubjectRelationFilter{}.WithRelation(req.Subject.Relation),
+		},
+	}
+	for _, step := range steps {
+		selectors = append(selectors, datastore.SubjectsSelector{
+			OptionalSubjectType: step.trace.Request.ResourceRelation.Namespace,
+			OptionalSubjectIds:  step.trace.Request.ResourceIds,
+			RelationFilter:      datastore.SubjectRelationFilter{}.WithNonEllipsisRelation(step.trace.Request.ResourceRelation.Relation),
+		})
+	}
+
+	relationships, err := ce.relationshipsFor(ctx, datastore.RelationshipsFilter{
+		OptionalResourceType:      req.ResourceRelation.Namespace,
+		OptionalResourceIds:       req.ResourceIds,
+		OptionalResourceRelation:  req.ResourceRelation.Relation,
+		OptionalSubjectsSelectors: selectors,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if result == v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION {
+		return relationships, steps, nil
+	}
+
+	// Pair each relationship with the step explaining its subject set, if any.
+	var selectedRelationships []explainedRelationship
+	var selectedSteps []explainedStep
+	for _, rel := range relationships {
+		combined := rel.result
+		stepIndex := slices.IndexFunc(steps, func(step explainedStep) bool {
+			return isStepForSubject(step, rel.tpl.Subject)
+		})
+		if stepIndex >= 0 {
+			combined = combineResults(combined, steps[stepIndex].explanation.Result)
+		}
+
+		if combined == v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION {
+			continue
+		}
+
+		if result == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION && combined != v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION {
+			continue
+		}
+
+		selectedRelationships = append(selectedRelationships, rel)
+		if stepIndex >= 0 {
+			selectedSteps = append(selectedSteps, steps[stepIndex])
+		}
+
+		if result == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION {
+			return selectedRelationships, selectedSteps, nil
+		}
+	}
+
+	if len(selectedRelationships) == 0 {
+		// The result was not reached through any relationship (for example, the subject is the
+		// resource itself), so there is nothing to reduce.
+		return relationships, steps, nil
+	}
+
+	return selectedRelationships, selectedSteps, nil
+}
+
+type rewriteKind int
+
+const (
+	rewriteKindUnion rewriteKind = iota
+	rewriteKindIntersection
+	rewriteKindOther
+)
+
+// explainPermission selects the steps, and the relationships walked by arrows to reach them,
+// which explain the result of a permission.
+func (ce *checkExplainer) explainPermission(
+	ctx context.Context,
+	req *dispatch.DispatchCheckRequest,
+	rewrite *core.UsersetRewrite,
+	result v1.CheckDebugTrace_Permissionship,
+	steps []explainedStep,
+) ([]explainedRelationship, []explainedStep, error) {
+	arrows := mapz.NewMultiMap[string, string]()
+	kind := collectArrows(rewrite, arrows)
+
+	// Find the relationships walked by arrows to reach the steps on other resources.
+	var selectors []datastore.SubjectsSelector
+	for _, step := range steps {
+		if step.trace.Request.ResourceRelation.Namespace == req.ResourceRelation.Namespace &&
+			slices.Equal(step.trace.Request.ResourceIds, req.ResourceIds) {
+			continue
+		}
+
+		selectors = append(selectors, datastore.SubjectsSelector{
+			OptionalSubjectType: step.trace.Request.ResourceRelation.Namespace,
+			OptionalSubjectIds:  step.trace.Request.ResourceIds,
+		})
+	}
+
+	stepRelationships := make([][]explainedRelationship, len(steps))
+	if len(selectors) > 0 && !arrows.IsEmpty() {
+		relationships, err := ce.relationshipsFor(ctx, datastore.RelationshipsFilter{
+			OptionalResourceType:      req.ResourceRelation.Namespace,
+			OptionalResourceIds:       req.ResourceIds,
+			OptionalSubjectsSelectors: selectors,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, rel := range relationships {
+			computedRelations, ok := arrows.Get(rel.tpl.ResourceAndRelation.Relation)
+			if !ok {
+				continue
+			}
+
+			for index, step := range steps {
+				if slices.Contains(computedRelations, step.trace.Request.ResourceRelation.Relation) &&
+					isStepForObject(step, rel.tpl.Subject) {
+					stepRelationships[index] = append(stepRelationships[index], rel)
+				}
+			}
+		}
+	}
+
+	// The result of a step reached by an arrow also depends on the relationships walked.
+	stepResults := make([]v1.CheckDebugTrace_Permissionship, len(steps))
+	for index, step := range steps {
+		stepResults[index] = step.explanation.Result
+		if len(stepRelationships[index]) > 0 {
+			best := v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+			for _, rel := range stepRelationships[index] {
+				best = bestResult(best, rel.result)
+			}
+			stepResults[index] = combineResults(stepResults[index], best)
+		}
+	}
+
+	selectAll := func(keep func(v1.CheckDebugTrace_Permissionship) bool) ([]explainedRelationship, []explainedStep) {
+		var selectedRelationships []explainedRelationship
+		var selectedSteps []explainedStep
+		for index, step := range steps {
+			if !keep(stepResults[index]) {
+				continue
+			}
+
+			selectedSteps = append(selectedSteps, step)
+			for _, rel := range stepRelationships[index] {
+				if rel.result == v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION && stepResults[index] != v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION {
+					continue
+				}
+				selectedRelationships = append(selectedRelationships, rel)
+			}
+		}
+		return selectedRelationships, selectedSteps
+	}
+
+	selectFirst := func(wanted v1.CheckDebugTrace_Permissionship) ([]explainedRelationship, []explainedStep, bool) {
+		for index, step := range steps {
+			if stepResults[index] != wanted {
+				continue
+			}
+
+			var selectedRelationships []explainedRelationship
+			for _, rel := range stepRelationships[index] {
+				if rel.result == wanted {
+					selectedRelationships = append(selectedRelationships, rel)
+					break
+				}
+			}
+			return selectedRelationships, []explainedStep{step}, true
+		}
+		return nil, nil, false
+	}
+
+	switch {
+	case kind == rewriteKindUnion && result == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION:
+		if relationships, selected, ok := selectFirst(v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION); ok {
+			return relationships, selected, nil
+		}
+
+	case kind == rewriteKindUnion && result == v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION:
+		relationships, selected := selectAll(func(result v1.CheckDebugTrace_Permissionship) bool {
+			return result != v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+		})
+		return relationships, selected, nil
+
+	case kind == rewriteKindIntersection && result == v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION:
+		if relationships, selected, ok := selectFirst(v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION); ok {
+			return relationships, selected, nil
+		}
+	}
+
+	relationships, selected := selectAll(func(v1.CheckDebugTrace_Permissionship) bool { return true })
+	return relationships, selected, nil
+}
+
+// collectArrows adds the computed relations of each arrow in the rewrite to the multimap, keyed by
+// the tupleset relation, and returns the kind of the rewrite. Rewrites which contain nested
+// rewrites or intersection arrows are reported as rewriteKindOther, as a single branch cannot be
+// selected from them.
+func collectArrows(rewrite *core.UsersetRewrite, arrows *mapz.MultiMap[string, string]) rewriteKind {
+	var kind rewriteKind
+	var setOp *core.SetOperation
+	switch rw := rewrite.RewriteOperation.(type) {
+	case *core.UsersetRewrite_Union:
+		kind = rewriteKindUnion
+		setOp = rw.Union
+	case *core.UsersetRewrite_Intersection:
+		kind = rewriteKindIntersection
+		setOp = rw.Intersection
+	case *core.UsersetRewrite_Exclusion:
+		kind = rewriteKindOther
+		setOp = rw.Exclusion
+	default:
+		return rewriteKindOther
+	}
+
+	for _, child := range setOp.Child {
+		switch child := child.ChildType.(type) {
+		case *core.SetOperation_Child_UsersetRewrite:
+			collectArrows(child.UsersetRewrite, arrows)
+			kind = rewriteKindOther
+
+		case *core.SetOperation_Child_TupleToUserset:
+			arrows.Add(child.TupleToUserset.Tupleset.Relation, child.TupleToUserset.ComputedUserset.Relation)
+			if child.TupleToUserset.Function == core.TupleToUserset_FUNCTION_ALL {
+				kind = rewriteKindOther
+			}
+		}
+	}
+	return kind
+}
+
+// relationshipsFor returns the relationships matching the filter, with any caveats evaluated.
+func (ce *checkExplainer) relationshipsFor(ctx context.Context, filter datastore.RelationshipsFilter) ([]explainedRelationship, error) {
+	it, err := ce.reader.QueryRelationships(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var relationships []explainedRelationship
+	for tpl := it.Next(); tpl != nil; tpl = it.Next() {
+		rel := explainedRelationship{
+			tpl: tpl,
+			explained: &extensionsv1.ExplainedRelationship{
+				Relationship: tuple.MustToRelationship(tpl),
+			},
+			result: v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION,
+		}
+
+		if tpl.Caveat != nil && tpl.Caveat.CaveatName != "" {
+			ce.caveatNames.Add(tpl.Caveat.CaveatName)
+
+			caveatEvalInfo, err := convertCaveatEvaluation(ctx, cexpr.CaveatAsExpr(tpl.Caveat), ce.caveatContext, ce.reader)
+			if err != nil {
+				return nil, err
+			}
+
+			rel.explained.CaveatEvaluationInfo = caveatEvalInfo
+			rel.result = resultForCaveatEvaluation(caveatEvalInfo)
+		}
+
+		relationships = append(relationships, rel)
+	}
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+
+	return relationships, nil
+}
+
+// resultOf returns the result of the trace, evaluating its caveat expression if it was
+// conditional.
+func (ce *checkExplainer) resultOf(ctx context.Context, trace *dispatch.CheckDebugTrace) (v1.CheckDebugTrace_Permissionship, *v1.CaveatEvalInfo, error) {
+	result := v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+	var caveatEvalInfo *v1.CaveatEvalInfo
+	for _, resourceID := range trace.Request.ResourceIds {
+		checkResult, ok := trace.Results[resourceID]
+		if !ok {
+			continue
+		}
+
+		switch checkResult.Membership {
+		case dispatch.ResourceCheckResult_MEMBER:
+			return v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION, nil, nil
+
+		case dispatch.ResourceCheckResult_CAVEATED_MEMBER:
+			evalInfo, err := convertCaveatEvaluation(ctx, checkResult.Expression, ce.caveatContext, ce.reader)
+			if err != nil {
+				return result, nil, err
+			}
+
+			evalResult := resultForCaveatEvaluation(evalInfo)
+			if evalResult == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION || caveatEvalInfo == nil {
+				result = bestResult(result, evalResult)
+				caveatEvalInfo = evalInfo
+			}
+		}
+	}
+	return result, caveatEvalInfo, nil
+}
+
+func (ce *checkExplainer) lookupRelation(ctx context.Context, rr *core.RelationReference) (*core.Relation, string, error) {
+	definition, ok := ce.definitions[rr.Namespace]
+	if !ok {
+		found, _, err := ce.reader.ReadNamespaceByName(ctx, rr.Namespace)
+		if err != nil {
+			return nil, "", err
+		}
+		ce.definitions[rr.Namespace] = found
+		definition = found
+	}
+
+	for _, relation := range definition.Relation {
+		if relation.Name != rr.Relation {
+			continue
+		}
+
+		schema, _, err := generator.GenerateRelationSource(relation)
+		if err != nil {
+			return nil, "", err
+		}
+		return relation, schema, nil
+	}
+
+	return nil, "", spiceerrors.MustBugf("relation `%s` not found under definition `%s`", rr.Relation, rr.Namespace)
+}
+
+// schemaUsed generates the schema of the definitions and caveats referenced in the explanation.
+func (ce *checkExplainer) schemaUsed(ctx context.Context) (string, error) {
+	caveatNames := ce.caveatNames.AsSlice()
+	caveats, err := ce.reader.LookupCaveatsWithNames(ctx, caveatNames)
+	if err != nil {
+		return "", err
+	}
+
+	defs := make([]compiler.SchemaDefinition, 0, len(caveats)+len(ce.definitions))
+	for _, caveat := range caveats {
+		defs = append(defs, caveat.Definition)
+	}
+	for _, definition := range ce.definitions {
+		defs = append(defs, definition)
+	}
+
+	slices.SortFunc(defs, func(a, b compiler.SchemaDefinition) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	schema, _, err := generator.GenerateSchema(defs)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(schema), nil
+}
+
+func traceKey(trace *dispatch.CheckDebugTrace) string {
+	return tuple.StringRR(trace.Request.ResourceRelation) + ":" + strings.Join(trace.Request.ResourceIds, ",")
+}
+
+func permissionTypeOf(relation *core.Relation) v1.CheckDebugTrace_PermissionType {
+	if relation.UsersetRewrite == nil {
+		return v1.CheckDebugTrace_PERMISSION_TYPE_RELATION
+	}
+	return v1.CheckDebugTrace_PERMISSION_TYPE_PERMISSION
+}
+
+func isStepForObject(step explainedStep, object *core.ObjectAndRelation) bool {
+	return step.trace.Request.ResourceRelation.Namespace == object.Namespace &&
+		slices.Contains(step.trace.Request.ResourceIds, object.ObjectId)
+}
+
+func isStepForSubject(step explainedStep, subject *core.ObjectAndRelation) bool {
+	return isStepForObject(step, subject) && step.trace.Request.ResourceRelation.Relation == subject.Relation
+}
+
+func resultForCaveatEvaluation(caveatEvalInfo *v1.CaveatEvalInfo) v1.CheckDebugTrace_Permissionship {
+	switch caveatEvalInfo.Result {
+	case v1.CaveatEvalInfo_RESULT_TRUE:
+		return v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION
+	case v1.CaveatEvalInfo_RESULT_MISSING_SOME_CONTEXT:
+		return v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION
+	default:
+		return v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+	}
+}
+
+// combineResults returns the result of requiring both results.
+func combineResults(first, second v1.CheckDebugTrace_Permissionship) v1.CheckDebugTrace_Permissionship {
+	if first == v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION || second == v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION {
+		return v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+	}
+	if first == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION && second == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION {
+		return v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION
+	}
+	return v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION
+}
+
+// bestResult returns the result of requiring either result.
+func bestResult(first, second v1.CheckDebugTrace_Permissionship) v1.CheckDebugTrace_Permissionship {
+	if first == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION || second == v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION {
+		return v1.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION
+	}
+	if first == v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION || second == v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION {
+		return v1.CheckDebugTrace_PERMISSIONSHIP_CONDITIONAL_PERMISSION
+	}
+	return v1.CheckDebugTrace_PERMISSIONSHIP_NO_PERMISSION
+}
@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	grpcvalidate "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/authzed/spicedb/internal/dispatch"
+	"github.com/authzed/spicedb/internal/graph/computed"
+	"github.com/authzed/spicedb/internal/middleware"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/internal/middleware/usagemetrics"
+	"github.com/authzed/spicedb/internal/namespace"
+	"github.com/authzed/spicedb/internal/services/shared"
+	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/middleware/consistency"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+	"github.com/authzed/spicedb/pkg/spiceerrors"
+)
+
+// ExtensionsServerConfig is configuration for the extensions server.
+type ExtensionsServerConfig struct {
+	// WatchEnabled indicates whether the extended Watch API is enabled. Should match whether
+	// the V1 watch service is enabled.
+	WatchEnabled bool
+
+	// WatchHeartbeatDuration is the heartbeat duration for the extended Watch API.
+	WatchHeartbeatDuration time.Duration
+}
+
+type extensionsServer struct {
+	extensionsv1.UnimplementedExtensionsServiceServer
+	shared.WithServiceSpecificInterceptors
+
+	dispatch         dispatch.Dispatcher
+	permServerConfig PermissionsServerConfig
+	config           ExtensionsServerConfig
+	watch            *watchServer
+	permissions      *permissionServer
+}
+
+// NewExtensionsServer creates an instance of the server for the SpiceDB-specific extensions API.
+func NewExtensionsServer(dispatch dispatch.Dispatcher, permServerConfig PermissionsServerConfig, config ExtensionsServerConfig) extensionsv1.ExtensionsServiceServer {
+	return &extensionsServer{
+		WithServiceSpecificInterceptors: shared.WithServiceSpecificInterceptors{
+			Unary: middleware.ChainUnaryServer(
+				grpcvalidate.UnaryServerInterceptor(),
+				usagemetrics.UnaryServerInterceptor(),
+			),
+			Stream: grpcvalidate.StreamServerInterceptor(),
+		},
+		dispatch:         dispatch,
+		permServerConfig: permServerConfig,
+		config:           config,
+		watch: &watchServer{
+			heartbeatDuration: config.WatchHeartbeatDuration,
+		},
+		permissions: &permissionServer{
+			dispatch: dispatch,
+			config:   permServerConfig,
+		},
+	}
+}
+
+func (es *extensionsServer) Watch(req *extensionsv1.WatchRequest, stream extensionsv1.ExtensionsService_WatchServer) error {
+	if !es.config.WatchEnabled {
+		return status.Errorf(codes.Unimplemented, "watch is not enabled")
+	}
+
+	var content datastore.WatchContent
+	for _, kind := range req.OptionalUpdateKinds {
+		switch kind {
+		case extensionsv1.WatchKind_WATCH_KIND_UNSPECIFIED, extensionsv1.WatchKind_WATCH_KIND_INCLUDE_RELATIONSHIP_UPDATES:
+			content |= datastore.WatchRelationships
+
+		case extensionsv1.WatchKind_WATCH_KIND_INCLUDE_SCHEMA_UPDATES:
+			content |= datastore.WatchSchema
+
+		case extensionsv1.WatchKind_WATCH_KIND_INCLUDE_CHECKPOINTS:
+			content |= datastore.WatchCheckpoints
+		}
+	}
+
+	if content&(datastore.WatchRelationships|datastore.WatchSchema) == 0 {
+		content |= datastore.WatchRelationships
+	}
+
+	return es.watch.watch(stream.Context(), watchParameters{
+		objectTypes:         req.OptionalObjectTypes,
+		startCursor:         req.OptionalStartCursor,
+		relationshipFilters: req.OptionalRelationshipFilters,
+		content:             content,
+	}, func(update watchUpdate) error {
+		return stream.Send(&extensionsv1.WatchResponse{
+			Updates:                     update.relationshipUpdates,
+			ChangesThrough:              update.changesThrough,
+			SchemaUpdates:               update.schemaUpdates,
+			IsCheckpoint:                update.isCheckpoint,
+			OptionalTransactionMetadata: update.transactionMetadata,
+		})
+	})
+}
+
+func (es *extensionsServer) rewriteError(ctx context.Context, err error) error {
+	return shared.RewriteError(ctx, err, &shared.ConfigForErrors{
+		MaximumAPIDepth: es.permServerConfig.MaximumAPIDepth,
+	})
+}
+
+func (es *extensionsServer) ExplainCheckPermission(ctx context.Context, req *extensionsv1.ExplainCheckPermissionRequest) (*extensionsv1.ExplainCheckPermissionResponse, error) {
+	atRevision, checkedAt, err := consistency.RevisionFromContext(ctx)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	ds := datastoremw.MustFromContext(ctx).SnapshotReader(atRevision)
+
+	caveatContext, err := GetCaveatContext(ctx, req.Context, es.permServerConfig.MaxCaveatContextSize)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	if err := namespace.CheckNamespaceAndRelations(ctx,
+		[]namespace.TypeAndRelationToCheck{
+			{
+				NamespaceName: req.Resource.ObjectType,
+				RelationName:  req.Permission,
+				AllowEllipsis: false,
+			},
+			{
+				NamespaceName: req.Subject.Object.ObjectType,
+				RelationName:  normalizeSubjectRelation(req.Subject),
+				AllowEllipsis: true,
+			},
+		}, ds); err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	resourceType := &core.RelationReference{
+		Namespace: req.Resource.ObjectType,
+		Relation:  req.Permission,
+	}
+
+	// Trace debugging disables batching, ensuring that the trace holds every subproblem of the
+	// check which was not served from the dispatch cache.
+	cr, metadata, err := computed.ComputeCheck(ctx, es.dispatch,
+		computed.CheckParameters{
+			ResourceType: resourceType,
+			Subject: &core.ObjectAndRelation{
+				Namespace: req.Subject.Object.ObjectType,
+				ObjectId:  req.Subject.Object.ObjectId,
+				Relation:  normalizeSubjectRelation(req.Subject),
+			},
+			CaveatContext: caveatContext,
+			AtRevision:    atRevision,
+			MaximumDepth:  es.permServerConfig.MaximumAPIDepth,
+			DebugOption:   computed.TraceDebuggingEnabled,
+		},
+		req.Resource.ObjectId,
+	)
+	usagemetrics.SetInContext(ctx, metadata)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	if metadata.DebugInfo == nil || metadata.DebugInfo.Check == nil {
+		return nil, es.rewriteError(ctx, spiceerrors.MustBugf("missing debug information for explained check"))
+	}
+
+	explainer := newCheckExplainer(caveatContext, ds)
+	explanation, err := explainer.explainRoot(ctx, resourceType, metadata.DebugInfo.Check)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	schemaUsed, err := explainer.schemaUsed(ctx)
+	if err != nil {
+		return nil, es.rewriteError(ctx, err)
+	}
+
+	permissionship, partialCaveat := checkResultToAPITypes(cr)
+
+	return &extensionsv1.ExplainCheckPermissionResponse{
+		CheckedAt:         checkedAt,
+		Permissionship:    permissionship,
+		PartialCaveatInfo: partialCaveat,
+		Explanation:       explanation,
+		SchemaUsed:        schemaUsed,
+	}, nil
+}
@@ -0,0 +1,987 @@
+package v1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/authzed/grpcutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	v1svc "github.com/authzed/spicedb/internal/services/v1"
+	"github.com/authzed/spicedb/internal/testfixtures"
+	"github.com/authzed/spicedb/internal/testserver"
+	"github.com/authzed/spicedb/pkg/datastore"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+	"github.com/authzed/spicedb/pkg/zedtoken"
+)
+
+func TestExtensionsWatchSchema(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := extensionsv1.NewExtensionsServiceClient(conn).Watch(ctx, &extensionsv1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+		OptionalUpdateKinds: []extensionsv1.WatchKind{
+			extensionsv1.WatchKind_WATCH_KIND_INCLUDE_RELATIONSHIP_UPDATES,
+			extensionsv1.WatchKind_WATCH_KIND_INCLUDE_SCHEMA_UPDATES,
+		},
+	})
+	require.NoError(err)
+
+	responses := make(chan *extensionsv1.WatchResponse, 10)
+	go func() {
+		defer close(responses)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			responses <- resp
+		}
+	}()
+
+	receive := func() *extensionsv1.WatchResponse {
+		select {
+		case resp := <-responses:
+			require.NotNil(resp)
+			return resp
+		case <-time.After(3 * time.Second):
+			require.FailNow("timed out waiting for watch response")
+			return nil
+		}
+	}
+
+	schemaClient := v1.NewSchemaServiceClient(conn)
+	existing, err := schemaClient.ReadSchema(context.Background(), &v1.ReadSchemaRequest{})
+	require.NoError(err)
+
+	// Add a definition and a caveat.
+	_, err = schemaClient.WriteSchema(context.Background(), &v1.WriteSchemaRequest{
+		Schema: existing.SchemaText + `
+
+definition example/newdefinition {}
+
+caveat example/newcaveat(somecondition int) {
+	somecondition == 42
+}`,
+	})
+	require.NoError(err)
+
+	resp := receive()
+	require.NotNil(resp.ChangesThrough)
+	require.Empty(resp.Updates)
+
+	written := map[string]*extensionsv1.SchemaUpdate{}
+	for _, update := range resp.SchemaUpdates {
+		require.Equal(extensionsv1.SchemaUpdate_OPERATION_WRITE, update.Operation)
+		written[update.DefinitionName] = update
+	}
+
+	require.Contains(written, "example/newdefinition")
+	require.Equal(extensionsv1.SchemaUpdate_DEFINITION_KIND_OBJECT, written["example/newdefinition"].DefinitionKind)
+	require.Equal("definition example/newdefinition {}", written["example/newdefinition"].SchemaText)
+
+	require.Contains(written, "example/newcaveat")
+	require.Equal(extensionsv1.SchemaUpdate_DEFINITION_KIND_CAVEAT, written["example/newcaveat"].DefinitionKind)
+	require.Contains(written["example/newcaveat"].SchemaText, "somecondition == 42")
+
+	// Remove them again.
+	_, err = schemaClient.WriteSchema(context.Background(), &v1.WriteSchemaRequest{
+		Schema: existing.SchemaText,
+	})
+	require.NoError(err)
+
+	deleted := map[string]extensionsv1.SchemaUpdate_DefinitionKind{}
+	for len(deleted) < 2 {
+		resp := receive()
+		for _, update := range resp.SchemaUpdates {
+			if update.Operation == extensionsv1.SchemaUpdate_OPERATION_DELETE {
+				deleted[update.DefinitionName] = update.DefinitionKind
+			}
+		}
+	}
+
+	require.Equal(map[string]extensionsv1.SchemaUpdate_DefinitionKind{
+		"example/newdefinition": extensionsv1.SchemaUpdate_DEFINITION_KIND_OBJECT,
+		"example/newcaveat":     extensionsv1.SchemaUpdate_DEFINITION_KIND_CAVEAT,
+	}, deleted)
+
+	// Relationship updates are returned on the same stream.
+	_, err = v1.NewPermissionsServiceClient(conn).WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	for {
+		resp := receive()
+		if len(resp.Updates) > 0 {
+			require.Equal([]*v1.RelationshipUpdate{
+				update(v1.RelationshipUpdate_OPERATION_TOUCH, "document", "document1", "viewer", "user", "user1"),
+			}, resp.Updates)
+			break
+		}
+	}
+}
+
+func TestExtensionsWatchSchemaWithRelationshipFilters(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := extensionsv1.NewExtensionsServiceClient(conn).Watch(ctx, &extensionsv1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+		OptionalRelationshipFilters: []*v1.RelationshipFilter{
+			{ResourceType: "document"},
+		},
+		OptionalUpdateKinds: []extensionsv1.WatchKind{
+			extensionsv1.WatchKind_WATCH_KIND_INCLUDE_RELATIONSHIP_UPDATES,
+			extensionsv1.WatchKind_WATCH_KIND_INCLUDE_SCHEMA_UPDATES,
+		},
+	})
+	require.NoError(err)
+
+	schemaClient := v1.NewSchemaServiceClient(conn)
+	existing, err := schemaClient.ReadSchema(context.Background(), &v1.ReadSchemaRequest{})
+	require.NoError(err)
+
+	// Add a definition whose relationships cannot match the filter, and change the document
+	// definition, whose relationships can.
+	updatedSchema := strings.Replace(existing.SchemaText, "definition document {", "definition document {\n\trelation newrelation: user", 1)
+	require.NotEqual(existing.SchemaText, updatedSchema)
+
+	_, err = schemaClient.WriteSchema(context.Background(), &v1.WriteSchemaRequest{
+		Schema: updatedSchema + "\n\ndefinition example/newdefinition {}",
+	})
+	require.NoError(err)
+
+	// Write a relationship, to know when all the schema updates have been received.
+	_, err = v1.NewPermissionsServiceClient(conn).WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "newrelation", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	written := map[string]struct{}{}
+	for {
+		resp, err := stream.Recv()
+		require.NoError(err)
+
+		for _, update := range resp.SchemaUpdates {
+			if update.DefinitionKind == extensionsv1.SchemaUpdate_DEFINITION_KIND_OBJECT {
+				written[update.DefinitionName] = struct{}{}
+			}
+		}
+
+		if len(resp.Updates) > 0 {
+			break
+		}
+	}
+
+	require.Equal(map[string]struct{}{"document": {}}, written)
+}
+
+func TestExtensionsWatchDefaultsToRelationships(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := extensionsv1.NewExtensionsServiceClient(conn).Watch(ctx, &extensionsv1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+	})
+	require.NoError(err)
+
+	schemaClient := v1.NewSchemaServiceClient(conn)
+	existing, err := schemaClient.ReadSchema(context.Background(), &v1.ReadSchemaRequest{})
+	require.NoError(err)
+
+	_, err = schemaClient.WriteSchema(context.Background(), &v1.WriteSchemaRequest{
+		Schema: existing.SchemaText + "\n\ndefinition example/newdefinition {}",
+	})
+	require.NoError(err)
+
+	_, err = v1.NewPermissionsServiceClient(conn).WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	// The first response must be the relationship update, as schema updates were not requested.
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.Empty(resp.SchemaUpdates)
+	require.Len(resp.Updates, 1)
+}
+
+func TestExtensionsWatchInvalidArguments(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	stream, err := extensionsv1.NewExtensionsServiceClient(conn).Watch(context.Background(), &extensionsv1.WatchRequest{
+		OptionalObjectTypes: []string{"document"},
+		OptionalRelationshipFilters: []*v1.RelationshipFilter{
+			{ResourceType: "document"},
+		},
+	})
+	require.NoError(err)
+
+	_, err = stream.Recv()
+	grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+}
+
+func TestExtensionsWatchCheckpoints(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := extensionsv1.NewExtensionsServiceClient(conn).Watch(ctx, &extensionsv1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+		OptionalUpdateKinds: []extensionsv1.WatchKind{
+			extensionsv1.WatchKind_WATCH_KIND_INCLUDE_CHECKPOINTS,
+		},
+	})
+	require.NoError(err)
+
+	written, err := v1.NewPermissionsServiceClient(conn).WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	// Relationship updates are included by default, followed by a checkpoint at the same revision.
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.False(resp.IsCheckpoint)
+	require.Len(resp.Updates, 1)
+	require.Equal(written.WrittenAt.Token, resp.ChangesThrough.Token)
+
+	resp, err = stream.Recv()
+	require.NoError(err)
+	require.True(resp.IsCheckpoint)
+	require.Empty(resp.Updates)
+	require.Equal(written.WrittenAt.Token, resp.ChangesThrough.Token)
+}
+
+func TestExtensionsWatchTransactionMetadata(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := extensionsv1.NewExtensionsServiceClient(conn).Watch(ctx, &extensionsv1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+	})
+	require.NoError(err)
+
+	v1Stream, err := v1.NewWatchServiceClient(conn).Watch(ctx, &v1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+	})
+	require.NoError(err)
+
+	client := v1.NewPermissionsServiceClient(conn)
+	writeMetadata, err := structpb.NewStruct(map[string]any{"reason": "ticket-1234", "actor": "tom"})
+	require.NoError(err)
+	written, err := client.WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+		},
+		OptionalTransactionMetadata: writeMetadata,
+	})
+	require.NoError(err)
+
+	// The metadata of requests which do not set it may be given in a header.
+	deleteCtx := metadata.AppendToOutgoingContext(context.Background(), v1svc.TransactionMetadataHeader, `{"reason": "ticket-5678"}`)
+	deleted, err := client.DeleteRelationships(deleteCtx, &v1.DeleteRelationshipsRequest{
+		RelationshipFilter: &v1.RelationshipFilter{ResourceType: "document", OptionalResourceId: "document1"},
+	})
+	require.NoError(err)
+
+	// Changes made without metadata have none.
+	unannotated, err := client.WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document2", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	for _, expected := range []struct {
+		token    string
+		metadata map[string]any
+	}{
+		{written.WrittenAt.Token, map[string]any{"reason": "ticket-1234", "actor": "tom"}},
+		{deleted.DeletedAt.Token, map[string]any{"reason": "ticket-5678"}},
+		{unannotated.WrittenAt.Token, nil},
+	} {
+		resp, err := stream.Recv()
+		require.NoError(err)
+		require.Equal(expected.token, resp.ChangesThrough.Token)
+
+		v1Resp, err := v1Stream.Recv()
+		require.NoError(err)
+		require.Equal(expected.token, v1Resp.ChangesThrough.Token)
+
+		if expected.metadata == nil {
+			require.Nil(resp.OptionalTransactionMetadata)
+			require.Nil(v1Resp.OptionalTransactionMetadata)
+		} else {
+			require.Equal(expected.metadata, resp.OptionalTransactionMetadata.AsMap())
+			require.Equal(expected.metadata, v1Resp.OptionalTransactionMetadata.AsMap())
+		}
+	}
+}
+
+func TestInvalidTransactionMetadata(t *testing.T) {
+	conn, cleanup, _, _ := testserver.NewTestServer(require.New(t), 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	valid, err := structpb.NewStruct(map[string]any{"reason": "ticket-1234"})
+	require.NoError(t, err)
+
+	tooLarge, err := structpb.NewStruct(map[string]any{"reason": strings.Repeat("a", 5000)})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		header   string
+		metadata *structpb.Struct
+	}{
+		{"not json", "ticket-1234", nil},
+		{"not an object", `["ticket-1234"]`, nil},
+		{"too large", fmt.Sprintf(`{"reason": "%s"}`, strings.Repeat("a", 5000)), nil},
+		{"too large in request", "", tooLarge},
+		{"in request and header", `{"reason": "ticket-1234"}`, valid},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, v1svc.TransactionMetadataHeader, tc.header)
+			}
+
+			_, err := v1.NewPermissionsServiceClient(conn).WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+				Updates: []*v1.RelationshipUpdate{
+					update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+				},
+				OptionalTransactionMetadata: tc.metadata,
+			})
+			grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+		})
+	}
+}
+
+func TestExplainCheckPermission(t *testing.T) {
+	tcs := []struct {
+		name                  string
+		resource              string
+		permission            string
+		subject               string
+		expectedPermission    v1.CheckPermissionResponse_Permissionship
+		expectedRelationships []string
+		expectedSteps         []string
+	}{
+		{
+			"direct relationship",
+			"document:masterplan",
+			"view",
+			"user:eng_lead",
+			v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+			[]string{"document:masterplan#viewer@user:eng_lead"},
+			[]string{"document:masterplan#view", "document:masterplan#viewer"},
+		},
+		{
+			"arrows and subject sets",
+			"document:masterplan",
+			"view",
+			"user:auditor",
+			v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+			[]string{
+				"document:masterplan#parent@folder:strategy",
+				"folder:strategy#parent@folder:company",
+				"folder:company#viewer@folder:auditors#viewer",
+				"folder:auditors#viewer@user:auditor",
+			},
+			[]string{
+				"document:masterplan#view",
+				"folder:strategy#view",
+				"folder:company#view",
+				"folder:company#viewer",
+				"folder:auditors#viewer",
+			},
+		},
+		{
+			"denied intersection",
+			"document:specialplan",
+			"view_and_edit",
+			"user:missingrolegal",
+			v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
+			nil,
+			[]string{
+				"document:specialplan#view_and_edit",
+				"document:specialplan#edit",
+				"document:specialplan#editor",
+				"document:specialplan#owner",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+			t.Cleanup(cleanup)
+
+			resource, subject := tuple.ParseONR(tc.resource+"#"+tc.permission), tuple.ParseSubjectONR(tc.subject)
+			resp, err := extensionsv1.NewExtensionsServiceClient(conn).ExplainCheckPermission(context.Background(), &extensionsv1.ExplainCheckPermissionRequest{
+				Consistency: &v1.Consistency{
+					Requirement: &v1.Consistency_AtLeastAsFresh{
+						AtLeastAsFresh: zedtoken.MustNewFromRevision(revision),
+					},
+				},
+				Resource:   &v1.ObjectReference{ObjectType: resource.Namespace, ObjectId: resource.ObjectId},
+				Permission: resource.Relation,
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{ObjectType: subject.Namespace, ObjectId: subject.ObjectId},
+				},
+			})
+			require.NoError(err)
+			require.Equal(tc.expectedPermission, resp.Permissionship)
+			require.NotEmpty(resp.SchemaUsed)
+
+			var relationships, steps []string
+			collectExplanation(resp.Explanation, &relationships, &steps)
+			require.Equal(tc.expectedRelationships, relationships)
+			require.Equal(tc.expectedSteps, steps)
+		})
+	}
+}
+
+func TestExplainCheckPermissionWithCaveats(t *testing.T) {
+	tcs := []struct {
+		name                 string
+		context              map[string]any
+		expectedPermission   v1.CheckPermissionResponse_Permissionship
+		expectedCaveatResult v1.CaveatEvalInfo_Result
+	}{
+		{
+			"caveat true",
+			map[string]any{"secret": "1234"},
+			v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+			v1.CaveatEvalInfo_RESULT_TRUE,
+		},
+		{
+			"caveat false",
+			map[string]any{"secret": "4321"},
+			v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
+			v1.CaveatEvalInfo_RESULT_FALSE,
+		},
+		{
+			"missing context",
+			nil,
+			v1.CheckPermissionResponse_PERMISSIONSHIP_CONDITIONAL_PERMISSION,
+			v1.CaveatEvalInfo_RESULT_MISSING_SOME_CONTEXT,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithCaveatedData)
+			t.Cleanup(cleanup)
+
+			var caveatContext *structpb.Struct
+			if tc.context != nil {
+				converted, err := structpb.NewStruct(tc.context)
+				require.NoError(err)
+				caveatContext = converted
+			}
+
+			resp, err := extensionsv1.NewExtensionsServiceClient(conn).ExplainCheckPermission(context.Background(), &extensionsv1.ExplainCheckPermissionRequest{
+				Consistency: &v1.Consistency{
+					Requirement: &v1.Consistency_AtLeastAsFresh{
+						AtLeastAsFresh: zedtoken.MustNewFromRevision(revision),
+					},
+				},
+				Resource:   &v1.ObjectReference{ObjectType: "document", ObjectId: "masterplan"},
+				Permission: "viewer",
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{ObjectType: "user", ObjectId: "eng_lead"},
+				},
+				Context: caveatContext,
+			})
+			require.NoError(err)
+			require.Equal(tc.expectedPermission, resp.Permissionship)
+			require.Contains(resp.SchemaUsed, "caveat test")
+
+			explanation := resp.Explanation
+			require.Len(explanation.Relationships, 1)
+			require.Equal("document:masterplan#viewer@user:eng_lead[test:{\"expectedSecret\":\"1234\"}]", tuple.MustStringRelationship(explanation.Relationships[0].Relationship))
+
+			caveatEvalInfo := explanation.Relationships[0].CaveatEvaluationInfo
+			require.NotNil(caveatEvalInfo)
+			require.Equal("test", caveatEvalInfo.CaveatName)
+			require.Equal("secret == expectedSecret", caveatEvalInfo.Expression)
+			require.Equal(tc.expectedCaveatResult, caveatEvalInfo.Result)
+			require.Equal("1234", caveatEvalInfo.Context.Fields["expectedSecret"].GetStringValue())
+		})
+	}
+}
+
+func collectExplanation(explanation *extensionsv1.CheckExplanation, relationships *[]string, steps *[]string) {
+	*steps = append(*steps, tuple.StringObjectRef(explanation.Resource)+"#"+explanation.Permission)
+	for _, rel := range explanation.Relationships {
+		*relationships = append(*relationships, tuple.MustStringRelationship(rel.Relationship))
+	}
+	for _, step := range explanation.Steps {
+		collectExplanation(step, relationships, steps)
+	}
+}
+
+func TestLookupPermissions(t *testing.T) {
+	tcs := []struct {
+		name             string
+		subject          string
+		resourceTypes    []string
+		includeRelations bool
+		expected         []string
+	}{
+		{
+			"permissions",
+			"user:auditor",
+			nil,
+			false,
+			[]string{
+				"document:companyplan#view",
+				"document:masterplan#view",
+				"folder:auditors#view",
+				"folder:company#view",
+				"folder:strategy#view",
+			},
+		},
+		{
+			"permissions and relations",
+			"user:auditor",
+			nil,
+			true,
+			[]string{
+				"document:companyplan#view",
+				"document:masterplan#view",
+				"folder:auditors#view",
+				"folder:auditors#viewer",
+				"folder:company#view",
+				"folder:company#viewer",
+				"folder:strategy#view",
+			},
+		},
+		{
+			"filtered by resource type",
+			"user:multiroleguy",
+			[]string{"document"},
+			false,
+			[]string{
+				"document:specialplan#edit",
+				"document:specialplan#view",
+				"document:specialplan#view_and_edit",
+			},
+		},
+		{
+			"unknown subject",
+			"user:unknown",
+			nil,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			for _, limit := range []uint32{0, 1, 2, 100} {
+				limit := limit
+				t.Run(fmt.Sprintf("limit-%d", limit), func(t *testing.T) {
+					require := require.New(t)
+
+					conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+					t.Cleanup(cleanup)
+
+					client := extensionsv1.NewExtensionsServiceClient(conn)
+					subject := tuple.ParseSubjectONR(tc.subject)
+
+					var found []string
+					var currentCursor *v1.Cursor
+					for i := 0; i < 20; i++ {
+						stream, err := client.LookupPermissions(context.Background(), &extensionsv1.LookupPermissionsRequest{
+							Consistency: &v1.Consistency{
+								Requirement: &v1.Consistency_AtLeastAsFresh{
+									AtLeastAsFresh: zedtoken.MustNewFromRevision(revision),
+								},
+							},
+							Subject: &v1.SubjectReference{
+								Object: &v1.ObjectReference{ObjectType: subject.Namespace, ObjectId: subject.ObjectId},
+							},
+							OptionalResourceTypes: tc.resourceTypes,
+							IncludeRelations:      tc.includeRelations,
+							OptionalLimit:         limit,
+							OptionalCursor:        currentCursor,
+						})
+						require.NoError(err)
+
+						var count uint32
+						for {
+							resp, err := stream.Recv()
+							if errors.Is(err, io.EOF) {
+								break
+							}
+							require.NoError(err)
+							require.Equal(v1.LookupPermissionship_LOOKUP_PERMISSIONSHIP_HAS_PERMISSION, resp.Permissionship)
+
+							found = append(found, tuple.StringObjectRef(resp.Resource)+"#"+resp.Permission)
+							currentCursor = resp.AfterResultCursor
+							count++
+						}
+
+						if limit == 0 || count < limit {
+							break
+						}
+						require.Equal(limit, count)
+					}
+
+					sort.Strings(found)
+					require.Equal(tc.expected, found)
+				})
+			}
+		})
+	}
+}
+
+func TestLookupPermissionsManyResults(t *testing.T) {
+	conn, cleanup, _, _ := testserver.NewTestServer(require.New(t), 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	// Write more results than are buffered for a single permission.
+	resultCount := 300
+	updates := make([]*v1.RelationshipUpdate, 0, resultCount)
+	for i := 0; i < resultCount; i++ {
+		updates = append(updates, update(v1.RelationshipUpdate_OPERATION_TOUCH, "document", fmt.Sprintf("doc%d", i), "viewer", "user", "manydocs"))
+	}
+
+	written, err := v1.NewPermissionsServiceClient(conn).WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: updates,
+	})
+	require.NoError(t, err)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+	for _, limit := range []uint32{0, 1, 150} {
+		limit := limit
+		t.Run(fmt.Sprintf("limit-%d", limit), func(t *testing.T) {
+			stream, err := client.LookupPermissions(context.Background(), &extensionsv1.LookupPermissionsRequest{
+				Consistency: &v1.Consistency{
+					Requirement: &v1.Consistency_AtLeastAsFresh{AtLeastAsFresh: written.WrittenAt},
+				},
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{ObjectType: "user", ObjectId: "manydocs"},
+				},
+				OptionalLimit: limit,
+			})
+			require.NoError(t, err)
+
+			found := map[string]struct{}{}
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err)
+
+				key := tuple.StringObjectRef(resp.Resource) + "#" + resp.Permission
+				require.NotContains(t, found, key)
+				found[key] = struct{}{}
+			}
+
+			expected := uint32(resultCount)
+			if limit > 0 {
+				expected = limit
+			}
+			require.Len(t, found, int(expected))
+		})
+	}
+}
+
+func TestLookupPermissionsInvalidCursor(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+	request := &extensionsv1.LookupPermissionsRequest{
+		Subject: &v1.SubjectReference{
+			Object: &v1.ObjectReference{ObjectType: "user", ObjectId: "auditor"},
+		},
+		OptionalLimit: 1,
+	}
+
+	stream, err := client.LookupPermissions(context.Background(), request)
+	require.NoError(err)
+	resp, err := stream.Recv()
+	require.NoError(err)
+
+	// A cursor cannot be used with a request that differs from the one which produced it.
+	request.OptionalLimit = 2
+	request.OptionalCursor = resp.AfterResultCursor
+	stream, err = client.LookupPermissions(context.Background(), request)
+	require.NoError(err)
+	_, err = stream.Recv()
+	grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+}
+
+func TestExtensionsExpandPermissionTreeMaxDepth(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+	resp, err := client.ExpandPermissionTree(context.Background(), &extensionsv1.ExpandPermissionTreeRequest{
+		Resource:         &v1.ObjectReference{ObjectType: "document", ObjectId: "masterplan"},
+		Permission:       "view",
+		OptionalMaxDepth: 1,
+	})
+	require.NoError(err)
+	require.NotNil(resp.ExpandedAt)
+	require.NotNil(resp.TreeRoot.GetIntermediate())
+
+	truncated := make([]string, 0, len(resp.TruncatedNodes))
+	for _, node := range resp.TruncatedNodes {
+		require.Nil(node.OptionalCursor)
+		truncated = append(truncated, node.Resource.ObjectType+":"+node.Resource.ObjectId+"#"+node.Relation)
+	}
+	require.ElementsMatch([]string{
+		"document:masterplan#viewer",
+		"document:masterplan#edit",
+		"folder:plans#view",
+		"folder:strategy#view",
+	}, truncated)
+}
+
+func TestExtensionsExpandPermissionTreeSubjectLimit(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+	request := &extensionsv1.ExpandPermissionTreeRequest{
+		Resource:             &v1.ObjectReference{ObjectType: "folder", ObjectId: "company"},
+		Permission:           "viewer",
+		OptionalSubjectLimit: 1,
+	}
+
+	var subjects []string
+	for {
+		resp, err := client.ExpandPermissionTree(context.Background(), request)
+		require.NoError(err)
+
+		leaf := resp.TreeRoot.GetLeaf()
+		require.NotNil(leaf)
+		require.Len(leaf.Subjects, 1)
+		subjects = append(subjects, tuple.StringSubjectRef(leaf.Subjects[0]))
+
+		if len(resp.TruncatedNodes) == 0 {
+			break
+		}
+
+		require.Len(resp.TruncatedNodes, 1)
+		require.NotNil(resp.TruncatedNodes[0].OptionalCursor)
+		request.OptionalCursor = resp.TruncatedNodes[0].OptionalCursor
+	}
+
+	require.Equal([]string{"folder:auditors#viewer", "user:legal"}, subjects)
+
+	// A cursor cannot be used with a request that differs from the one which produced it.
+	request.OptionalSubjectLimit = 2
+	_, err := client.ExpandPermissionTree(context.Background(), request)
+	grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+}
+
+func TestExtensionsLookupSubjectsWithCandidates(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+	request := &extensionsv1.LookupSubjectsRequest{
+		Resource:          &v1.ObjectReference{ObjectType: "document", ObjectId: "masterplan"},
+		Permission:        "view",
+		SubjectObjectType: "user",
+		SubjectIds:        []string{"villain", "eng_lead", "unknown", "chief_financial_officer", "auditor"},
+	}
+
+	lookupSubjects := func() []string {
+		stream, err := client.LookupSubjects(context.Background(), request)
+		require.NoError(err)
+
+		var subjectIDs []string
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(err)
+
+			subjectIDs = append(subjectIDs, resp.Subject.SubjectObjectId)
+			request.OptionalCursor = resp.AfterResultCursor
+		}
+		return subjectIDs
+	}
+
+	// Only the candidates with the permission are returned.
+	subjectIDs := lookupSubjects()
+	sort.Strings(subjectIDs)
+	require.Equal([]string{"auditor", "chief_financial_officer", "eng_lead"}, subjectIDs)
+
+	// With a limit, the candidates are returned one page at a time.
+	request.OptionalConcreteLimit = 2
+	request.OptionalCursor = nil
+	require.Equal([]string{"auditor", "chief_financial_officer"}, lookupSubjects())
+	require.Equal([]string{"eng_lead"}, lookupSubjects())
+	require.Empty(lookupSubjects())
+}
+
+func TestExtensionsLookupSubjectsInvalidCursor(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+	request := &extensionsv1.LookupSubjectsRequest{
+		Resource:              &v1.ObjectReference{ObjectType: "document", ObjectId: "masterplan"},
+		Permission:            "view",
+		SubjectObjectType:     "user",
+		SubjectIds:            []string{"eng_lead", "chief_financial_officer"},
+		OptionalConcreteLimit: 1,
+	}
+
+	stream, err := client.LookupSubjects(context.Background(), request)
+	require.NoError(err)
+	resp, err := stream.Recv()
+	require.NoError(err)
+
+	// A cursor cannot be used with other candidate subjects than those which produced it.
+	request.SubjectIds = []string{"eng_lead"}
+	request.OptionalCursor = resp.AfterResultCursor
+	stream, err = client.LookupSubjects(context.Background(), request)
+	require.NoError(err)
+	_, err = stream.Recv()
+	grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+}
+
+func TestExtensionsLookupResourcesWithWildcards(t *testing.T) {
+	req := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(req, 0, memdb.DisableGC, true,
+		func(ds datastore.Datastore, require *require.Assertions) (datastore.Datastore, datastore.Revision) {
+			return testfixtures.DatastoreFromSchemaAndTestRelationships(ds, `
+				definition user {}
+
+				definition document {
+					relation viewer: user | user:*
+					relation editor: user
+					permission view = viewer + editor
+				}
+			`, []*core.RelationTuple{
+				tuple.MustParse("document:public#viewer@user:*"),
+				tuple.MustParse("document:private#viewer@user:tom"),
+				tuple.MustParse("document:both#viewer@user:*"),
+				tuple.MustParse("document:both#editor@user:tom"),
+			}, require)
+		})
+	t.Cleanup(cleanup)
+
+	client := extensionsv1.NewExtensionsServiceClient(conn)
+
+	lookupResources := func(subjectID string, mode extensionsv1.WildcardMode) (map[string]bool, error) {
+		stream, err := client.LookupResources(context.Background(), &extensionsv1.LookupResourcesRequest{
+			ResourceObjectType: "document",
+			Permission:         "view",
+			Subject:            &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: "user", ObjectId: subjectID}},
+			WildcardMode:       mode,
+		})
+		req.NoError(err)
+
+		// NOTE: each resource is returned once, whether it is found via a wildcard or not.
+		grantedByWildcard := map[string]bool{}
+		for {
+			resp, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return grantedByWildcard, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			req.NotContains(grantedByWildcard, resp.ResourceObjectId)
+			grantedByWildcard[resp.ResourceObjectId] = resp.GrantedByWildcard
+		}
+	}
+
+	// The resources of the subject are annotated by default.
+	found, err := lookupResources("tom", extensionsv1.WildcardMode_WILDCARD_MODE_UNSPECIFIED)
+	req.NoError(err)
+	req.Equal(map[string]bool{"public": true, "private": false, "both": true}, found)
+
+	// The resources granted via a wildcard can be looked up on their own.
+	found, err = lookupResources("*", extensionsv1.WildcardMode_WILDCARD_MODE_ONLY_WILDCARDS)
+	req.NoError(err)
+	req.Equal(map[string]bool{"public": true, "both": true}, found)
+
+	// Only the wildcard can be the subject when looking up only wildcards.
+	_, err = lookupResources("tom", extensionsv1.WildcardMode_WILDCARD_MODE_ONLY_WILDCARDS)
+	grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+
+	_, err = lookupResources("*", extensionsv1.WildcardMode_WILDCARD_MODE_ANNOTATE)
+	grpcutil.RequireStatus(t, codes.InvalidArgument, err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/authzed/spicedb/internal/graph/computed"
 	"github.com/authzed/spicedb/pkg/datastore"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
 )
 
 var MaxBulkCheckDispatchChunkSize = datastore.FilterMaximumIDCount
@@ -15,6 +16,14 @@ var MaxBulkCheckDispatchChunkSize = datastore.FilterMaximumIDCount
 type groupedCheckParameters struct {
 	params      *computed.CheckParameters
 	resourceIDs []string
+
+	// additionalSubjects are the subjects checked along with the subject of the parameters, if the
+	// group was merged with others by groupItemsBySubject.
+	additionalSubjects []*core.ObjectAndRelation
+
+	// subjectGroupingHash is the hash of the first item of the group without its subject ID, used
+	// to merge groups by groupItemsBySubject.
+	subjectGroupingHash string
 }
 
 type groupingParameters struct {
@@ -40,9 +49,15 @@ func groupItems(ctx context.Context, params groupingParameters, items []*v1.Chec
 				return nil, err
 			}
 
+			subjectGroupingHash, err := computeCheckBulkPermissionsItemHashWithoutSubjectID(item)
+			if err != nil {
+				return nil, err
+			}
+
 			res[hash] = &groupedCheckParameters{
-				params:      checkParametersFromCheckBulkPermissionsRequestItem(item, params, caveatContext),
-				resourceIDs: []string{item.Resource.ObjectId},
+				params:              checkParametersFromCheckBulkPermissionsRequestItem(item, params, caveatContext),
+				resourceIDs:         []string{item.Resource.ObjectId},
+				subjectGroupingHash: subjectGroupingHash,
 			}
 		} else {
 			res[hash].resourceIDs = append(res[hash].resourceIDs, item.Resource.ObjectId)
@@ -52,6 +67,32 @@ func groupItems(ctx context.Context, params groupingParameters, items []*v1.Chec
 	return res, nil
 }
 
+// groupItemsBySubject merges the groups of a single resource ID which differ only by the ID of
+// their subject, so that the subjects of the merged group are checked together, as is the case
+// when checking a single resource for many subjects. Groups of a wildcard subject are not merged,
+// as checking a wildcard fails and would fail the other subjects of its group along with it.
+func groupItemsBySubject(groups map[string]*groupedCheckParameters) []*groupedCheckParameters {
+	grouped := make([]*groupedCheckParameters, 0, len(groups))
+	bySubjectGroupingHash := make(map[string]*groupedCheckParameters, len(groups))
+	for _, group := range groups {
+		if len(group.resourceIDs) != 1 || group.params.Subject.ObjectId == tuple.PublicWildcard {
+			grouped = append(grouped, group)
+			continue
+		}
+
+		existing, ok := bySubjectGroupingHash[group.subjectGroupingHash]
+		if !ok {
+			bySubjectGroupingHash[group.subjectGroupingHash] = group
+			grouped = append(grouped, group)
+			continue
+		}
+
+		existing.additionalSubjects = append(existing.additionalSubjects, group.params.Subject)
+	}
+
+	return grouped
+}
+
 func checkParametersFromCheckBulkPermissionsRequestItem(
 	bc *v1.CheckBulkPermissionsRequestItem,
 	params groupingParameters,
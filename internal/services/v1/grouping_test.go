@@ -249,6 +249,139 @@ func TestGroupItems(t *testing.T) {
 	}
 }
 
+func TestGroupItemsBySubject(t *testing.T) {
+	testCases := []struct {
+		name                string
+		requests            []string
+		expectedSubjectSets [][]string
+	}{
+		{
+			name: "different subjects of the same resource are merged",
+			requests: []string{
+				"document:1#view@user:1",
+				"document:1#view@user:2",
+				"document:1#view@user:3",
+			},
+			expectedSubjectSets: [][]string{
+				{"user:1", "user:2", "user:3"},
+			},
+		},
+		{
+			name: "different resources are not merged",
+			requests: []string{
+				"document:1#view@user:1",
+				"document:2#view@user:2",
+			},
+			expectedSubjectSets: [][]string{
+				{"user:1"},
+				{"user:2"},
+			},
+		},
+		{
+			name: "different permissions are not merged",
+			requests: []string{
+				"document:1#view@user:1",
+				"document:1#edit@user:2",
+			},
+			expectedSubjectSets: [][]string{
+				{"user:1"},
+				{"user:2"},
+			},
+		},
+		{
+			name: "different subject relations are not merged",
+			requests: []string{
+				"document:1#view@user:1",
+				"document:1#view@group:2#member",
+				"document:1#view@group:3#member",
+			},
+			expectedSubjectSets: [][]string{
+				{"group:2#member", "group:3#member"},
+				{"user:1"},
+			},
+		},
+		{
+			name: "different caveat contexts are not merged",
+			requests: []string{
+				`document:1#view@user:1[somecaveat:{"hey": "bud"}]`,
+				`document:1#view@user:2[somecaveat:{"hi": "there"}]`,
+				`document:1#view@user:3[somecaveat:{"hey": "bud"}]`,
+			},
+			expectedSubjectSets: [][]string{
+				{"user:1", "user:3"},
+				{"user:2"},
+			},
+		},
+		{
+			name: "wildcard subjects are not merged",
+			requests: []string{
+				"document:1#view@user:1",
+				"document:1#view@user:*",
+				"document:1#view@user:2",
+			},
+			expectedSubjectSets: [][]string{
+				{"user:*"},
+				{"user:1", "user:2"},
+			},
+		},
+		{
+			name: "groups of several resources are not merged",
+			requests: []string{
+				"document:1#view@user:1",
+				"document:2#view@user:1",
+				"document:1#view@user:2",
+			},
+			expectedSubjectSets: [][]string{
+				{"user:1"},
+				{"user:2"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cp := groupingParameters{
+				atRevision:           datastore.NoRevision,
+				maxCaveatContextSize: math.MaxInt,
+				maximumAPIDepth:      1,
+			}
+
+			items := make([]*v1.CheckBulkPermissionsRequestItem, 0, len(tt.requests))
+			for _, r := range tt.requests {
+				rel := tuple.ParseRel(r)
+				item := &v1.CheckBulkPermissionsRequestItem{
+					Resource:   rel.Resource,
+					Permission: rel.Relation,
+					Subject:    rel.Subject,
+				}
+				if rel.OptionalCaveat != nil {
+					item.Context = rel.OptionalCaveat.Context
+				}
+				items = append(items, item)
+			}
+
+			groups, err := groupItems(context.Background(), cp, items)
+			require.NoError(t, err)
+
+			subjectSets := make([][]string, 0, len(groups))
+			for _, group := range groupItemsBySubject(groups) {
+				subjects := []string{tuple.StringONR(group.params.Subject)}
+				for _, subject := range group.additionalSubjects {
+					subjects = append(subjects, tuple.StringONR(subject))
+				}
+				sort.Strings(subjects)
+				subjectSets = append(subjectSets, subjects)
+			}
+
+			sort.Slice(subjectSets, func(first, second int) bool {
+				return strings.Join(subjectSets[first], ",") < strings.Join(subjectSets[second], ",")
+			})
+			require.Equal(t, tt.expectedSubjectSets, subjectSets)
+		})
+	}
+}
+
 func TestCaveatContextSizeLimitIsEnforced(t *testing.T) {
 	cp := groupingParameters{
 		atRevision:           datastore.NoRevision,
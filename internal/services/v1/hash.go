@@ -1,12 +1,16 @@
 package v1
 
 import (
+	"slices"
 	"strconv"
+	"strings"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/authzed/spicedb/pkg/caveats"
+	dispatchv1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
 	"github.com/authzed/spicedb/pkg/spiceerrors"
 	"github.com/authzed/spicedb/pkg/tuple"
 )
@@ -22,6 +26,17 @@ func computeCheckBulkPermissionsItemHashWithoutResourceID(req *v1.CheckBulkPermi
 	})
 }
 
+func computeCheckBulkPermissionsItemHashWithoutSubjectID(req *v1.CheckBulkPermissionsRequestItem) (string, error) {
+	return computeCallHash("v1.checkbulkpermissionsrequestitem", nil, map[string]any{
+		"resource-type":    req.Resource.ObjectType,
+		"resource-id":      req.Resource.ObjectId,
+		"permission":       req.Permission,
+		"subject-type":     req.Subject.Object.ObjectType,
+		"subject-relation": req.Subject.OptionalRelation,
+		"context":          req.Context,
+	})
+}
+
 func computeCheckBulkPermissionsItemHash(req *v1.CheckBulkPermissionsRequestItem) (string, error) {
 	return computeCallHash("v1.checkbulkpermissionsrequestitem", nil, map[string]any{
 		"resource-type":    req.Resource.ObjectType,
@@ -66,6 +81,53 @@ func computeLRRequestHash(req *v1.LookupResourcesRequest) (string, error) {
 	})
 }
 
+func computeWildcardLRRequestHash(req *v1.LookupResourcesRequest, wildcardSetting dispatchv1.DispatchLookupResourcesRequest_WildcardSetting) (string, error) {
+	return computeCallHash("extensions.v1.lookupresources", req.Consistency, map[string]any{
+		"resource-type":    req.ResourceObjectType,
+		"permission":       req.Permission,
+		"subject":          tuple.StringSubjectRef(req.Subject),
+		"limit":            req.OptionalLimit,
+		"context":          req.Context,
+		"wildcard-setting": wildcardSetting.String(),
+	})
+}
+
+func computeLSRequestHash(req *v1.LookupSubjectsRequest, candidateSubjectIDs []string) (string, error) {
+	sortedSubjectIDs := slices.Clone(candidateSubjectIDs)
+	slices.Sort(sortedSubjectIDs)
+
+	return computeCallHash("v1.lookupsubjects", req.Consistency, map[string]any{
+		"resource-type":    req.Resource.ObjectType,
+		"resource-id":      req.Resource.ObjectId,
+		"permission":       req.Permission,
+		"subject-type":     req.SubjectObjectType,
+		"subject-relation": req.OptionalSubjectRelation,
+		"subject-ids":      strings.Join(sortedSubjectIDs, ","),
+		"limit":            req.OptionalConcreteLimit,
+		"context":          req.Context,
+	})
+}
+
+func computeLPRequestHash(req *extensionsv1.LookupPermissionsRequest) (string, error) {
+	return computeCallHash("extensions.v1.lookuppermissions", req.Consistency, map[string]any{
+		"resource-types":    strings.Join(req.OptionalResourceTypes, ","),
+		"include-relations": strconv.FormatBool(req.IncludeRelations),
+		"subject":           tuple.StringSubjectRef(req.Subject),
+		"limit":             req.OptionalLimit,
+		"context":           req.Context,
+	})
+}
+
+func computeEPTRequestHash(req *extensionsv1.ExpandPermissionTreeRequest) (string, error) {
+	return computeCallHash("extensions.v1.expandpermissiontree", req.Consistency, map[string]any{
+		"resource-type": req.Resource.ObjectType,
+		"resource-id":   req.Resource.ObjectId,
+		"permission":    req.Permission,
+		"max-depth":     req.OptionalMaxDepth,
+		"subject-limit": req.OptionalSubjectLimit,
+	})
+}
+
 func computeCallHash(apiName string, consistency *v1.Consistency, arguments map[string]any) (string, error) {
 	stringArguments := make(map[string]string, len(arguments)+1)
 
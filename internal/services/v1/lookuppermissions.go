@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+
+	dispatchpkg "github.com/authzed/spicedb/internal/dispatch"
+	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
+	"github.com/authzed/spicedb/internal/middleware/usagemetrics"
+	"github.com/authzed/spicedb/internal/namespace"
+	"github.com/authzed/spicedb/internal/taskrunner"
+	"github.com/authzed/spicedb/pkg/cursor"
+	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/middleware/consistency"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+	"github.com/authzed/spicedb/pkg/typesystem"
+)
+
+// lookupPermissionsBufferSize is the number of results of each permission which are held while
+// waiting for the results of the permissions before it to be sent.
+const lookupPermissionsBufferSize = 100
+
+func (es *extensionsServer) LookupPermissions(req *extensionsv1.LookupPermissionsRequest, resp extensionsv1.ExtensionsService_LookupPermissionsServer) error {
+	ctx := resp.Context()
+
+	atRevision, revisionReadAt, err := consistency.RevisionFromContext(ctx)
+	if err != nil {
+		return es.rewriteError(ctx, err)
+	}
+
+	ds := datastoremw.MustFromContext(ctx).SnapshotReader(atRevision)
+
+	toCheck := []namespace.TypeAndRelationToCheck{
+		{
+			NamespaceName: req.Subject.Object.ObjectType,
+			RelationName:  normalizeSubjectRelation(req.Subject),
+			AllowEllipsis: true,
+		},
+	}
+	for _, resourceType := range req.OptionalResourceTypes {
+		toCheck = append(toCheck, namespace.TypeAndRelationToCheck{
+			NamespaceName: resourceType,
+			RelationName:  tuple.Ellipsis,
+			AllowEllipsis: true,
+		})
+	}
+	if err := namespace.CheckNamespaceAndRelations(ctx, toCheck, ds); err != nil {
+		return es.rewriteError(ctx, err)
+	}
+
+	subjectType := &core.RelationReference{
+		Namespace: req.Subject.Object.ObjectType,
+		Relation:  normalizeSubjectRelation(req.Subject),
+	}
+
+	permissions, err := reachablePermissions(ctx, ds, subjectType, req.OptionalResourceTypes, req.IncludeRelations)
+	if err != nil {
+		return es.rewriteError(ctx, err)
+	}
+
+	respMetadata := &dispatch.ResponseMeta{
+		DispatchCount:       1,
+		CachedDispatchCount: 0,
+		DepthRequired:       1,
+		DebugInfo:           nil,
+	}
+	usagemetrics.SetInContext(ctx, respMetadata)
+
+	lpRequestHash, err := computeLPRequestHash(req)
+	if err != nil {
+		return es.rewriteError(ctx, err)
+	}
+
+	// The cursor holds the permission being looked up in its first section, followed by the
+	// sections of the LookupResources cursor for that permission.
+	startIndex := 0
+	var currentCursor *dispatch.Cursor
+	if req.OptionalCursor != nil {
+		decodedCursor, err := cursor.DecodeToDispatchCursor(req.OptionalCursor, lpRequestHash)
+		if err != nil {
+			return es.rewriteError(ctx, err)
+		}
+
+		if len(decodedCursor.Sections) == 0 {
+			return es.rewriteError(ctx, cursor.NewInvalidCursorErr(errors.New("missing permission in cursor")))
+		}
+
+		startIndex = slices.IndexFunc(permissions, func(permission *core.RelationReference) bool {
+			return tuple.StringRR(permission) == decodedCursor.Sections[0]
+		})
+		if startIndex < 0 {
+			return es.rewriteError(ctx, cursor.NewInvalidCursorErr(errors.New("unknown permission in cursor")))
+		}
+
+		currentCursor = &dispatch.Cursor{
+			Sections:        decodedCursor.Sections[1:],
+			DispatchVersion: decodedCursor.DispatchVersion,
+		}
+	}
+
+	bf, err := dispatch.NewTraversalBloomFilter(uint(es.permServerConfig.MaximumAPIDepth))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Each permission is looked up by its own dispatch, run concurrently up to the configured
+	// limit. The results of each dispatch are handed over through a bounded channel and sent in
+	// the order of the permissions, so that the cursor of each result resumes the lookup after it.
+	// As tasks are started in order, the dispatch whose results are being sent is always running,
+	// while the dispatches after it wait once their channel is full.
+	results := make([]chan *extensionsv1.LookupPermissionsResponse, len(permissions)-startIndex)
+	var metadataLock sync.Mutex
+
+	tr := taskrunner.NewPreloadedTaskRunner(ctx, es.permServerConfig.MaxLookupPermissionsConcurrency, len(results))
+	for index, permission := range permissions[startIndex:] {
+		var permissionCursor *dispatch.Cursor
+		if index == 0 {
+			permissionCursor = currentCursor
+		}
+
+		permissionResults := make(chan *extensionsv1.LookupPermissionsResponse, lookupPermissionsBufferSize)
+		results[index] = permissionResults
+
+		tr.Add(func(ctx context.Context) error {
+			defer close(permissionResults)
+
+			permissionKey := tuple.StringRR(permission)
+			alreadyPublishedPermissionedResourceIds := map[string]struct{}{}
+
+			stream := dispatchpkg.NewHandlingDispatchStream(ctx, func(result *dispatch.DispatchLookupResourcesResponse) error {
+				found := result.ResolvedResource
+
+				metadataLock.Lock()
+				dispatchpkg.AddResponseMetadata(respMetadata, result.Metadata)
+				metadataLock.Unlock()
+
+				var partial *v1.PartialCaveatInfo
+				permissionship := v1.LookupPermissionship_LOOKUP_PERMISSIONSHIP_HAS_PERMISSION
+				if found.Permissionship == dispatch.ResolvedResource_CONDITIONALLY_HAS_PERMISSION {
+					permissionship = v1.LookupPermissionship_LOOKUP_PERMISSIONSHIP_CONDITIONAL_PERMISSION
+					partial = &v1.PartialCaveatInfo{
+						MissingRequiredContext: found.MissingRequiredContext,
+					}
+				} else if req.OptionalLimit == 0 {
+					if _, ok := alreadyPublishedPermissionedResourceIds[found.ResourceId]; ok {
+						// Skip publishing the duplicate.
+						return nil
+					}
+
+					alreadyPublishedPermissionedResourceIds[found.ResourceId] = struct{}{}
+				}
+
+				var afterResponseCursor *dispatch.Cursor
+				if result.AfterResponseCursor != nil {
+					afterResponseCursor = &dispatch.Cursor{
+						Sections:        append([]string{permissionKey}, result.AfterResponseCursor.Sections...),
+						DispatchVersion: result.AfterResponseCursor.DispatchVersion,
+					}
+				} else {
+					afterResponseCursor = &dispatch.Cursor{Sections: []string{permissionKey}}
+				}
+
+				encodedCursor, err := cursor.EncodeFromDispatchCursor(afterResponseCursor, lpRequestHash, atRevision)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case permissionResults <- &extensionsv1.LookupPermissionsResponse{
+					LookedUpAt: revisionReadAt,
+					Resource: &v1.ObjectReference{
+						ObjectType: permission.Namespace,
+						ObjectId:   found.ResourceId,
+					},
+					Permission:        permission.Relation,
+					Permissionship:    permissionship,
+					PartialCaveatInfo: partial,
+					AfterResultCursor: encodedCursor,
+				}:
+					return nil
+
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+
+			// Each dispatch is bounded by the overall limit, as it is not known in advance how
+			// many results the permissions before it will return.
+			return es.dispatch.DispatchLookupResources(
+				&dispatch.DispatchLookupResourcesRequest{
+					Metadata: &dispatch.ResolverMeta{
+						AtRevision:     atRevision.String(),
+						DepthRemaining: es.permServerConfig.MaximumAPIDepth,
+						TraversalBloom: bf,
+					},
+					ObjectRelation: permission,
+					Subject: &core.ObjectAndRelation{
+						Namespace: req.Subject.Object.ObjectType,
+						ObjectId:  req.Subject.Object.ObjectId,
+						Relation:  normalizeSubjectRelation(req.Subject),
+					},
+					Context:        req.Context,
+					OptionalCursor: permissionCursor,
+					OptionalLimit:  req.OptionalLimit,
+				},
+				stream)
+		})
+	}
+
+	var taskErr error
+	done := make(chan struct{})
+	go func() {
+		taskErr = tr.StartAndWait()
+		close(done)
+	}()
+
+	// Once the limit is reached, the remaining dispatches are canceled.
+	stop := func() {
+		cancel()
+		<-done
+	}
+
+	var sentCount uint32
+	running := done
+	for _, permissionResults := range results {
+		for {
+			var result *extensionsv1.LookupPermissionsResponse
+			var ok bool
+			select {
+			case result, ok = <-permissionResults:
+			case <-running:
+				if taskErr != nil {
+					return es.rewriteError(ctx, taskErr)
+				}
+
+				// Every dispatch completed, so every channel has been closed.
+				running = nil
+				continue
+			}
+
+			if !ok {
+				break
+			}
+
+			if err := resp.Send(result); err != nil {
+				stop()
+				return err
+			}
+
+			sentCount++
+			if req.OptionalLimit > 0 && sentCount >= req.OptionalLimit {
+				stop()
+				return nil
+			}
+		}
+	}
+
+	<-done
+	if taskErr != nil {
+		return es.rewriteError(ctx, taskErr)
+	}
+	return nil
+}
+
+// reachablePermissions returns the permissions, and optionally the relations, which are
+// reachable from the subject type in the schema, in the order of their definitions. If resource
+// types are given, only the permissions of those types are returned.
+func reachablePermissions(
+	ctx context.Context,
+	ds datastore.Reader,
+	subjectType *core.RelationReference,
+	resourceTypes []string,
+	includeRelations bool,
+) ([]*core.RelationReference, error) {
+	namespaces, err := ds.ListAllNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(namespaces, func(a, b datastore.RevisionedNamespace) int {
+		return cmp.Compare(a.Definition.Name, b.Definition.Name)
+	})
+
+	var permissions []*core.RelationReference
+	for _, ns := range namespaces {
+		if len(resourceTypes) > 0 && !slices.Contains(resourceTypes, ns.Definition.Name) {
+			continue
+		}
+
+		_, ts, err := typesystem.ReadNamespaceAndTypes(ctx, ns.Definition.Name, ds)
+		if err != nil {
+			return nil, err
+		}
+
+		rg := typesystem.ReachabilityGraphFor(ts)
+		for _, relation := range ns.Definition.Relation {
+			if !includeRelations && !ts.IsPermission(relation.Name) {
+				continue
+			}
+
+			resourceType := &core.RelationReference{
+				Namespace: ns.Definition.Name,
+				Relation:  relation.Name,
+			}
+
+			reachable, err := rg.HasOptimizedEntrypointsForSubjectToResource(ctx, subjectType, resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			if reachable {
+				permissions = append(permissions, resourceType)
+			}
+		}
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+
+	dispatch "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+)
+
+func (es *extensionsServer) LookupResources(req *extensionsv1.LookupResourcesRequest, resp extensionsv1.ExtensionsService_LookupResourcesServer) error {
+	wildcardSetting := dispatch.DispatchLookupResourcesRequest_ANNOTATE_WILDCARDS
+	if req.WildcardMode == extensionsv1.WildcardMode_WILDCARD_MODE_ONLY_WILDCARDS {
+		wildcardSetting = dispatch.DispatchLookupResourcesRequest_ONLY_WILDCARDS
+	}
+
+	return es.permissions.lookupResources(resp.Context(), &v1.LookupResourcesRequest{
+		Consistency:        req.Consistency,
+		ResourceObjectType: req.ResourceObjectType,
+		Permission:         req.Permission,
+		Subject:            req.Subject,
+		Context:            req.Context,
+		OptionalLimit:      req.OptionalLimit,
+		OptionalCursor:     req.OptionalCursor,
+	}, wildcardSetting, func(result *v1.LookupResourcesResponse, grantedByWildcard bool) error {
+		return resp.Send(&extensionsv1.LookupResourcesResponse{
+			LookedUpAt:        result.LookedUpAt,
+			ResourceObjectId:  result.ResourceObjectId,
+			Permissionship:    result.Permissionship,
+			PartialCaveatInfo: result.PartialCaveatInfo,
+			AfterResultCursor: result.AfterResultCursor,
+			GrantedByWildcard: grantedByWildcard,
+		})
+	})
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+)
+
+func (es *extensionsServer) LookupSubjects(req *extensionsv1.LookupSubjectsRequest, resp extensionsv1.ExtensionsService_LookupSubjectsServer) error {
+	return es.permissions.lookupSubjects(resp.Context(), &v1.LookupSubjectsRequest{
+		Consistency:             req.Consistency,
+		Resource:                req.Resource,
+		Permission:              req.Permission,
+		SubjectObjectType:       req.SubjectObjectType,
+		OptionalSubjectRelation: req.OptionalSubjectRelation,
+		Context:                 req.Context,
+		OptionalConcreteLimit:   req.OptionalConcreteLimit,
+		OptionalCursor:          req.OptionalCursor,
+	}, req.SubjectIds, resp.Send)
+}
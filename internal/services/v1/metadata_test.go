@@ -18,6 +18,7 @@ import (
 	"github.com/authzed/spicedb/internal/datastore/memdb"
 	tf "github.com/authzed/spicedb/internal/testfixtures"
 	"github.com/authzed/spicedb/internal/testserver"
+	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	"github.com/authzed/spicedb/pkg/zedtoken"
 )
 
@@ -204,6 +205,10 @@ func TestAllMethodsReturnMetadata(t *testing.T) {
 	)
 }
 
+// unservedMethods are the methods of the API clients that are not (yet) served by SpiceDB;
+// their experimental equivalents are covered instead.
+var unservedMethods = mapz.NewSet("ImportBulkRelationships", "ExportBulkRelationships")
+
 func checkServiceMethods[T any](
 	t *testing.T,
 	client T,
@@ -212,6 +217,10 @@ func checkServiceMethods[T any](
 	et := reflect.TypeOf(new(T)).Elem()
 	for i := 0; i < et.NumMethod(); i++ {
 		methodName := et.Method(i).Name
+		if unservedMethods.Has(methodName) {
+			continue
+		}
+
 		t.Run(methodName, func(t *testing.T) {
 			handler, ok := handlers[methodName]
 			require.True(t, ok, "missing handler for method %s under %T", methodName, new(T))
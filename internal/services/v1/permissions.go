@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/authzed/authzed-go/pkg/requestmeta"
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
@@ -14,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	cexpr "github.com/authzed/spicedb/internal/caveats"
+	"github.com/authzed/spicedb/internal/datasets"
 	dispatchpkg "github.com/authzed/spicedb/internal/dispatch"
 	"github.com/authzed/spicedb/internal/graph"
 	"github.com/authzed/spicedb/internal/graph/computed"
@@ -369,7 +372,18 @@ func TranslateExpansionTree(node *core.RelationTupleTreeNode) *v1.PermissionRela
 }
 
 func (ps *permissionServer) LookupResources(req *v1.LookupResourcesRequest, resp v1.PermissionsService_LookupResourcesServer) error {
-	ctx := resp.Context()
+	return ps.lookupResources(resp.Context(), req, dispatch.DispatchLookupResourcesRequest_NO_WILDCARD_ANNOTATIONS, func(result *v1.LookupResourcesResponse, _ bool) error {
+		return resp.Send(result)
+	})
+}
+
+// lookupResources looks up the resources of the request, handling those granted via a wildcard as
+// per the wildcard setting, and sends each resource found along with whether it was granted via
+// a wildcard.
+func (ps *permissionServer) lookupResources(ctx context.Context, req *v1.LookupResourcesRequest, wildcardSetting dispatch.DispatchLookupResourcesRequest_WildcardSetting, send func(*v1.LookupResourcesResponse, bool) error) error {
+	if err := validateLimit("LookupResourcesRequest.OptionalLimit", req.OptionalLimit); err != nil {
+		return ps.rewriteError(ctx, err)
+	}
 
 	atRevision, revisionReadAt, err := consistency.RevisionFromContext(ctx)
 	if err != nil {
@@ -405,6 +419,9 @@ func (ps *permissionServer) LookupResources(req *v1.LookupResourcesRequest, resp
 	var currentCursor *dispatch.Cursor
 
 	lrRequestHash, err := computeLRRequestHash(req)
+	if wildcardSetting != dispatch.DispatchLookupResourcesRequest_NO_WILDCARD_ANNOTATIONS {
+		lrRequestHash, err = computeWildcardLRRequestHash(req, wildcardSetting)
+	}
 	if err != nil {
 		return ps.rewriteError(ctx, err)
 	}
@@ -446,13 +463,13 @@ func (ps *permissionServer) LookupResources(req *v1.LookupResourcesRequest, resp
 			return ps.rewriteError(ctx, err)
 		}
 
-		err = resp.Send(&v1.LookupResourcesResponse{
+		err = send(&v1.LookupResourcesResponse{
 			LookedUpAt:        revisionReadAt,
 			ResourceObjectId:  found.ResourceId,
 			Permissionship:    permissionship,
 			PartialCaveatInfo: partial,
 			AfterResultCursor: encodedCursor,
-		})
+		}, found.GrantedByWildcard)
 		if err != nil {
 			return err
 		}
@@ -480,9 +497,10 @@ func (ps *permissionServer) LookupResources(req *v1.LookupResourcesRequest, resp
 				ObjectId:  req.Subject.Object.ObjectId,
 				Relation:  normalizeSubjectRelation(req.Subject),
 			},
-			Context:        req.Context,
-			OptionalCursor: currentCursor,
-			OptionalLimit:  req.OptionalLimit,
+			Context:         req.Context,
+			OptionalCursor:  currentCursor,
+			OptionalLimit:   req.OptionalLimit,
+			WildcardSetting: wildcardSetting,
 		},
 		stream)
 	if err != nil {
@@ -493,7 +511,15 @@ func (ps *permissionServer) LookupResources(req *v1.LookupResourcesRequest, resp
 }
 
 func (ps *permissionServer) LookupSubjects(req *v1.LookupSubjectsRequest, resp v1.PermissionsService_LookupSubjectsServer) error {
-	ctx := resp.Context()
+	return ps.lookupSubjects(resp.Context(), req, nil, resp.Send)
+}
+
+// lookupSubjects looks up the subjects of the request, optionally restricted to the given candidate
+// subject IDs, and sends each subject found.
+func (ps *permissionServer) lookupSubjects(ctx context.Context, req *v1.LookupSubjectsRequest, candidateSubjectIDs []string, send func(*v1.LookupSubjectsResponse) error) error {
+	if err := validateLimit("LookupSubjectsRequest.OptionalConcreteLimit", req.OptionalConcreteLimit); err != nil {
+		return ps.rewriteError(ctx, err)
+	}
 
 	atRevision, revisionReadAt, err := consistency.RevisionFromContext(ctx)
 	if err != nil {
@@ -531,6 +557,74 @@ func (ps *permissionServer) LookupSubjects(req *v1.LookupSubjectsRequest, resp v
 	}
 	usagemetrics.SetInContext(ctx, respMetadata)
 
+	sendFoundSubject := func(foundSubject *dispatch.FoundSubject, afterResultCursor *v1.Cursor) (bool, error) {
+		excludedSubjectIDs := make([]string, 0, len(foundSubject.ExcludedSubjects))
+		for _, excludedSubject := range foundSubject.ExcludedSubjects {
+			excludedSubjectIDs = append(excludedSubjectIDs, excludedSubject.SubjectId)
+		}
+
+		excludedSubjects := make([]*v1.ResolvedSubject, 0, len(foundSubject.ExcludedSubjects))
+		for _, excludedSubject := range foundSubject.ExcludedSubjects {
+			resolvedExcludedSubject, err := foundSubjectToResolvedSubject(ctx, excludedSubject, caveatContext, ds)
+			if err != nil {
+				return false, err
+			}
+
+			if resolvedExcludedSubject == nil {
+				continue
+			}
+
+			excludedSubjects = append(excludedSubjects, resolvedExcludedSubject)
+		}
+
+		subject, err := foundSubjectToResolvedSubject(ctx, foundSubject, caveatContext, ds)
+		if err != nil {
+			return false, err
+		}
+		if subject == nil {
+			return false, nil
+		}
+
+		err = send(&v1.LookupSubjectsResponse{
+			Subject:            subject,
+			ExcludedSubjects:   excludedSubjects,
+			LookedUpAt:         revisionReadAt,
+			SubjectObjectId:    foundSubject.SubjectId,    // Deprecated
+			ExcludedSubjectIds: excludedSubjectIDs,        // Deprecated
+			Permissionship:     subject.Permissionship,    // Deprecated
+			PartialCaveatInfo:  subject.PartialCaveatInfo, // Deprecated
+			AfterResultCursor:  afterResultCursor,
+		})
+		return err == nil, err
+	}
+
+	bf, err := dispatch.NewTraversalBloomFilter(uint(ps.config.MaximumAPIDepth))
+	if err != nil {
+		return err
+	}
+
+	dispatchRequest := &dispatch.DispatchLookupSubjectsRequest{
+		Metadata: &dispatch.ResolverMeta{
+			AtRevision:     atRevision.String(),
+			DepthRemaining: ps.config.MaximumAPIDepth,
+			TraversalBloom: bf,
+		},
+		ResourceRelation: &core.RelationReference{
+			Namespace: req.Resource.ObjectType,
+			Relation:  req.Permission,
+		},
+		ResourceIds: []string{req.Resource.ObjectId},
+		SubjectRelation: &core.RelationReference{
+			Namespace: req.SubjectObjectType,
+			Relation:  stringz.DefaultEmpty(req.OptionalSubjectRelation, tuple.Ellipsis),
+		},
+		OptionalSubjectIds: candidateSubjectIDs,
+	}
+
+	if req.OptionalConcreteLimit > 0 || req.OptionalCursor != nil {
+		return ps.lookupSubjectsWithCursor(ctx, req, candidateSubjectIDs, dispatchRequest, atRevision, respMetadata, sendFoundSubject)
+	}
+
 	stream := dispatchpkg.NewHandlingDispatchStream(ctx, func(result *dispatch.DispatchLookupSubjectsResponse) error {
 		foundSubjects, ok := result.FoundSubjectsByResourceId[req.Resource.ObjectId]
 		if !ok {
@@ -538,79 +632,133 @@ func (ps *permissionServer) LookupSubjects(req *v1.LookupSubjectsRequest, resp v
 		}
 
 		for _, foundSubject := range foundSubjects.FoundSubjects {
-			excludedSubjectIDs := make([]string, 0, len(foundSubject.ExcludedSubjects))
-			for _, excludedSubject := range foundSubject.ExcludedSubjects {
-				excludedSubjectIDs = append(excludedSubjectIDs, excludedSubject.SubjectId)
+			if _, err := sendFoundSubject(foundSubject, nil); err != nil {
+				return err
 			}
+		}
 
-			excludedSubjects := make([]*v1.ResolvedSubject, 0, len(foundSubject.ExcludedSubjects))
-			for _, excludedSubject := range foundSubject.ExcludedSubjects {
-				resolvedExcludedSubject, err := foundSubjectToResolvedSubject(ctx, excludedSubject, caveatContext, ds)
-				if err != nil {
-					return err
+		dispatchpkg.AddResponseMetadata(respMetadata, result.Metadata)
+		return nil
+	})
+
+	err = ps.dispatch.DispatchLookupSubjects(dispatchRequest, stream)
+	if err != nil {
+		return ps.rewriteError(ctx, err)
+	}
+
+	return nil
+}
+
+// lookupSubjectsWithCursor looks up the subjects of the request in the order of their IDs, starting
+// after the cursor of the request, until the limit of concrete subjects has been sent. Each subject
+// is sent with the cursor at which to resume after it. If found, the wildcard subject is sent first,
+// on the first page only: the subjects found after a cursor omit those before it, including the
+// exclusions of the wildcard.
+func (ps *permissionServer) lookupSubjectsWithCursor(
+	ctx context.Context,
+	req *v1.LookupSubjectsRequest,
+	candidateSubjectIDs []string,
+	dispatchRequest *dispatch.DispatchLookupSubjectsRequest,
+	atRevision datastore.Revision,
+	respMetadata *dispatch.ResponseMeta,
+	sendFoundSubject func(foundSubject *dispatch.FoundSubject, afterResultCursor *v1.Cursor) (bool, error),
+) error {
+	lsRequestHash, err := computeLSRequestHash(req, candidateSubjectIDs)
+	if err != nil {
+		return ps.rewriteError(ctx, err)
+	}
+
+	var currentCursor *dispatch.Cursor
+	if req.OptionalCursor != nil {
+		decodedCursor, err := cursor.DecodeToDispatchCursor(req.OptionalCursor, lsRequestHash)
+		if err != nil {
+			return ps.rewriteError(ctx, err)
+		}
+		currentCursor = decodedCursor
+	}
+
+	limit := req.OptionalConcreteLimit
+	var sentCount uint32
+	sentWildcard := req.OptionalCursor != nil
+	for {
+		// Subjects whose caveats are not satisfied are not sent, in which case the remaining subjects
+		// are looked up again from the last subject found.
+		remaining := uint32(0)
+		if limit > 0 {
+			remaining = limit - sentCount
+		}
+
+		dispatchRequest.OptionalCursor = currentCursor
+		dispatchRequest.OptionalLimit = remaining
+
+		stream := dispatchpkg.NewCollectingDispatchStream[*dispatch.DispatchLookupSubjectsResponse](ctx)
+		if err := ps.dispatch.DispatchLookupSubjects(dispatchRequest, stream); err != nil {
+			return ps.rewriteError(ctx, err)
+		}
+
+		// The dispatched limit applies to each response, so the responses are combined before the
+		// limit is applied again.
+		foundSubjects := datasets.NewSubjectSet()
+		for _, result := range stream.Results() {
+			dispatchpkg.AddResponseMetadata(respMetadata, result.Metadata)
+			if found, ok := result.FoundSubjectsByResourceId[req.Resource.ObjectId]; ok {
+				if err := foundSubjects.UnionWith(found.FoundSubjects); err != nil {
+					return ps.rewriteError(ctx, err)
 				}
+			}
+		}
+
+		subjects := foundSubjects.AsSlice()
+		slices.SortFunc(subjects, func(a, b *dispatch.FoundSubject) int {
+			return cmp.Compare(a.SubjectId, b.SubjectId)
+		})
 
-				if resolvedExcludedSubject == nil {
+		var concreteCount uint32
+		for _, foundSubject := range subjects {
+			if foundSubject.SubjectId == tuple.PublicWildcard {
+				if sentWildcard {
 					continue
 				}
+				sentWildcard = true
+
+				var encodedCursor *v1.Cursor
+				if currentCursor != nil {
+					encodedCursor, err = cursor.EncodeFromDispatchCursor(currentCursor, lsRequestHash, atRevision)
+					if err != nil {
+						return ps.rewriteError(ctx, err)
+					}
+				}
 
-				excludedSubjects = append(excludedSubjects, resolvedExcludedSubject)
+				if _, err := sendFoundSubject(foundSubject, encodedCursor); err != nil {
+					return err
+				}
+				continue
 			}
 
-			subject, err := foundSubjectToResolvedSubject(ctx, foundSubject, caveatContext, ds)
-			if err != nil {
-				return err
+			if remaining > 0 && concreteCount == remaining {
+				break
 			}
-			if subject == nil {
-				continue
+			concreteCount++
+
+			currentCursor = graph.NewLookupSubjectsCursor(foundSubject.SubjectId)
+			encodedCursor, err := cursor.EncodeFromDispatchCursor(currentCursor, lsRequestHash, atRevision)
+			if err != nil {
+				return ps.rewriteError(ctx, err)
 			}
 
-			err = resp.Send(&v1.LookupSubjectsResponse{
-				Subject:            subject,
-				ExcludedSubjects:   excludedSubjects,
-				LookedUpAt:         revisionReadAt,
-				SubjectObjectId:    foundSubject.SubjectId,    // Deprecated
-				ExcludedSubjectIds: excludedSubjectIDs,        // Deprecated
-				Permissionship:     subject.Permissionship,    // Deprecated
-				PartialCaveatInfo:  subject.PartialCaveatInfo, // Deprecated
-			})
+			sent, err := sendFoundSubject(foundSubject, encodedCursor)
 			if err != nil {
 				return err
 			}
+			if sent {
+				sentCount++
+			}
 		}
 
-		dispatchpkg.AddResponseMetadata(respMetadata, result.Metadata)
-		return nil
-	})
-
-	bf, err := dispatch.NewTraversalBloomFilter(uint(ps.config.MaximumAPIDepth))
-	if err != nil {
-		return err
-	}
-
-	err = ps.dispatch.DispatchLookupSubjects(
-		&dispatch.DispatchLookupSubjectsRequest{
-			Metadata: &dispatch.ResolverMeta{
-				AtRevision:     atRevision.String(),
-				DepthRemaining: ps.config.MaximumAPIDepth,
-				TraversalBloom: bf,
-			},
-			ResourceRelation: &core.RelationReference{
-				Namespace: req.Resource.ObjectType,
-				Relation:  req.Permission,
-			},
-			ResourceIds: []string{req.Resource.ObjectId},
-			SubjectRelation: &core.RelationReference{
-				Namespace: req.SubjectObjectType,
-				Relation:  stringz.DefaultEmpty(req.OptionalSubjectRelation, tuple.Ellipsis),
-			},
-		},
-		stream)
-	if err != nil {
-		return ps.rewriteError(ctx, err)
+		if remaining == 0 || concreteCount < remaining || sentCount == limit {
+			return nil
+		}
 	}
-
-	return nil
 }
 
 func foundSubjectToResolvedSubject(ctx context.Context, foundSubject *dispatch.FoundSubject, caveatContext map[string]any, ds datastore.CaveatReader) (*v1.ResolvedSubject, error) {
@@ -25,6 +25,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/graph"
 	"github.com/authzed/spicedb/internal/namespace"
 	"github.com/authzed/spicedb/internal/services/shared"
 	v1svc "github.com/authzed/spicedb/internal/services/v1"
@@ -1430,6 +1431,113 @@ type expectedSubject struct {
 	isConditional bool
 }
 
+func TestLookupSubjectsWithLimitAndCursor(t *testing.T) {
+	req := require.New(t)
+	conn, cleanup, _, revision := testserver.NewTestServer(req, testTimedeltas[0], memdb.DisableGC, true,
+		func(ds datastore.Datastore, require *require.Assertions) (datastore.Datastore, datastore.Revision) {
+			return tf.DatastoreFromSchemaAndTestRelationships(ds, `
+				definition user {}
+
+				caveat testcaveat(somecondition int) {
+					somecondition == 42
+				}
+
+				definition group {
+					relation member: user
+				}
+
+				definition document {
+					relation viewer: user | user:* | user with testcaveat | group#member
+					relation banned: user
+					permission view = viewer - banned
+				}
+			`, []*core.RelationTuple{
+				tuple.MustParse("document:first#viewer@user:alice"),
+				tuple.MustParse("document:first#viewer@user:bob"),
+				tuple.MustWithCaveat(tuple.MustParse("document:first#viewer@user:carl"), "testcaveat"),
+				tuple.MustParse("document:first#viewer@user:*"),
+				tuple.MustParse("document:first#viewer@group:staff#member"),
+				tuple.MustParse("group:staff#member@user:dana"),
+				tuple.MustParse("group:staff#member@user:eve"),
+				tuple.MustParse("group:staff#member@user:frank"),
+				tuple.MustParse("group:staff#member@user:bob"),
+				tuple.MustParse("document:first#banned@user:eve"),
+			}, require)
+		})
+
+	client := v1.NewPermissionsServiceClient(conn)
+	t.Cleanup(cleanup)
+
+	// carl is found, but the caveat on its relationship is not satisfied by the context, so
+	// pages which reach carl must continue past it to fill the limit.
+	caveatContext, err := structpb.NewStruct(map[string]any{"somecondition": 41})
+	req.NoError(err)
+
+	for _, limit := range []uint32{1, 2, 3, 100} {
+		limit := limit
+		t.Run(fmt.Sprintf("limit-%d", limit), func(t *testing.T) {
+			require := require.New(t)
+
+			var concreteSubjectIDs []string
+			var cursor *v1.Cursor
+			for {
+				lookupClient, err := client.LookupSubjects(context.Background(), &v1.LookupSubjectsRequest{
+					Consistency: &v1.Consistency{
+						Requirement: &v1.Consistency_AtLeastAsFresh{
+							AtLeastAsFresh: zedtoken.MustNewFromRevision(revision),
+						},
+					},
+					Resource:              obj("document", "first"),
+					Permission:            "view",
+					SubjectObjectType:     "user",
+					Context:               caveatContext,
+					OptionalConcreteLimit: limit,
+					OptionalCursor:        cursor,
+				})
+				require.NoError(err)
+
+				isFirstPage := cursor == nil
+				var pageSubjectIDs []string
+				foundWildcard := false
+				for {
+					resp, err := lookupClient.Recv()
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					require.NoError(err)
+
+					if resp.Subject.SubjectObjectId == tuple.PublicWildcard {
+						// The wildcard is returned with the first page only, along with all of its
+						// exclusions.
+						require.False(foundWildcard)
+						foundWildcard = true
+
+						excludedSubjectIDs := make([]string, 0, len(resp.ExcludedSubjects))
+						for _, excluded := range resp.ExcludedSubjects {
+							excludedSubjectIDs = append(excludedSubjectIDs, excluded.SubjectObjectId)
+						}
+						require.Equal([]string{"eve"}, excludedSubjectIDs)
+						continue
+					}
+
+					require.NotNil(resp.AfterResultCursor)
+					pageSubjectIDs = append(pageSubjectIDs, resp.Subject.SubjectObjectId)
+					cursor = resp.AfterResultCursor
+				}
+
+				require.Equal(isFirstPage, foundWildcard)
+				require.LessOrEqual(len(pageSubjectIDs), int(limit))
+				concreteSubjectIDs = append(concreteSubjectIDs, pageSubjectIDs...)
+				if len(pageSubjectIDs) < int(limit) {
+					break
+				}
+			}
+
+			require.Equal([]string{"alice", "bob", "dana", "frank"}, concreteSubjectIDs)
+		})
+	}
+}
+
 func bySubjectID(a, b expectedSubject) int {
 	return cmp.Compare(a.subjectID, b.subjectID)
 }
@@ -1665,7 +1773,7 @@ func TestCheckBulkPermissions(t *testing.T) {
 					resp: v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
 				},
 			},
-			expectedDispatchCount: 49,
+			expectedDispatchCount: 17,
 		},
 		{
 			name: "different resources, same permission and subject",
@@ -1783,6 +1891,34 @@ func TestCheckBulkPermissions(t *testing.T) {
 			})(),
 			expectedDispatchCount: 11,
 		},
+		{
+			name: "subject chunking test",
+			requests: (func() []string {
+				toReturn := make([]string, 0, datastore.FilterMaximumIDCount+6)
+				toReturn = append(toReturn, `document:masterplan#view@user:eng_lead[test:{"secret": "1234"}]`)
+				for i := 0; i < int(datastore.FilterMaximumIDCount+5); i++ {
+					toReturn = append(toReturn, fmt.Sprintf(`document:masterplan#view@user:someuser-%d[test:{"secret": "1234"}]`, i))
+				}
+
+				return toReturn
+			})(),
+			response: (func() []bulkCheckTest {
+				toReturn := make([]bulkCheckTest, 0, datastore.FilterMaximumIDCount+6)
+				toReturn = append(toReturn, bulkCheckTest{
+					req:  `document:masterplan#view@user:eng_lead[test:{"secret": "1234"}]`,
+					resp: v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+				})
+				for i := 0; i < int(datastore.FilterMaximumIDCount+5); i++ {
+					toReturn = append(toReturn, bulkCheckTest{
+						req:  fmt.Sprintf(`document:masterplan#view@user:someuser-%d[test:{"secret": "1234"}]`, i),
+						resp: v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
+					})
+				}
+
+				return toReturn
+			})(),
+			expectedDispatchCount: 33,
+		},
 		{
 			name: "chunking test with errors",
 			requests: (func() []string {
@@ -1813,6 +1949,29 @@ func TestCheckBulkPermissions(t *testing.T) {
 			})(),
 			expectedDispatchCount: 11,
 		},
+		{
+			name: "same resource and permission, different subjects with a wildcard",
+			requests: []string{
+				`document:masterplan#view@user:eng_lead[test:{"secret": "1234"}]`,
+				`document:masterplan#view@user:*[test:{"secret": "1234"}]`,
+				`document:masterplan#view@user:villain[test:{"secret": "1234"}]`,
+			},
+			response: []bulkCheckTest{
+				{
+					req:  `document:masterplan#view@user:eng_lead[test:{"secret": "1234"}]`,
+					resp: v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+				},
+				{
+					req: `document:masterplan#view@user:*[test:{"secret": "1234"}]`,
+					err: graph.NewErrInvalidArgument(errors.New("cannot perform check on wildcard")),
+				},
+				{
+					req:  `document:masterplan#view@user:villain[test:{"secret": "1234"}]`,
+					resp: v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
+				},
+			},
+			expectedDispatchCount: 17,
+		},
 		{
 			name: "same resource and permission with same subject, repeated",
 			requests: []string{
@@ -15,6 +15,7 @@ import (
 
 	"github.com/authzed/spicedb/internal/dispatch"
 	"github.com/authzed/spicedb/internal/middleware"
+	"github.com/authzed/spicedb/internal/middleware/audit"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/middleware/handwrittenvalidation"
 	"github.com/authzed/spicedb/internal/middleware/streamtimeout"
@@ -29,6 +30,7 @@ import (
 	"github.com/authzed/spicedb/pkg/genutil"
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	"github.com/authzed/spicedb/pkg/middleware/consistency"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	dispatchv1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
 	"github.com/authzed/spicedb/pkg/tuple"
 	"github.com/authzed/spicedb/pkg/zedtoken"
@@ -73,6 +75,10 @@ type PermissionsServerConfig struct {
 	// MaxCheckBulkConcurrency defines the maximum number of concurrent checks that can be
 	// made in a single CheckBulkPermissions call.
 	MaxCheckBulkConcurrency uint16
+
+	// MaxLookupPermissionsConcurrency defines the maximum number of concurrent dispatches that
+	// can be made in a single LookupPermissions call.
+	MaxLookupPermissionsConcurrency uint16
 }
 
 // NewPermissionsServer creates a PermissionsServiceServer instance.
@@ -127,6 +133,10 @@ type permissionServer struct {
 
 func (ps *permissionServer) ReadRelationships(req *v1.ReadRelationshipsRequest, resp v1.PermissionsService_ReadRelationshipsServer) error {
 	ctx := resp.Context()
+	if err := validateLimit("ReadRelationshipsRequest.OptionalLimit", req.OptionalLimit); err != nil {
+		return ps.rewriteError(ctx, err)
+	}
+
 	atRevision, revisionReadAt, err := consistency.RevisionFromContext(ctx)
 	if err != nil {
 		return ps.rewriteError(ctx, err)
@@ -280,6 +290,23 @@ func (ps *permissionServer) WriteRelationships(ctx context.Context, req *v1.Writ
 	// Execute the write operation(s).
 	span.AddEvent("read write transaction")
 	tupleUpdates := tuple.UpdateFromRelationshipUpdates(req.Updates)
+
+	// The audited form of the updates is built before they are written, as a failure to build it
+	// once they are committed could not be reported to the caller.
+	var auditedUpdates []audit.RelationshipUpdate
+	if audit.Enabled(ctx) {
+		var err error
+		auditedUpdates, err = audit.RelationshipUpdates(tupleUpdates)
+		if err != nil {
+			return nil, ps.rewriteError(ctx, err)
+		}
+	}
+
+	txMetadata, err := transactionMetadata(ctx, req.OptionalTransactionMetadata)
+	if err != nil {
+		return nil, ps.rewriteError(ctx, err)
+	}
+
 	revision, err := ds.ReadWriteTx(ctx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
 		span.AddEvent("preconditions")
 
@@ -314,7 +341,7 @@ func (ps *permissionServer) WriteRelationships(ctx context.Context, req *v1.Writ
 
 		span.AddEvent("write relationships")
 		return rwt.WriteRelationships(ctx, tupleUpdates)
-	})
+	}, options.WithMetadata(txMetadata))
 	if err != nil {
 		return nil, ps.rewriteError(ctx, err)
 	}
@@ -329,12 +356,22 @@ func (ps *permissionServer) WriteRelationships(ctx context.Context, req *v1.Writ
 		writeUpdateCounter.WithLabelValues(v1.RelationshipUpdate_Operation_name[int32(kind)]).Observe(float64(count))
 	}
 
+	writtenAt := zedtoken.MustNewFromRevision(revision)
+	audit.Emit(ctx, audit.Record{
+		ZedToken:            writtenAt.Token,
+		RelationshipUpdates: auditedUpdates,
+	})
+
 	return &v1.WriteRelationshipsResponse{
-		WrittenAt: zedtoken.MustNewFromRevision(revision),
+		WrittenAt: writtenAt,
 	}, nil
 }
 
 func (ps *permissionServer) DeleteRelationships(ctx context.Context, req *v1.DeleteRelationshipsRequest) (*v1.DeleteRelationshipsResponse, error) {
+	if err := validateLimit("DeleteRelationshipsRequest.OptionalLimit", req.OptionalLimit); err != nil {
+		return nil, ps.rewriteError(ctx, err)
+	}
+
 	if len(req.OptionalPreconditions) > int(ps.config.MaxPreconditionsCount) {
 		return nil, ps.rewriteError(
 			ctx,
@@ -342,10 +379,26 @@ func (ps *permissionServer) DeleteRelationships(ctx context.Context, req *v1.Del
 		)
 	}
 
+	txMetadata, err := transactionMetadata(ctx, req.OptionalTransactionMetadata)
+	if err != nil {
+		return nil, ps.rewriteError(ctx, err)
+	}
+
+	// The deleted relationships are spooled for the audit log until the deletion commits.
+	spool, err := audit.NewSpool(ctx)
+	if err != nil {
+		return nil, ps.rewriteError(ctx, err)
+	}
+	defer spool.Close()
+
 	ds := datastoremw.MustFromContext(ctx)
 	deletionProgress := v1.DeleteRelationshipsResponse_DELETION_PROGRESS_COMPLETE
 
 	revision, err := ds.ReadWriteTx(ctx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		if err := spool.Reset(); err != nil {
+			return err
+		}
+
 		if err := validateRelationshipsFilter(ctx, req.RelationshipFilter, rwt); err != nil {
 			return err
 		}
@@ -401,35 +454,110 @@ func (ps *permissionServer) DeleteRelationships(ctx context.Context, req *v1.Del
 			iter.Close()
 		}
 
-		// Delete with the specified limit.
-		if req.OptionalLimit > 0 {
-			deleteLimit := uint64(req.OptionalLimit)
-			reachedLimit, err := rwt.DeleteRelationships(ctx, req.RelationshipFilter, options.WithDeleteLimit(&deleteLimit))
-			if err != nil {
-				return err
-			}
-
-			if reachedLimit {
-				deletionProgress = v1.DeleteRelationshipsResponse_DELETION_PROGRESS_PARTIAL
-			}
-
-			return nil
+		// Delete with the specified limit, if any.
+		reachedLimit, err := deleteRelationships(ctx, rwt, req.RelationshipFilter, uint64(req.OptionalLimit), spool)
+		if err != nil {
+			return err
 		}
 
-		// Otherwise, kick off an unlimited deletion.
-		_, err = rwt.DeleteRelationships(ctx, req.RelationshipFilter)
-		return err
-	})
+		if req.OptionalLimit > 0 && reachedLimit {
+			deletionProgress = v1.DeleteRelationshipsResponse_DELETION_PROGRESS_PARTIAL
+		}
+		return nil
+	}, options.WithMetadata(txMetadata))
 	if err != nil {
 		return nil, ps.rewriteError(ctx, err)
 	}
 
+	deletedAt := zedtoken.MustNewFromRevision(revision)
+	spool.Emit(ctx, audit.Record{
+		ZedToken:           deletedAt.Token,
+		RelationshipFilter: audit.DeletedRelationships(req.RelationshipFilter, req.OptionalLimit),
+	})
+
 	return &v1.DeleteRelationshipsResponse{
-		DeletedAt:        zedtoken.MustNewFromRevision(revision),
+		DeletedAt:        deletedAt,
 		DeletionProgress: deletionProgress,
 	}, nil
 }
 
+// deleteRelationships deletes the relationships matching the filter, up to the limit if non-zero,
+// and returns whether the limit was reached. If the spool is non-nil, the deleted relationships
+// are first read and added to it, and a limited deletion then deletes exactly the relationships
+// read, as the datastore could otherwise choose different ones.
+func deleteRelationships(ctx context.Context, rwt datastore.ReadWriteTransaction, filter *v1.RelationshipFilter, limit uint64, spool *audit.Spool) (bool, error) {
+	if spool == nil {
+		if limit > 0 {
+			return rwt.DeleteRelationships(ctx, filter, options.WithDeleteLimit(&limit))
+		}
+		return rwt.DeleteRelationships(ctx, filter)
+	}
+
+	dsFilter, err := datastore.RelationshipsFilterFromPublicFilter(filter)
+	if err != nil {
+		return false, err
+	}
+
+	var queryOpts []options.QueryOptionsOption
+	if limit > 0 {
+		queryOpts = append(queryOpts, options.WithLimit(&limit))
+	}
+
+	iter, err := rwt.QueryRelationships(ctx, dsFilter, queryOpts...)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	// The relationships of a limited deletion are kept to be deleted once read, which is bounded
+	// by the limit; those of an unlimited deletion are only spooled, in batches.
+	var deleted []*core.RelationTupleUpdate
+	batch := make([]*core.RelationTupleUpdate, 0, audit.MaxRecordUpdates)
+	spoolBatch := func() error {
+		audited, err := audit.RelationshipUpdates(batch)
+		if err != nil {
+			return err
+		}
+		batch = batch[:0]
+		return spool.Append(audited)
+	}
+
+	for tpl := iter.Next(); tpl != nil; tpl = iter.Next() {
+		if iter.Err() != nil {
+			return false, iter.Err()
+		}
+
+		update := tuple.Delete(tpl)
+		if limit > 0 {
+			deleted = append(deleted, update)
+		}
+
+		batch = append(batch, update)
+		if len(batch) == audit.MaxRecordUpdates {
+			if err := spoolBatch(); err != nil {
+				return false, err
+			}
+		}
+	}
+	if iter.Err() != nil {
+		return false, iter.Err()
+	}
+	iter.Close()
+
+	if err := spoolBatch(); err != nil {
+		return false, err
+	}
+
+	if limit == 0 {
+		return rwt.DeleteRelationships(ctx, filter)
+	}
+
+	if err := rwt.WriteRelationships(ctx, deleted); err != nil {
+		return false, err
+	}
+	return uint64(len(deleted)) == limit, nil
+}
+
 var emptyPrecondition = &v1.Precondition{}
 
 func validatePrecondition(ctx context.Context, precond *v1.Precondition, reader datastore.Reader) error {
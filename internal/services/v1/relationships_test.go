@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/authzed/spicedb/internal/datastore/memdb"
 	tf "github.com/authzed/spicedb/internal/testfixtures"
@@ -550,6 +551,72 @@ func TestWriteCaveatedRelationships(t *testing.T) {
 	}
 }
 
+func TestWriteRelationshipsWithExpiration(t *testing.T) {
+	req := require.New(t)
+
+	conn, cleanup, _, _ := testserver.NewTestServer(req, 0, memdb.DisableGC, true, tf.StandardDatastoreWithData)
+	client := v1.NewPermissionsServiceClient(conn)
+	t.Cleanup(cleanup)
+
+	expiresAt := timestamppb.New(time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond))
+	relWritten := rel("document", "companyplan", "viewer", "user", "contractor", "")
+	relWritten.OptionalExpiresAt = expiresAt
+
+	expiredRel := rel("document", "companyplan", "viewer", "user", "formercontractor", "")
+	expiredRel.OptionalExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+
+	resp, err := client.WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			{
+				Operation:    v1.RelationshipUpdate_OPERATION_CREATE,
+				Relationship: relWritten,
+			},
+			{
+				Operation:    v1.RelationshipUpdate_OPERATION_CREATE,
+				Relationship: expiredRel,
+			},
+		},
+	})
+	req.NoError(err)
+
+	// The unexpired relationship is read back with its expiration.
+	relRead := readFirst(req, client, resp.WrittenAt, relWritten)
+	req.True(proto.Equal(relWritten, relRead), "expected %v, found %v", relWritten, relRead)
+
+	// The expired relationship is filtered out.
+	stream, err := client.ReadRelationships(context.Background(), &v1.ReadRelationshipsRequest{
+		Consistency: &v1.Consistency{
+			Requirement: &v1.Consistency_AtExactSnapshot{
+				AtExactSnapshot: resp.WrittenAt,
+			},
+		},
+		RelationshipFilter: tuple.RelToFilter(expiredRel),
+	})
+	req.NoError(err)
+
+	_, err = stream.Recv()
+	req.ErrorIs(err, io.EOF)
+
+	// Checks respect the expiration as well.
+	for subjectID, expected := range map[string]v1.CheckPermissionResponse_Permissionship{
+		"contractor":       v1.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION,
+		"formercontractor": v1.CheckPermissionResponse_PERMISSIONSHIP_NO_PERMISSION,
+	} {
+		checkResp, err := client.CheckPermission(context.Background(), &v1.CheckPermissionRequest{
+			Consistency: &v1.Consistency{
+				Requirement: &v1.Consistency_AtLeastAsFresh{
+					AtLeastAsFresh: resp.WrittenAt,
+				},
+			},
+			Resource:   obj("document", "companyplan"),
+			Permission: "view",
+			Subject:    sub("user", subjectID, ""),
+		})
+		req.NoError(err)
+		req.Equal(expected, checkResp.Permissionship, "unexpected permissionship for %s", subjectID)
+	}
+}
+
 func readFirst(require *require.Assertions, client v1.PermissionsServiceClient, token *v1.ZedToken, rel *v1.Relationship) *v1.Relationship {
 	stream, err := client.ReadRelationships(context.Background(), &v1.ReadRelationshipsRequest{
 		Consistency: &v1.Consistency{
@@ -10,6 +10,7 @@ import (
 
 	log "github.com/authzed/spicedb/internal/logging"
 	"github.com/authzed/spicedb/internal/middleware"
+	"github.com/authzed/spicedb/internal/middleware/audit"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/middleware/usagemetrics"
 	"github.com/authzed/spicedb/internal/services/shared"
@@ -125,12 +126,20 @@ func (ss *schemaServer) WriteSchema(ctx context.Context, in *v1.WriteSchemaReque
 	}
 
 	// Update the schema.
+	var schemaDiff []audit.DefinitionDiff
 	revision, err := ds.ReadWriteTx(ctx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
 		applied, err := shared.ApplySchemaChanges(ctx, rwt, validated)
 		if err != nil {
 			return err
 		}
 
+		if audit.Enabled(ctx) {
+			schemaDiff, err = audit.SchemaDiff(applied.ObjectDefDiffs, applied.CaveatDefDiffs)
+			if err != nil {
+				return err
+			}
+		}
+
 		dispatchCount, err := genutil.EnsureUInt32(applied.TotalOperationCount)
 		if err != nil {
 			return err
@@ -145,7 +154,13 @@ func (ss *schemaServer) WriteSchema(ctx context.Context, in *v1.WriteSchemaReque
 		return nil, ss.rewriteError(ctx, err)
 	}
 
+	writtenAt := zedtoken.MustNewFromRevision(revision)
+	audit.Emit(ctx, audit.Record{
+		ZedToken:   writtenAt.Token,
+		SchemaDiff: schemaDiff,
+	})
+
 	return &v1.WriteSchemaResponse{
-		WrittenAt: zedtoken.MustNewFromRevision(revision),
+		WrittenAt: writtenAt,
 	}, nil
 }
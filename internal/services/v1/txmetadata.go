@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TransactionMetadataHeader is the request header holding the metadata, as a JSON object, to
+// attach to the transaction of a WriteRelationships or DeleteRelationships call.
+//
+// Deprecated: set the optional_transaction_metadata field of the request instead. The header is
+// only read from requests which do not set the field.
+const TransactionMetadataHeader = "spicedb-transaction-metadata"
+
+// transactionMetadataField is the name of the field of WriteRelationships and DeleteRelationships
+// requests holding the metadata of their transaction.
+const transactionMetadataField = "optional_transaction_metadata"
+
+// maxTransactionMetadataSize is the maximum size, in bytes, of the metadata of a transaction.
+const maxTransactionMetadataSize = 4096
+
+// transactionMetadata returns the metadata to attach to the transaction of a request, which is
+// persisted with the transaction and returned with its changes by the Watch APIs. The metadata
+// is that of the request, or that found in its headers if the request has none, or nil if there
+// is none.
+func transactionMetadata(ctx context.Context, requested *structpb.Struct) (*structpb.Struct, error) {
+	values := metadata.ValueFromIncomingContext(ctx, TransactionMetadataHeader)
+	if requested != nil {
+		if len(values) > 0 {
+			return nil, NewInvalidTransactionMetadataErr(transactionMetadataField, fmt.Sprintf("it cannot be given along with the `%s` header", TransactionMetadataHeader))
+		}
+
+		if proto.Size(requested) > maxTransactionMetadataSize {
+			return nil, NewInvalidTransactionMetadataErr(transactionMetadataField, fmt.Sprintf("it exceeds the maximum allowed size of %d bytes", maxTransactionMetadataSize))
+		}
+
+		if len(requested.Fields) == 0 {
+			return nil, nil
+		}
+		return requested, nil
+	}
+
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, NewInvalidTransactionMetadataErr(TransactionMetadataHeader, "the header was given more than once")
+	}
+
+	if len(values[0]) > maxTransactionMetadataSize {
+		return nil, NewInvalidTransactionMetadataErr(TransactionMetadataHeader, fmt.Sprintf("it exceeds the maximum allowed size of %d bytes", maxTransactionMetadataSize))
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(values[0]), &decoded); err != nil {
+		return nil, NewInvalidTransactionMetadataErr(TransactionMetadataHeader, "it must be a JSON object")
+	}
+
+	if len(decoded) == 0 {
+		return nil, nil
+	}
+
+	converted, err := structpb.NewStruct(decoded)
+	if err != nil {
+		return nil, NewInvalidTransactionMetadataErr(TransactionMetadataHeader, err.Error())
+	}
+	return converted, nil
+}
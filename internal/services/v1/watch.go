@@ -3,12 +3,14 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	grpcvalidate "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/validator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/internal/middleware/usagemetrics"
@@ -17,6 +19,9 @@ import (
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	dispatchv1 "github.com/authzed/spicedb/pkg/proto/dispatch/v1"
+	extensionsv1 "github.com/authzed/spicedb/pkg/proto/extensions/v1"
+	"github.com/authzed/spicedb/pkg/schemadsl/generator"
+	"github.com/authzed/spicedb/pkg/spiceerrors"
 	"github.com/authzed/spicedb/pkg/tuple"
 	"github.com/authzed/spicedb/pkg/zedtoken"
 )
@@ -40,19 +45,59 @@ func NewWatchServer(heartbeatDuration time.Duration) v1.WatchServiceServer {
 }
 
 func (ws *watchServer) Watch(req *v1.WatchRequest, stream v1.WatchService_WatchServer) error {
-	if len(req.GetOptionalObjectTypes()) > 0 && len(req.OptionalRelationshipFilters) > 0 {
+	return ws.watch(stream.Context(), watchParameters{
+		objectTypes:         req.GetOptionalObjectTypes(),
+		startCursor:         req.OptionalStartCursor,
+		relationshipFilters: req.OptionalRelationshipFilters,
+		content:             datastore.WatchRelationships,
+	}, func(update watchUpdate) error {
+		// NOTE: checkpoints are only available via the extensions API, which flags them as such.
+		if len(update.relationshipUpdates) == 0 {
+			return nil
+		}
+
+		return stream.Send(&v1.WatchResponse{
+			Updates:                     update.relationshipUpdates,
+			ChangesThrough:              update.changesThrough,
+			OptionalTransactionMetadata: update.transactionMetadata,
+		})
+	})
+}
+
+// watchParameters are the parameters for a watch, shared between the v1 and extensions watch APIs.
+type watchParameters struct {
+	objectTypes         []string
+	startCursor         *v1.ZedToken
+	relationshipFilters []*v1.RelationshipFilter
+	content             datastore.WatchContent
+}
+
+// watchUpdate holds the filtered changes that occurred at a single revision, or a checkpoint
+// indicating that all changes through the revision have been sent.
+type watchUpdate struct {
+	relationshipUpdates []*v1.RelationshipUpdate
+	schemaUpdates       []*extensionsv1.SchemaUpdate
+	changesThrough      *v1.ZedToken
+	isCheckpoint        bool
+
+	// transactionMetadata is the metadata attached to the transaction which made the
+	// relationship updates, if any.
+	transactionMetadata *structpb.Struct
+}
+
+func (ws *watchServer) watch(ctx context.Context, params watchParameters, send func(watchUpdate) error) error {
+	if len(params.objectTypes) > 0 && len(params.relationshipFilters) > 0 {
 		return status.Errorf(codes.InvalidArgument, "cannot specify both object types and relationship filters")
 	}
 
-	objectTypes := mapz.NewSet[string](req.GetOptionalObjectTypes()...)
-	filters := make([]datastore.RelationshipsFilter, 0, len(req.OptionalRelationshipFilters))
+	objectTypes := mapz.NewSet[string](params.objectTypes...)
+	filters := make([]datastore.RelationshipsFilter, 0, len(params.relationshipFilters))
 
-	ctx := stream.Context()
 	ds := datastoremw.MustFromContext(ctx)
 
 	var afterRevision datastore.Revision
-	if req.OptionalStartCursor != nil && req.OptionalStartCursor.Token != "" {
-		decodedRevision, err := zedtoken.DecodeRevision(req.OptionalStartCursor, ds)
+	if params.startCursor != nil && params.startCursor.Token != "" {
+		decodedRevision, err := zedtoken.DecodeRevision(params.startCursor, ds)
 		if err != nil {
 			return status.Errorf(codes.InvalidArgument, "failed to decode start revision: %s", err)
 		}
@@ -68,8 +113,8 @@ func (ws *watchServer) Watch(req *v1.WatchRequest, stream v1.WatchService_WatchS
 
 	reader := ds.SnapshotReader(afterRevision)
 
-	for _, filter := range req.OptionalRelationshipFilters {
-		if err := validateRelationshipsFilter(stream.Context(), filter, reader); err != nil {
+	for _, filter := range params.relationshipFilters {
+		if err := validateRelationshipsFilter(ctx, filter, reader); err != nil {
 			return ws.rewriteError(ctx, err)
 		}
 
@@ -86,19 +131,44 @@ func (ws *watchServer) Watch(req *v1.WatchRequest, stream v1.WatchService_WatchS
 	})
 
 	updates, errchan := ds.Watch(ctx, afterRevision, datastore.WatchOptions{
-		Content:            datastore.WatchRelationships,
+		Content:            params.content,
 		CheckpointInterval: ws.heartbeatDuration,
 	})
 	for {
 		select {
 		case update, ok := <-updates:
 			if ok {
-				filtered := filterUpdates(objectTypes, filters, update.RelationshipChanges)
-				if len(filtered) > 0 {
-					if err := stream.Send(&v1.WatchResponse{
-						Updates:        filtered,
-						ChangesThrough: zedtoken.MustNewFromRevision(update.Revision),
-					}); err != nil {
+				if update.IsCheckpoint {
+					if params.content&datastore.WatchCheckpoints == datastore.WatchCheckpoints {
+						if err := send(watchUpdate{
+							changesThrough: zedtoken.MustNewFromRevision(update.Revision),
+							isCheckpoint:   true,
+						}); err != nil {
+							return status.Errorf(codes.Canceled, "watch canceled by user: %s", err)
+						}
+					}
+					continue
+				}
+
+				var filtered watchUpdate
+				if params.content&datastore.WatchRelationships == datastore.WatchRelationships {
+					filtered.relationshipUpdates = filterUpdates(objectTypes, filters, update.RelationshipChanges)
+					if len(filtered.relationshipUpdates) > 0 {
+						filtered.transactionMetadata = update.Metadata
+					}
+				}
+
+				if params.content&datastore.WatchSchema == datastore.WatchSchema {
+					schemaUpdates, err := schemaUpdatesFor(objectTypes, filters, update)
+					if err != nil {
+						return status.Errorf(codes.Internal, "failed to convert schema updates: %s", err)
+					}
+					filtered.schemaUpdates = schemaUpdates
+				}
+
+				if len(filtered.relationshipUpdates) > 0 || len(filtered.schemaUpdates) > 0 {
+					filtered.changesThrough = zedtoken.MustNewFromRevision(update.Revision)
+					if err := send(filtered); err != nil {
 						return status.Errorf(codes.Canceled, "watch canceled by user: %s", err)
 					}
 				}
@@ -109,6 +179,8 @@ func (ws *watchServer) Watch(req *v1.WatchRequest, stream v1.WatchService_WatchS
 				return status.Errorf(codes.Canceled, "watch canceled by user: %s", err)
 			case errors.As(err, &datastore.ErrWatchDisconnected{}):
 				return status.Errorf(codes.ResourceExhausted, "watch disconnected: %s", err)
+			case errors.As(err, &datastore.ErrWatchRevisionTooOld{}):
+				return spiceerrors.WithCodeAndReason(err, codes.OutOfRange, v1.ErrorReason_ERROR_REASON_INVALID_CURSOR)
 			default:
 				return status.Errorf(codes.Internal, "watch error: %s", err)
 			}
@@ -155,3 +227,113 @@ func filterUpdates(objectTypes *mapz.Set[string], filters []datastore.Relationsh
 
 	return filtered
 }
+
+// schemaUpdatesFor returns the schema updates found in the revision changes. If object types are
+// given, only updates to those object definitions are returned, along with all caveat updates. If
+// relationship filters are given, only updates to the definitions of the resource types and caveats
+// of the relationships they match are returned.
+func schemaUpdatesFor(objectTypes *mapz.Set[string], filters []datastore.RelationshipsFilter, changes *datastore.RevisionChanges) ([]*extensionsv1.SchemaUpdate, error) {
+	var updates []*extensionsv1.SchemaUpdate
+	for _, changed := range changes.ChangedDefinitions {
+		switch def := changed.(type) {
+		case *core.NamespaceDefinition:
+			if !objectTypes.IsEmpty() && !objectTypes.Has(def.Name) {
+				continue
+			}
+			if !filtersMatchDefinition(filters, def.Name) {
+				continue
+			}
+
+			schemaText, _, err := generator.GenerateSource(def)
+			if err != nil {
+				return nil, err
+			}
+
+			updates = append(updates, &extensionsv1.SchemaUpdate{
+				Operation:      extensionsv1.SchemaUpdate_OPERATION_WRITE,
+				DefinitionKind: extensionsv1.SchemaUpdate_DEFINITION_KIND_OBJECT,
+				DefinitionName: def.Name,
+				SchemaText:     schemaText,
+			})
+
+		case *core.CaveatDefinition:
+			if !filtersMatchCaveat(filters, def.Name) {
+				continue
+			}
+
+			schemaText, _, err := generator.GenerateCaveatSource(def)
+			if err != nil {
+				return nil, err
+			}
+
+			updates = append(updates, &extensionsv1.SchemaUpdate{
+				Operation:      extensionsv1.SchemaUpdate_OPERATION_WRITE,
+				DefinitionKind: extensionsv1.SchemaUpdate_DEFINITION_KIND_CAVEAT,
+				DefinitionName: def.Name,
+				SchemaText:     schemaText,
+			})
+
+		default:
+			return nil, fmt.Errorf("unknown schema definition type %T", changed)
+		}
+	}
+
+	for _, deletedNamespace := range changes.DeletedNamespaces {
+		if !objectTypes.IsEmpty() && !objectTypes.Has(deletedNamespace) {
+			continue
+		}
+		if !filtersMatchDefinition(filters, deletedNamespace) {
+			continue
+		}
+
+		updates = append(updates, &extensionsv1.SchemaUpdate{
+			Operation:      extensionsv1.SchemaUpdate_OPERATION_DELETE,
+			DefinitionKind: extensionsv1.SchemaUpdate_DEFINITION_KIND_OBJECT,
+			DefinitionName: deletedNamespace,
+		})
+	}
+
+	for _, deletedCaveat := range changes.DeletedCaveats {
+		if !filtersMatchCaveat(filters, deletedCaveat) {
+			continue
+		}
+
+		updates = append(updates, &extensionsv1.SchemaUpdate{
+			Operation:      extensionsv1.SchemaUpdate_OPERATION_DELETE,
+			DefinitionKind: extensionsv1.SchemaUpdate_DEFINITION_KIND_CAVEAT,
+			DefinitionName: deletedCaveat,
+		})
+	}
+
+	return updates, nil
+}
+
+// filtersMatchDefinition returns true if no filters are given or if any of the filters can match
+// relationships whose resource is of the object definition.
+func filtersMatchDefinition(filters []datastore.RelationshipsFilter, definitionName string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	for _, filter := range filters {
+		if filter.OptionalResourceType == "" || filter.OptionalResourceType == definitionName {
+			return true
+		}
+	}
+	return false
+}
+
+// filtersMatchCaveat returns true if no filters are given or if any of the filters can match
+// relationships with the caveat.
+func filtersMatchCaveat(filters []datastore.RelationshipsFilter, caveatName string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	for _, filter := range filters {
+		if filter.OptionalCaveatName == "" || filter.OptionalCaveatName == caveatName {
+			return true
+		}
+	}
+	return false
+}
@@ -297,3 +297,70 @@ func sortUpdates(in []*v1.RelationshipUpdate) []*v1.RelationshipUpdate {
 
 	return out
 }
+
+func TestWatchOmitsCheckpoints(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, memdb.DisableGC, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := v1.NewWatchServiceClient(conn).Watch(ctx, &v1.WatchRequest{
+		OptionalObjectTypes: []string{"folder"},
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+	})
+	require.NoError(err)
+
+	// Write a relationship which does not match the filter, followed by one which does; only the
+	// latter must be sent, as checkpoints are only included via the extensions API.
+	client := v1.NewPermissionsServiceClient(conn)
+	_, err = client.WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	written, err := client.WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "folder", "folder1", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	resp, err := stream.Recv()
+	require.NoError(err)
+	require.Len(resp.Updates, 1)
+	require.Equal(written.WrittenAt.Token, resp.ChangesThrough.Token)
+}
+
+func TestWatchRevisionTooOld(t *testing.T) {
+	require := require.New(t)
+
+	conn, cleanup, _, revision := testserver.NewTestServer(require, 0, 10*time.Millisecond, true, testfixtures.StandardDatastoreWithData)
+	t.Cleanup(cleanup)
+
+	// Wait for the revision to fall outside of the GC window, and then advance past it.
+	time.Sleep(20 * time.Millisecond)
+
+	_, err := v1.NewPermissionsServiceClient(conn).WriteRelationships(context.Background(), &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{
+			update(v1.RelationshipUpdate_OPERATION_CREATE, "document", "document1", "viewer", "user", "user1"),
+		},
+	})
+	require.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := v1.NewWatchServiceClient(conn).Watch(ctx, &v1.WatchRequest{
+		OptionalStartCursor: zedtoken.MustNewFromRevision(revision),
+	})
+	require.NoError(err)
+
+	_, err = stream.Recv()
+	grpcutil.RequireStatus(t, codes.OutOfRange, err)
+	require.ErrorContains(err, "resync from a bulk export")
+}
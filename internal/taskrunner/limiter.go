@@ -0,0 +1,19 @@
+package taskrunner
+
+// ConcurrencyLimiter provides the maximum number of goroutines a task runner may use. The limit
+// may change over time, in which case task runners adjust the number of goroutines they use as
+// tasks complete.
+type ConcurrencyLimiter interface {
+	// ConcurrencyLimit returns the current concurrency limit.
+	ConcurrencyLimit() uint16
+}
+
+// StaticConcurrencyLimit is a ConcurrencyLimiter whose limit never changes.
+type StaticConcurrencyLimit uint16
+
+// ConcurrencyLimit implements ConcurrencyLimiter.
+func (scl StaticConcurrencyLimit) ConcurrencyLimit() uint16 {
+	return uint16(scl)
+}
+
+var _ ConcurrencyLimiter = StaticConcurrencyLimit(0)
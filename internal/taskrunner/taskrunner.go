@@ -13,17 +13,18 @@ type TaskRunner struct {
 	ctx    context.Context
 	cancel func()
 
-	// sem is a chan of length `concurrencyLimit` used to ensure the task runner does
-	// not exceed the concurrencyLimit with spawned goroutines.
-	sem chan struct{}
+	// limiter provides the concurrency limit, which the task runner ensures it does
+	// not exceed with spawned goroutines.
+	limiter ConcurrencyLimiter
 
 	// err holds the error returned by any task, if any. If the context is canceled,
 	// this err will hold the cancelation error.
 	err error
 
-	wg    sync.WaitGroup
-	lock  sync.Mutex
-	tasks []TaskFunc
+	wg      sync.WaitGroup
+	lock    sync.Mutex
+	tasks   []TaskFunc
+	running uint16
 }
 
 // TaskFunc defines functions representing tasks.
@@ -35,16 +36,20 @@ type TaskFunc func(ctx context.Context) error
 // started after that point will also be canceled and the error returned. If
 // a task returns an error, the context provided to all tasks is also canceled.
 func NewTaskRunner(ctx context.Context, concurrencyLimit uint16) *TaskRunner {
-	if concurrencyLimit < 1 {
-		concurrencyLimit = 1
-	}
+	return NewTaskRunnerWithLimiter(ctx, StaticConcurrencyLimit(concurrencyLimit))
+}
 
+// NewTaskRunnerWithLimiter creates a new task runner with the given starting context,
+// whose concurrency limit is provided by the given limiter. If the limit is lowered,
+// goroutines above the limit exit once their current task completes; if it is raised,
+// additional goroutines are spawned as further tasks are scheduled.
+func NewTaskRunnerWithLimiter(ctx context.Context, limiter ConcurrencyLimiter) *TaskRunner {
 	ctxWithCancel, cancel := context.WithCancel(ctx)
 	return &TaskRunner{
-		ctx:    ctxWithCancel,
-		cancel: cancel,
-		sem:    make(chan struct{}, concurrencyLimit),
-		tasks:  make([]TaskFunc, 0),
+		ctx:     ctxWithCancel,
+		cancel:  cancel,
+		limiter: limiter,
+		tasks:   make([]TaskFunc, 0),
 	}
 }
 
@@ -57,20 +62,26 @@ func (tr *TaskRunner) Schedule(f TaskFunc) {
 }
 
 func (tr *TaskRunner) spawnIfAvailable() {
-	// To spawn a runner, write a struct{} to the sem channel. If the task runner
-	// is already at the concurrency limit, then this chan write will fail,
-	// and nothing will be spawned. This also checks if the context has already
-	// been canceled, in which case nothing needs to be done.
-	select {
-	case tr.sem <- struct{}{}:
-		go tr.runner()
-
-	case <-tr.ctx.Done():
+	// If the context has already been canceled, nothing needs to be done.
+	if tr.ctx.Err() != nil {
 		return
+	}
 
-	default:
+	// If the task runner is already at the concurrency limit, nothing will be spawned.
+	tr.lock.Lock()
+	if tr.running >= tr.concurrencyLimit() {
+		tr.lock.Unlock()
 		return
 	}
+	tr.running++
+	tr.lock.Unlock()
+
+	go tr.runner()
+}
+
+// concurrencyLimit returns the current concurrency limit, which is at least 1.
+func (tr *TaskRunner) concurrencyLimit() uint16 {
+	return max(tr.limiter.ConcurrencyLimit(), 1)
 }
 
 func (tr *TaskRunner) runner() {
@@ -82,13 +93,11 @@ func (tr *TaskRunner) runner() {
 			return
 
 		default:
-			// Select a task from the list, if any.
+			// Select a task from the list, if any. If there are no further tasks or the
+			// concurrency limit has been lowered, the runner exits (freeing a slot
+			// potentially for another worker to be spawned later).
 			task := tr.selectTask()
 			if task == nil {
-				// If there are no further tasks, then "return" the struct{} by reading
-				// it from the channel (freeing a slot potentially for another worker
-				// to be spawned later).
-				<-tr.sem
 				return
 			}
 
@@ -119,7 +128,8 @@ func (tr *TaskRunner) selectTask() TaskFunc {
 	tr.lock.Lock()
 	defer tr.lock.Unlock()
 
-	if len(tr.tasks) == 0 {
+	if len(tr.tasks) == 0 || tr.running > tr.concurrencyLimit() {
+		tr.running--
 		return nil
 	}
 
@@ -146,6 +156,7 @@ func (tr *TaskRunner) emptyForCancel() {
 		tr.err = tr.ctx.Err()
 	}
 
+	tr.running--
 	for {
 		if len(tr.tasks) == 0 {
 			break
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -144,3 +145,92 @@ func TestTaskRunnerDoesNotBlockOnQueuing(t *testing.T) {
 		require.True(t, completed)
 	}, 5*time.Second)
 }
+
+type adjustableLimit struct {
+	limit atomic.Uint32
+}
+
+func (al *adjustableLimit) ConcurrencyLimit() uint16 {
+	return uint16(al.limit.Load())
+}
+
+func TestTaskRunnerFollowsLimiter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	limiter := &adjustableLimit{}
+	limiter.limit.Store(4)
+
+	var running, maxRunning atomic.Int32
+	task := func(ctx context.Context) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			seen := maxRunning.Load()
+			if current <= seen || maxRunning.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	}
+
+	tr := NewTaskRunnerWithLimiter(context.Background(), limiter)
+	for i := 0; i < 20; i++ {
+		tr.Schedule(task)
+	}
+
+	testutil.RequireWithin(t, func(t *testing.T) {
+		require.NoError(t, tr.Wait())
+	}, 5*time.Second)
+	require.Equal(t, int32(4), maxRunning.Load())
+
+	// Lower the limit: the runner must not exceed it for newly scheduled tasks.
+	limiter.limit.Store(1)
+	maxRunning.Store(0)
+
+	tr = NewTaskRunnerWithLimiter(context.Background(), limiter)
+	for i := 0; i < 10; i++ {
+		tr.Schedule(task)
+	}
+
+	testutil.RequireWithin(t, func(t *testing.T) {
+		require.NoError(t, tr.Wait())
+	}, 5*time.Second)
+	require.Equal(t, int32(1), maxRunning.Load())
+}
+
+func TestTaskRunnerShrinksWithLimiter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	limiter := &adjustableLimit{}
+	limiter.limit.Store(3)
+
+	var running, maxRunningAfterShrink atomic.Int32
+	var shrunk atomic.Bool
+	tr := NewTaskRunnerWithLimiter(context.Background(), limiter)
+	for i := 0; i < 30; i++ {
+		tr.Schedule(func(ctx context.Context) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			if shrunk.Load() && current > maxRunningAfterShrink.Load() {
+				maxRunningAfterShrink.Store(current)
+			}
+
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		})
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	limiter.limit.Store(1)
+	time.Sleep(10 * time.Millisecond)
+	shrunk.Store(true)
+
+	testutil.RequireWithin(t, func(t *testing.T) {
+		require.NoError(t, tr.Wait())
+	}, 5*time.Second)
+	require.LessOrEqual(t, maxRunningAfterShrink.Load(), int32(1))
+}
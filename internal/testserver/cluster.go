@@ -27,6 +27,10 @@ import (
 
 const TestResolverScheme = "test"
 
+// clusterPresharedKey is the preshared key authenticating the dispatches between the nodes of
+// test clusters.
+const clusterPresharedKey = "clusterpresharedkey"
+
 type TempError struct{}
 
 func (t TempError) Error() string {
@@ -163,6 +167,7 @@ func TestClusterWithDispatchAndCacheConfig(t testing.TB, size uint, ds datastore
 	for i := uint(0); i < size; i++ {
 		dispatcherOptions := []combineddispatch.Option{
 			combineddispatch.UpstreamAddr("test://" + prefix),
+			combineddispatch.GrpcPresharedKey(clusterPresharedKey),
 			combineddispatch.PrometheusSubsystem(fmt.Sprintf("%s_%d_client_dispatch", prefix, i)),
 			combineddispatch.GrpcDialOpts(
 				grpc.WithDefaultServiceConfig(
@@ -204,6 +209,7 @@ func TestClusterWithDispatchAndCacheConfig(t testing.TB, size uint, ds datastore
 			server.WithGRPCAuthFunc(func(ctx context.Context) (context.Context, error) {
 				return ctx, nil
 			}),
+			server.WithPresharedSecureKey(clusterPresharedKey),
 			server.WithHTTPGateway(util.HTTPServerConfig{HTTPEnabled: false}),
 			server.WithMetricsAPI(util.HTTPServerConfig{HTTPEnabled: false}),
 			server.WithDispatchServer(util.GRPCServerConfig{
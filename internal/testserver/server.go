@@ -11,6 +11,7 @@ import (
 
 	"github.com/authzed/spicedb/internal/datastore/memdb"
 	"github.com/authzed/spicedb/internal/dispatch/graph"
+	"github.com/authzed/spicedb/internal/middleware/audit"
 	"github.com/authzed/spicedb/internal/middleware/consistency"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	"github.com/authzed/spicedb/pkg/cmd/server"
@@ -25,6 +26,7 @@ type ServerConfig struct {
 	MaxPreconditionsCount      uint16
 	MaxRelationshipContextSize int
 	StreamingAPITimeout        time.Duration
+	Auditor                    *audit.Auditor
 }
 
 // NewTestServer creates a new test server, using defaults for the config.
@@ -92,6 +94,10 @@ func NewTestServerWithConfig(require *require.Assertions,
 						Name:       "consistency",
 						Middleware: consistency.UnaryServerInterceptor(),
 					},
+					{
+						Name:       "audit",
+						Middleware: audit.UnaryServerInterceptor(config.Auditor),
+					},
 					{
 						Name:       "servicespecific",
 						Middleware: servicespecific.UnaryServerInterceptor,
@@ -115,6 +121,10 @@ func NewTestServerWithConfig(require *require.Assertions,
 						Name:       "consistency",
 						Middleware: consistency.StreamServerInterceptor(),
 					},
+					{
+						Name:       "audit",
+						Middleware: audit.StreamServerInterceptor(config.Auditor),
+					},
 					{
 						Name:       "servicespecific",
 						Middleware: servicespecific.StreamServerInterceptor,
@@ -3,10 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/authzed/spicedb/internal/datastore/backup"
 	"github.com/authzed/spicedb/internal/datastore/common"
 	log "github.com/authzed/spicedb/internal/logging"
 	"github.com/authzed/spicedb/pkg/cmd/datastore"
@@ -43,6 +46,19 @@ func NewDatastoreCommand(programName string) (*cobra.Command, error) {
 	}
 	datastoreCmd.AddCommand(repairCmd)
 
+	exportCmd := NewExportDatastoreCommand(programName, &cfg)
+	if err := datastore.RegisterDatastoreFlagsWithPrefix(exportCmd.Flags(), "", &cfg); err != nil {
+		return nil, err
+	}
+	datastoreCmd.AddCommand(exportCmd)
+
+	restoreCmd := NewRestoreDatastoreCommand(programName, &cfg)
+	if err := datastore.RegisterDatastoreFlagsWithPrefix(restoreCmd.Flags(), "", &cfg); err != nil {
+		return nil, err
+	}
+	restoreCmd.Flags().Int("batch-size", 1_000, "number of relationships to load per transaction")
+	datastoreCmd.AddCommand(restoreCmd)
+
 	headCmd := NewHeadCommand(programName)
 	RegisterHeadFlags(headCmd)
 	datastoreCmd.AddCommand(headCmd)
@@ -132,3 +148,137 @@ func NewRepairDatastoreCommand(programName string, cfg *datastore.Config) *cobra
 		}),
 	}
 }
+
+func NewExportDatastoreCommand(programName string, cfg *datastore.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:     "export <file>",
+		Short:   "exports the datastore to a backup file",
+		Long:    "Exports the schema, caveats and all relationships in the datastore at its current revision to a compressed and checksummed backup file, which can be restored into any datastore engine",
+		PreRunE: server.DefaultPreRunE(programName),
+		Args:    cobra.ExactArgs(1),
+		RunE: termination.PublishError(func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			// Disable background GC and hedging.
+			cfg.GCInterval = -1 * time.Hour
+			cfg.RequestHedgingEnabled = false
+
+			ds, err := datastore.NewDatastore(ctx, cfg.ToOption())
+			if err != nil {
+				return fmt.Errorf("failed to create datastore: %w", err)
+			}
+			defer ds.Close()
+
+			revision, err := ds.HeadRevision(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to determine head revision: %w", err)
+			}
+
+			// Write to a temporary file first, to ensure an incomplete backup never exists at the
+			// given path.
+			filePath := args[0]
+			file, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
+			if err != nil {
+				return fmt.Errorf("failed to create backup file: %w", err)
+			}
+			defer os.Remove(file.Name())
+
+			log.Ctx(ctx).Info().Stringer("revision", revision).Msg("Exporting datastore...")
+			stats, err := backup.Export(ctx, ds, revision, cfg.Engine, file)
+			if err != nil {
+				_ = file.Close()
+				return err
+			}
+
+			if err := file.Close(); err != nil {
+				return fmt.Errorf("failed to write backup file: %w", err)
+			}
+
+			if err := os.Rename(file.Name(), filePath); err != nil {
+				return fmt.Errorf("failed to write backup file: %w", err)
+			}
+
+			log.Ctx(ctx).Info().
+				Stringer("revision", revision).
+				Uint64("namespaces", stats.Namespaces).
+				Uint64("caveats", stats.Caveats).
+				Uint64("relationships", stats.Relationships).
+				Msg("Datastore export completed")
+			return nil
+		}),
+	}
+}
+
+func NewRestoreDatastoreCommand(programName string, cfg *datastore.Config) *cobra.Command {
+	return &cobra.Command{
+		Use:     "restore <file>",
+		Short:   "restores the datastore from a backup file",
+		Long:    "Restores the schema, caveats and relationships from a backup file created by the export command into an empty datastore of any engine",
+		PreRunE: server.DefaultPreRunE(programName),
+		Args:    cobra.ExactArgs(1),
+		RunE: termination.PublishError(func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			batchSize, err := cmd.Flags().GetInt("batch-size")
+			if err != nil {
+				return err
+			}
+
+			// Verify the entire file before writing anything to the datastore.
+			header, expected, err := verifyBackupFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			log.Ctx(ctx).Info().
+				Str("engine", header.Engine).
+				Str("revision", header.Revision).
+				Time("created_at", header.CreatedAt).
+				Msg("Verified backup file")
+
+			// Disable background GC and hedging.
+			cfg.GCInterval = -1 * time.Hour
+			cfg.RequestHedgingEnabled = false
+
+			ds, err := datastore.NewDatastore(ctx, cfg.ToOption())
+			if err != nil {
+				return fmt.Errorf("failed to create datastore: %w", err)
+			}
+			defer ds.Close()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open backup file: %w", err)
+			}
+			defer file.Close()
+
+			log.Ctx(ctx).Info().
+				Uint64("namespaces", expected.Namespaces).
+				Uint64("caveats", expected.Caveats).
+				Uint64("relationships", expected.Relationships).
+				Msg("Restoring datastore...")
+
+			stats, err := backup.Restore(ctx, file, ds, batchSize)
+			if err != nil {
+				return err
+			}
+
+			log.Ctx(ctx).Info().
+				Uint64("namespaces", stats.Namespaces).
+				Uint64("caveats", stats.Caveats).
+				Uint64("relationships", stats.Relationships).
+				Msg("Datastore restore completed")
+			return nil
+		}),
+	}
+}
+
+func verifyBackupFile(filePath string) (*backup.Header, backup.Stats, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, backup.Stats{}, fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer file.Close()
+
+	return backup.Verify(file)
+}
@@ -187,8 +187,8 @@ func RegisterDatastoreFlagsWithPrefix(flagSet *pflag.FlagSet, prefix string, opt
 	var unusedSplitQueryCount uint16
 
 	flagSet.DurationVar(&opts.GCWindow, flagName("datastore-gc-window"), defaults.GCWindow, "amount of time before revisions are garbage collected")
-	flagSet.DurationVar(&opts.GCInterval, flagName("datastore-gc-interval"), defaults.GCInterval, "amount of time between passes of garbage collection (postgres driver only)")
-	flagSet.DurationVar(&opts.GCMaxOperationTime, flagName("datastore-gc-max-operation-time"), defaults.GCMaxOperationTime, "maximum amount of time a garbage collection pass can operate before timing out (postgres driver only)")
+	flagSet.DurationVar(&opts.GCInterval, flagName("datastore-gc-interval"), defaults.GCInterval, "amount of time between passes of garbage collection (postgres and mysql drivers; cockroachdb and spanner only collect expired relationships)")
+	flagSet.DurationVar(&opts.GCMaxOperationTime, flagName("datastore-gc-max-operation-time"), defaults.GCMaxOperationTime, "maximum amount of time a garbage collection pass can operate before timing out (postgres and mysql drivers; cockroachdb and spanner only collect expired relationships)")
 	flagSet.DurationVar(&opts.RevisionQuantization, flagName("datastore-revision-quantization-interval"), defaults.RevisionQuantization, "boundary interval to which to round the quantized revision")
 	flagSet.Float64Var(&opts.MaxRevisionStalenessPercent, flagName("datastore-revision-quantization-max-staleness-percent"), defaults.MaxRevisionStalenessPercent, "float percentage (where 1 = 100%) of the revision quantization interval where we may opt to select a stale revision for performance reasons. Defaults to 0.1 (representing 10%)")
 	flagSet.BoolVar(&opts.ReadOnly, flagName("datastore-readonly"), defaults.ReadOnly, "set the service to read-only mode")
@@ -362,6 +362,9 @@ func newCRDBDatastore(ctx context.Context, opts Config) (datastore.Datastore, er
 		ctx,
 		opts.URI,
 		crdb.GCWindow(opts.GCWindow),
+		crdb.GCEnabled(!opts.ReadOnly),
+		crdb.GCInterval(opts.GCInterval),
+		crdb.GCMaxOperationTime(opts.GCMaxOperationTime),
 		crdb.RevisionQuantization(opts.RevisionQuantization),
 		crdb.MaxRevisionStalenessPercent(opts.MaxRevisionStalenessPercent),
 		crdb.ReadConnsMaxOpen(opts.ReadConnPool.MaxOpenConns),
@@ -435,6 +438,9 @@ func newSpannerDatastore(ctx context.Context, opts Config) (datastore.Datastore,
 		spanner.MinSessionCount(opts.SpannerMinSessions),
 		spanner.MaxSessionCount(opts.SpannerMaxSessions),
 		spanner.MigrationPhase(opts.MigrationPhase),
+		spanner.GCEnabled(!opts.ReadOnly),
+		spanner.GCInterval(opts.GCInterval),
+		spanner.GCMaxOperationTime(opts.GCMaxOperationTime),
 	)
 }
 
@@ -35,8 +35,10 @@ func RegisterDevtoolsFlags(cmd *cobra.Command) {
 	httpMetricsServiceBuilder().RegisterFlags(cmd.Flags())
 	httpDownloadServiceBuilder().RegisterFlags(cmd.Flags())
 
-	cmd.Flags().String("share-store", "inmemory", "kind of share store to use")
+	cmd.Flags().String("share-store", "inmemory", `kind of share store to use ("inmemory", "s3", "directory")`)
 	cmd.Flags().String("share-store-salt", "", "salt for share store hashing")
+	cmd.Flags().String("share-store-directory", "", "local directory in which to persist shares for directory share store")
+	cmd.Flags().Duration("share-store-ttl", 0, "duration after which shares expire in the directory share store (0 to never expire)")
 	cmd.Flags().String("s3-access-key", "", "s3 access key for s3 share store")
 	cmd.Flags().String("s3-secret-key", "", "s3 secret key for s3 share store")
 	cmd.Flags().String("s3-bucket", "", "s3 bucket name for s3 share store")
@@ -179,6 +181,25 @@ func shareStoreFromCmd(cmd *cobra.Command) (v0svc.ShareStore, error) {
 
 		event = event.Str("endpoint", endpoint).Str("region", region).Str("bucketName", bucketName).Str("accessKey", accessKey)
 
+	case "directory":
+		directory := cobrautil.MustGetStringExpanded(cmd, "share-store-directory")
+		if directory == "" {
+			return nil, fmt.Errorf("missing required field: share-store-directory")
+		}
+
+		ttl := cobrautil.MustGetDuration(cmd, "share-store-ttl")
+		if ttl < 0 {
+			return nil, fmt.Errorf("share-store-ttl must be non-negative")
+		}
+
+		var err error
+		shareStore, err = v0svc.NewDirectoryShareStore(directory, shareStoreSalt, ttl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create directory share store: %w", err)
+		}
+
+		event = event.Str("directory", directory).Dur("ttl", ttl)
+
 	default:
 		return nil, errors.New("unknown share store")
 	}
@@ -0,0 +1,575 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/authzed/spicedb/internal/datastore/common"
+	log "github.com/authzed/spicedb/internal/logging"
+	"github.com/authzed/spicedb/internal/relationships"
+	"github.com/authzed/spicedb/pkg/cmd/datastore"
+	"github.com/authzed/spicedb/pkg/cmd/server"
+	"github.com/authzed/spicedb/pkg/cmd/termination"
+	dspkg "github.com/authzed/spicedb/pkg/datastore"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+const (
+	importFormatCSV   = "csv"
+	importFormatJSONL = "jsonl"
+	importFormatTuple = "tuple"
+)
+
+// importColumns are the columns of a relationship in the CSV and JSONL import formats. Only the
+// first five are required.
+var importColumns = []string{
+	"resource_type",
+	"resource_id",
+	"relation",
+	"subject_type",
+	"subject_id",
+	"subject_relation",
+	"caveat_name",
+	"caveat_context",
+	"expiration",
+}
+
+// ImportConfig is the configuration for the import command.
+type ImportConfig struct {
+	Datastore datastore.Config
+	Format    string
+	BatchSize uint
+}
+
+func RegisterImportFlags(cmd *cobra.Command, cfg *ImportConfig) error {
+	if err := datastore.RegisterDatastoreFlagsWithPrefix(cmd.Flags(), "", &cfg.Datastore); err != nil {
+		return err
+	}
+
+	cmd.Flags().StringVar(&cfg.Format, "format", "", `format of the file to import ("csv", "jsonl", "tuple"); detected from the file extension if unspecified`)
+	cmd.Flags().UintVar(&cfg.BatchSize, "batch-size", 1_000, "number of relationships to validate and load per batch")
+	return nil
+}
+
+func NewImportCommand(programName string, cfg *ImportConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file>",
+		Short: "imports relationships from a file",
+		Long: `Imports relationships from a CSV, JSON Lines or relationship string file (use "-" for stdin) into the configured datastore.
+
+Each relationship is validated against the schema stored in the datastore before being loaded. Rows which fail to parse,
+validate or load are reported with their line numbers and skipped, rather than aborting the import. If the import cannot
+continue, such as when the datastore becomes unavailable, the line from which it can be resumed is reported.
+
+CSV files and JSON Lines objects use the columns: ` + strings.Join(importColumns, ", ") + `.
+The caveat context is a JSON object and the expiration an RFC 3339 timestamp. CSV files may begin with a header row.`,
+		PreRunE: server.DefaultPreRunE(programName),
+		Args:    cobra.ExactArgs(1),
+		RunE: termination.PublishError(func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			format, err := importFormatFor(args[0], cfg.Format)
+			if err != nil {
+				return err
+			}
+
+			if cfg.BatchSize == 0 {
+				return fmt.Errorf("batch size must be greater than zero")
+			}
+
+			input := io.Reader(os.Stdin)
+			if args[0] != "-" {
+				file, err := os.Open(args[0])
+				if err != nil {
+					return fmt.Errorf("failed to open import file: %w", err)
+				}
+				defer file.Close()
+				input = file
+			}
+
+			// Disable background GC and hedging.
+			cfg.Datastore.GCInterval = -1 * time.Hour
+			cfg.Datastore.RequestHedgingEnabled = false
+
+			ds, err := datastore.NewDatastore(ctx, cfg.Datastore.ToOption())
+			if err != nil {
+				return fmt.Errorf("failed to create datastore: %w", err)
+			}
+			defer ds.Close()
+
+			importer := &relationshipImporter{
+				ds:        ds,
+				batchSize: int(cfg.BatchSize),
+				rejected:  cmd.ErrOrStderr(),
+			}
+
+			log.Ctx(ctx).Info().Str("format", format).Msg("Importing relationships...")
+			if err := importer.importFrom(ctx, input, format); err != nil {
+				return err
+			}
+
+			log.Ctx(ctx).Info().
+				Uint64("loaded", importer.numLoaded).
+				Uint64("rejected", importer.numRejected).
+				Msg("Import completed")
+
+			if importer.numRejected > 0 {
+				return fmt.Errorf("%d rows were rejected", importer.numRejected)
+			}
+			return nil
+		}),
+	}
+}
+
+// importFormatFor returns the import format for the given file, either as specified or as
+// detected from the file's extension.
+func importFormatFor(filePath string, specified string) (string, error) {
+	if specified != "" {
+		switch specified {
+		case importFormatCSV, importFormatJSONL, importFormatTuple:
+			return specified, nil
+		default:
+			return "", fmt.Errorf("unknown import format `%s`", specified)
+		}
+	}
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv":
+		return importFormatCSV, nil
+	case ".jsonl", ".ndjson":
+		return importFormatJSONL, nil
+	case ".txt", ".tuples", ".relationships":
+		return importFormatTuple, nil
+	default:
+		return "", fmt.Errorf("could not determine the format of `%s`; specify it with --format", filePath)
+	}
+}
+
+// importRow is a single parsed row of an import file.
+type importRow struct {
+	line  int
+	tuple *core.RelationTuple
+	err   error
+}
+
+// relationshipImporter validates relationships read from an import file and loads them into a
+// datastore in batches.
+type relationshipImporter struct {
+	ds        dspkg.Datastore
+	batchSize int
+
+	// rejected is where rejected rows are reported.
+	rejected io.Writer
+
+	batch       []importRow
+	numLoaded   uint64
+	numRejected uint64
+}
+
+func (ri *relationshipImporter) importFrom(ctx context.Context, input io.Reader, format string) error {
+	var err error
+	switch format {
+	case importFormatCSV:
+		err = readCSVRows(input, func(row importRow) error { return ri.add(ctx, row) })
+	case importFormatJSONL:
+		err = readLineRows(input, parseJSONLRow, func(row importRow) error { return ri.add(ctx, row) })
+	case importFormatTuple:
+		err = readLineRows(input, parseTupleRow, func(row importRow) error { return ri.add(ctx, row) })
+	default:
+		err = fmt.Errorf("unknown import format `%s`", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ri.flush(ctx)
+}
+
+func (ri *relationshipImporter) add(ctx context.Context, row importRow) error {
+	if row.err == nil {
+		row.err = row.tuple.Validate()
+	}
+
+	if row.err != nil {
+		ri.reject(row, row.err)
+		return nil
+	}
+
+	ri.batch = append(ri.batch, row)
+	if len(ri.batch) >= ri.batchSize {
+		return ri.flush(ctx)
+	}
+	return nil
+}
+
+func (ri *relationshipImporter) reject(row importRow, err error) {
+	ri.numRejected++
+	fmt.Fprintf(ri.rejected, "line %d: %s\n", row.line, err)
+}
+
+// flush validates the current batch against the schema at the head revision and bulk loads the
+// valid relationships. If the import cannot continue, the returned error reports the first line
+// which was not processed, from which the import can be resumed.
+func (ri *relationshipImporter) flush(ctx context.Context) error {
+	if len(ri.batch) == 0 {
+		return nil
+	}
+
+	batch := ri.batch
+	ri.batch = nil
+
+	if err := ri.validateAndLoad(ctx, batch); err != nil {
+		line := batch[0].line
+		var stopped loadStoppedError
+		if errors.As(err, &stopped) {
+			line, err = stopped.line, stopped.err
+		}
+		return fmt.Errorf("import stopped; all lines before line %d were processed: %w", line, err)
+	}
+	return nil
+}
+
+// loadStoppedError is returned when rows cannot be loaded for a reason other than the rows
+// themselves, such as the datastore being unavailable. It holds the line of the first row which
+// was not loaded or rejected.
+type loadStoppedError struct {
+	line int
+	err  error
+}
+
+func (err loadStoppedError) Error() string {
+	return err.err.Error()
+}
+
+func (err loadStoppedError) Unwrap() error {
+	return err.err
+}
+
+func (ri *relationshipImporter) validateAndLoad(ctx context.Context, batch []importRow) error {
+	headRevision, err := ri.ds.HeadRevision(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to determine head revision: %w", err)
+	}
+
+	valid, err := ri.validate(ctx, ri.ds.SnapshotReader(headRevision), batch)
+	if err != nil {
+		return err
+	}
+
+	return ri.load(ctx, valid)
+}
+
+// load bulk loads the relationships of the given rows. As the datastore does not report which
+// relationship conflicts with an existing one, a batch which fails to load because of a conflict
+// is split in halves which are loaded separately, so that only the conflicting rows are rejected.
+// Any other failure stops the import with a loadStoppedError.
+func (ri *relationshipImporter) load(ctx context.Context, rows []importRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var loaded uint64
+	_, err := ri.ds.ReadWriteTx(ctx, func(ctx context.Context, rwt dspkg.ReadWriteTransaction) error {
+		tuples := make([]*core.RelationTuple, 0, len(rows))
+		for _, row := range rows {
+			tuples = append(tuples, row.tuple)
+		}
+
+		var err error
+		loaded, err = rwt.BulkLoad(ctx, common.NewSliceRelationshipSource(tuples))
+		return err
+	})
+	if err == nil {
+		ri.numLoaded += loaded
+		return nil
+	}
+
+	if !errors.As(err, &common.CreateRelationshipExistsError{}) {
+		return loadStoppedError{line: rows[0].line, err: err}
+	}
+
+	if len(rows) == 1 {
+		ri.reject(rows[0], fmt.Errorf("failed to load relationship `%s`: %w", tuple.MustString(rows[0].tuple), err))
+		return nil
+	}
+
+	middle := len(rows) / 2
+	if err := ri.load(ctx, rows[:middle]); err != nil {
+		return err
+	}
+	return ri.load(ctx, rows[middle:])
+}
+
+// validate returns the rows of the batch which are valid against the schema, reporting the others
+// as rejected.
+func (ri *relationshipImporter) validate(ctx context.Context, reader dspkg.Reader, batch []importRow) ([]importRow, error) {
+	// Relationships can only be loaded once per batch.
+	valid := make([]importRow, 0, len(batch))
+	seen := make(map[string]int, len(batch))
+	for _, row := range batch {
+		key := tuple.StringWithoutCaveat(row.tuple)
+		if line, ok := seen[key]; ok {
+			ri.reject(row, fmt.Errorf("duplicate of relationship on line %d", line))
+			continue
+		}
+
+		seen[key] = row.line
+		valid = append(valid, row)
+	}
+
+	tuples := make([]*core.RelationTuple, 0, len(valid))
+	for _, row := range valid {
+		tuples = append(tuples, row.tuple)
+	}
+
+	errs, err := relationships.ValidateEachRelationshipForCreateOrTouch(ctx, reader, tuples)
+	if err != nil {
+		return nil, err
+	}
+
+	validated := make([]importRow, 0, len(valid))
+	for index, row := range valid {
+		if errs[index] != nil {
+			ri.reject(row, errs[index])
+			continue
+		}
+
+		validated = append(validated, row)
+	}
+	return validated, nil
+}
+
+// readCSVRows reads relationships from CSV, calling handle for each row. If the first row is a
+// header row, the columns may be in any order.
+func readCSVRows(input io.Reader, handle func(importRow) error) error {
+	reader := csv.NewReader(input)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	columnIndexes := make(map[string]int, len(importColumns))
+	for index, column := range importColumns {
+		columnIndexes[column] = index
+	}
+
+	first := true
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			if err := handle(importRow{line: parseErr.Line, err: parseErr.Err}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if first {
+			first = false
+			if isCSVHeader(record) {
+				header, err := csvHeader(record)
+				if err != nil {
+					return fmt.Errorf("invalid CSV header on line %d: %w", line, err)
+				}
+				columnIndexes = header
+				continue
+			}
+		}
+
+		values := make(map[string]string, len(columnIndexes))
+		for column, index := range columnIndexes {
+			if index < len(record) {
+				values[column] = record[index]
+			}
+		}
+
+		tpl, err := tupleFromColumns(values)
+		if err := handle(importRow{line: line, tuple: tpl, err: err}); err != nil {
+			return err
+		}
+	}
+}
+
+// isCSVHeader returns whether the record is a header row, made up only of column names.
+func isCSVHeader(record []string) bool {
+	for _, column := range record {
+		if !isImportColumn(column) {
+			return false
+		}
+	}
+	return len(record) > 0
+}
+
+func csvHeader(record []string) (map[string]int, error) {
+	header := make(map[string]int, len(record))
+	for index, column := range record {
+		if _, ok := header[column]; ok {
+			return nil, fmt.Errorf("duplicate column `%s`", column)
+		}
+		header[column] = index
+	}
+
+	for _, required := range importColumns[:5] {
+		if _, ok := header[required]; !ok {
+			return nil, fmt.Errorf("missing required column `%s`", required)
+		}
+	}
+	return header, nil
+}
+
+// readLineRows reads relationships from input with one relationship per line, calling handle for
+// each. Empty lines and lines starting with `//` are skipped.
+func readLineRows(input io.Reader, parse func(string) (*core.RelationTuple, error), handle func(importRow) error) error {
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "//") {
+			continue
+		}
+
+		tpl, err := parse(text)
+		if err := handle(importRow{line: line, tuple: tpl, err: err}); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read line %d: %w", line+1, err)
+	}
+	return nil
+}
+
+func parseTupleRow(text string) (*core.RelationTuple, error) {
+	tpl := tuple.Parse(text)
+	if tpl == nil {
+		return nil, fmt.Errorf("invalid relationship `%s`", text)
+	}
+	return tpl, nil
+}
+
+func parseJSONLRow(text string) (*core.RelationTuple, error) {
+	var row map[string]any
+	if err := json.Unmarshal([]byte(text), &row); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	values := make(map[string]string, len(row))
+	for column, value := range row {
+		switch typed := value.(type) {
+		case nil:
+			continue
+
+		case string:
+			values[column] = typed
+
+		case map[string]any:
+			if column != "caveat_context" {
+				return nil, fmt.Errorf("expected a string for `%s`", column)
+			}
+
+			encoded, err := json.Marshal(typed)
+			if err != nil {
+				return nil, fmt.Errorf("invalid caveat context: %w", err)
+			}
+			values[column] = string(encoded)
+
+		default:
+			return nil, fmt.Errorf("expected a string for `%s`", column)
+		}
+	}
+
+	for column := range values {
+		if !isImportColumn(column) {
+			return nil, fmt.Errorf("unknown field `%s`", column)
+		}
+	}
+
+	return tupleFromColumns(values)
+}
+
+func isImportColumn(column string) bool {
+	for _, known := range importColumns {
+		if column == known {
+			return true
+		}
+	}
+	return false
+}
+
+// tupleFromColumns constructs a relation tuple from the values of the import columns.
+func tupleFromColumns(values map[string]string) (*core.RelationTuple, error) {
+	for _, required := range importColumns[:5] {
+		if values[required] == "" {
+			return nil, fmt.Errorf("missing value for `%s`", required)
+		}
+	}
+
+	subjectRelation := values["subject_relation"]
+	if subjectRelation == "" {
+		subjectRelation = tuple.Ellipsis
+	}
+
+	tpl := &core.RelationTuple{
+		ResourceAndRelation: &core.ObjectAndRelation{
+			Namespace: values["resource_type"],
+			ObjectId:  values["resource_id"],
+			Relation:  values["relation"],
+		},
+		Subject: &core.ObjectAndRelation{
+			Namespace: values["subject_type"],
+			ObjectId:  values["subject_id"],
+			Relation:  subjectRelation,
+		},
+	}
+
+	if caveatName := values["caveat_name"]; caveatName != "" {
+		caveatContext := &structpb.Struct{}
+		if encoded := values["caveat_context"]; encoded != "" {
+			if err := caveatContext.UnmarshalJSON([]byte(encoded)); err != nil {
+				return nil, fmt.Errorf("invalid caveat context: %w", err)
+			}
+		}
+
+		tpl.Caveat = &core.ContextualizedCaveat{
+			CaveatName: caveatName,
+			Context:    caveatContext,
+		}
+	} else if values["caveat_context"] != "" {
+		return nil, fmt.Errorf("caveat context given without a caveat name")
+	}
+
+	if encoded := values["expiration"]; encoded != "" {
+		expiration, err := time.Parse(time.RFC3339Nano, encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiration: %w", err)
+		}
+		tpl.OptionalExpirationTime = timestamppb.New(expiration)
+	}
+
+	return tpl, nil
+}
@@ -0,0 +1,361 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/internal/datastore/memdb"
+	"github.com/authzed/spicedb/internal/testfixtures"
+	dspkg "github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/datastore/options"
+	core "github.com/authzed/spicedb/pkg/proto/core/v1"
+	"github.com/authzed/spicedb/pkg/tuple"
+)
+
+const importTestSchema = `
+definition user {}
+
+caveat somecaveat(somecondition int) {
+	somecondition == 42
+}
+
+definition document {
+	relation viewer: user | user with somecaveat
+}`
+
+func TestImportFormatFor(t *testing.T) {
+	tcs := []struct {
+		filePath       string
+		specified      string
+		expectedFormat string
+		expectedError  string
+	}{
+		{"rels.csv", "", importFormatCSV, ""},
+		{"rels.CSV", "", importFormatCSV, ""},
+		{"rels.jsonl", "", importFormatJSONL, ""},
+		{"rels.ndjson", "", importFormatJSONL, ""},
+		{"rels.txt", "", importFormatTuple, ""},
+		{"rels.csv", importFormatTuple, importFormatTuple, ""},
+		{"-", importFormatJSONL, importFormatJSONL, ""},
+		{"-", "", "", "could not determine the format of `-`"},
+		{"rels.csv", "xml", "", "unknown import format `xml`"},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.filePath+"/"+tc.specified, func(t *testing.T) {
+			format, err := importFormatFor(tc.filePath, tc.specified)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFormat, format)
+		})
+	}
+}
+
+func TestImportRelationships(t *testing.T) {
+	tcs := []struct {
+		name             string
+		format           string
+		input            string
+		expectedLoaded   []string
+		expectedRejected []string
+	}{
+		{
+			"tuple format",
+			importFormatTuple,
+			`// a comment
+document:first#viewer@user:tom
+
+document:first#viewer@user:sarah[somecaveat:{"somecondition":42}]
+document:first#viewer@user:fred[expiration:2300-01-02T03:04:05Z]
+document:first#unknown@user:tom
+not a relationship
+document:first#viewer@user:tom`,
+			[]string{
+				"document:first#viewer@user:tom",
+				`document:first#viewer@user:sarah[somecaveat:{"somecondition":42}]`,
+				"document:first#viewer@user:fred[expiration:2300-01-02T03:04:05Z]",
+			},
+			[]string{
+				"line 6: relation/permission `unknown` not found under definition `document`",
+				"line 7: invalid relationship `not a relationship`",
+				"line 8: failed to load relationship `document:first#viewer@user:tom`: could not CREATE relationship `document:first#viewer@user:tom`, as it already existed",
+			},
+		},
+		{
+			"csv without header",
+			importFormatCSV,
+			`document,first,viewer,user,tom
+document,first,viewer,user,sarah,,somecaveat,"{""somecondition"": 42}"
+document,first,viewer,unknown,tom
+document,first,viewer,user
+document,first,viewer,user,fred,,,,2300-01-02T03:04:05Z`,
+			[]string{
+				"document:first#viewer@user:tom",
+				`document:first#viewer@user:sarah[somecaveat:{"somecondition":42}]`,
+				"document:first#viewer@user:fred[expiration:2300-01-02T03:04:05Z]",
+			},
+			[]string{
+				"line 3: object definition `unknown` not found",
+				"line 4: missing value for `subject_id`",
+			},
+		},
+		{
+			"csv with header",
+			importFormatCSV,
+			`subject_id,subject_type,relation,resource_id,resource_type
+tom,user,viewer,first,document
+sarah,user,viewer,"second
+doc",document`,
+			[]string{
+				"document:first#viewer@user:tom",
+			},
+			[]string{
+				"line 3: invalid RelationTuple.ResourceAndRelation",
+			},
+		},
+		{
+			"jsonl",
+			importFormatJSONL,
+			`{"resource_type": "document", "resource_id": "first", "relation": "viewer", "subject_type": "user", "subject_id": "tom"}
+{"resource_type": "document", "resource_id": "first", "relation": "viewer", "subject_type": "user", "subject_id": "sarah", "caveat_name": "somecaveat", "caveat_context": {"somecondition": 42}}
+{"resource_type": "document", "resource_id": "first", "relation": "viewer", "subject_type": "user", "subject_id": "fred", "expiration": "2300-01-02T03:04:05Z"}
+{"resource_type": "document", "resource_id": "first", "relation": "viewer", "subject_type": "user", "subject_id": "jill", "somefield": "somevalue"}
+{"resource_type": "document", "resource_id": "first", "relation": "viewer", "subject_type": "user", "subject_id": 42}
+{"resource_type": "document"`,
+			[]string{
+				"document:first#viewer@user:tom",
+				`document:first#viewer@user:sarah[somecaveat:{"somecondition":42}]`,
+				"document:first#viewer@user:fred[expiration:2300-01-02T03:04:05Z]",
+			},
+			[]string{
+				"line 4: unknown field `somefield`",
+				"line 5: expected a string for `subject_id`",
+				"line 6: invalid JSON",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+
+			rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+			require.NoError(err)
+
+			ds, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, importTestSchema, nil, require)
+
+			rejected := &bytes.Buffer{}
+			importer := &relationshipImporter{
+				ds:        ds,
+				batchSize: 2,
+				rejected:  rejected,
+			}
+
+			require.NoError(importer.importFrom(context.Background(), strings.NewReader(tc.input), tc.format))
+
+			rejectedLines := strings.Split(strings.TrimSpace(rejected.String()), "\n")
+			if len(tc.expectedRejected) == 0 {
+				require.Empty(strings.TrimSpace(rejected.String()))
+			} else {
+				// Rows are rejected as they are parsed or as their batch is validated, so the
+				// order of the report is not guaranteed.
+				require.Len(rejectedLines, len(tc.expectedRejected))
+				for _, expected := range tc.expectedRejected {
+					require.True(slices.ContainsFunc(rejectedLines, func(line string) bool {
+						return strings.HasPrefix(line, expected)
+					}), "missing rejection %q in %v", expected, rejectedLines)
+				}
+			}
+
+			require.Equal(uint64(len(tc.expectedLoaded)), importer.numLoaded)
+			require.Equal(uint64(len(tc.expectedRejected)), importer.numRejected)
+
+			headRevision, err := ds.HeadRevision(context.Background())
+			require.NoError(err)
+
+			iter, err := ds.SnapshotReader(headRevision).QueryRelationships(context.Background(), dspkg.RelationshipsFilter{
+				OptionalResourceType: "document",
+			})
+			require.NoError(err)
+			defer iter.Close()
+
+			var loaded []string
+			for tpl := iter.Next(); tpl != nil; tpl = iter.Next() {
+				loaded = append(loaded, tuple.MustString(tpl))
+			}
+			require.NoError(iter.Err())
+			require.ElementsMatch(tc.expectedLoaded, loaded)
+		})
+	}
+}
+
+func TestImportRejectsExistingRelationships(t *testing.T) {
+	require := require.New(t)
+
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	ds, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, importTestSchema, nil, require)
+
+	rejected := &bytes.Buffer{}
+	importer := &relationshipImporter{
+		ds:        ds,
+		batchSize: 10,
+		rejected:  rejected,
+	}
+
+	input := "document:first#viewer@user:tom\n"
+	require.NoError(importer.importFrom(context.Background(), strings.NewReader(input), importFormatTuple))
+	require.Equal(uint64(1), importer.numLoaded)
+
+	// Importing the same relationship again, along with new ones, rejects only the existing
+	// relationship, rather than the batch or the import.
+	input = "document:second#viewer@user:tom\ndocument:first#viewer@user:tom\ndocument:third#viewer@user:tom\ndocument:fourth#viewer@user:tom\n"
+	require.NoError(importer.importFrom(context.Background(), strings.NewReader(input), importFormatTuple))
+	require.Equal(uint64(4), importer.numLoaded)
+	require.Equal(uint64(1), importer.numRejected)
+	require.True(strings.HasPrefix(rejected.String(), "line 2: failed to load relationship `document:first#viewer@user:tom`"), rejected.String())
+	require.Equal(1, strings.Count(rejected.String(), "\n"))
+}
+
+func TestImportReportsResumableLine(t *testing.T) {
+	require := require.New(t)
+
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	ds, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, importTestSchema, nil, require)
+
+	// Fail the import once the first batch has been loaded.
+	importer := &relationshipImporter{
+		ds:        &unavailableAfterDatastore{Datastore: ds, remainingCalls: 1},
+		batchSize: 2,
+		rejected:  &bytes.Buffer{},
+	}
+
+	input := "document:first#viewer@user:tom\ndocument:second#viewer@user:tom\ndocument:third#viewer@user:tom\n"
+	err = importer.importFrom(context.Background(), strings.NewReader(input), importFormatTuple)
+	require.ErrorContains(err, "all lines before line 3 were processed")
+	require.ErrorIs(err, errDatastoreUnavailable)
+	require.Equal(uint64(2), importer.numLoaded)
+	require.Equal(uint64(0), importer.numRejected)
+}
+
+var errDatastoreUnavailable = errors.New("datastore unavailable")
+
+// unavailableAfterDatastore is a datastore which fails to return its head revision after the
+// given number of calls.
+type unavailableAfterDatastore struct {
+	dspkg.Datastore
+	remainingCalls int
+}
+
+func (ds *unavailableAfterDatastore) HeadRevision(ctx context.Context) (dspkg.Revision, error) {
+	if ds.remainingCalls == 0 {
+		return nil, errDatastoreUnavailable
+	}
+
+	ds.remainingCalls--
+	return ds.Datastore.HeadRevision(ctx)
+}
+
+func TestImportStopsOnDatastoreErrorDuringSplit(t *testing.T) {
+	require := require.New(t)
+
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	ds, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, importTestSchema, []*core.RelationTuple{
+		tuple.MustParse("document:first#viewer@user:tom"),
+	}, require)
+
+	// The batch conflicts with the existing relationship, so it is split: the first half is split
+	// again to reject the conflicting row, after which the datastore fails to load the second half.
+	rejected := &bytes.Buffer{}
+	importer := &relationshipImporter{
+		ds:        &failingWritesAfterDatastore{Datastore: ds, remainingWrites: 4},
+		batchSize: 10,
+		rejected:  rejected,
+	}
+
+	input := "document:second#viewer@user:tom\ndocument:first#viewer@user:tom\ndocument:third#viewer@user:tom\ndocument:fourth#viewer@user:tom\n"
+	err = importer.importFrom(context.Background(), strings.NewReader(input), importFormatTuple)
+	require.ErrorContains(err, "all lines before line 3 were processed")
+	require.ErrorIs(err, errDatastoreUnavailable)
+	require.Equal(uint64(1), importer.numLoaded)
+	require.Equal(uint64(1), importer.numRejected)
+	require.True(strings.HasPrefix(rejected.String(), "line 2: failed to load relationship `document:first#viewer@user:tom`"), rejected.String())
+}
+
+func TestImportStopsOnDatastoreErrorWithoutRejecting(t *testing.T) {
+	require := require.New(t)
+
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	ds, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, importTestSchema, nil, require)
+
+	// A datastore error which is not caused by the rows does not reject them.
+	importer := &relationshipImporter{
+		ds:        &failingWritesAfterDatastore{Datastore: ds, remainingWrites: 0},
+		batchSize: 10,
+		rejected:  &bytes.Buffer{},
+	}
+
+	input := "document:first#viewer@user:tom\ndocument:second#viewer@user:tom\n"
+	err = importer.importFrom(context.Background(), strings.NewReader(input), importFormatTuple)
+	require.ErrorContains(err, "all lines before line 1 were processed")
+	require.ErrorIs(err, errDatastoreUnavailable)
+	require.Equal(uint64(0), importer.numLoaded)
+	require.Equal(uint64(0), importer.numRejected)
+}
+
+// failingWritesAfterDatastore is a datastore which fails its read-write transactions after the
+// given number of them.
+type failingWritesAfterDatastore struct {
+	dspkg.Datastore
+	remainingWrites int
+}
+
+func (ds *failingWritesAfterDatastore) ReadWriteTx(ctx context.Context, f dspkg.TxUserFunc, opts ...options.RWTOptionsOption) (dspkg.Revision, error) {
+	if ds.remainingWrites == 0 {
+		return nil, errDatastoreUnavailable
+	}
+
+	ds.remainingWrites--
+	return ds.Datastore.ReadWriteTx(ctx, f, opts...)
+}
+
+func TestImportRejectsDuplicatesInBatch(t *testing.T) {
+	require := require.New(t)
+
+	rawDS, err := memdb.NewMemdbDatastore(0, 0, memdb.DisableGC)
+	require.NoError(err)
+
+	ds, _ := testfixtures.DatastoreFromSchemaAndTestRelationships(rawDS, importTestSchema, nil, require)
+
+	rejected := &bytes.Buffer{}
+	importer := &relationshipImporter{
+		ds:        ds,
+		batchSize: 10,
+		rejected:  rejected,
+	}
+
+	input := "document:first#viewer@user:tom\ndocument:first#viewer@user:sarah\ndocument:first#viewer@user:tom[expiration:2300-01-02T03:04:05Z]\n"
+	require.NoError(importer.importFrom(context.Background(), strings.NewReader(input), importFormatTuple))
+	require.Equal(uint64(2), importer.numLoaded)
+	require.Equal(uint64(1), importer.numRejected)
+	require.Equal("line 3: duplicate of relationship on line 1\n", rejected.String())
+}
@@ -53,11 +53,27 @@ func RegisterServeFlags(cmd *cobra.Command, config *server.Config) error {
 
 	// Flags for the gRPC API server
 	util.RegisterGRPCServerFlags(cmd.Flags(), &config.GRPCServer, "grpc", "gRPC", ":50051", true)
-	cmd.Flags().StringSliceVar(&config.PresharedSecureKey, PresharedKeyFlag, []string{}, "preshared key(s) to require for authenticated requests")
+	cmd.Flags().StringVar(&config.GRPCServer.ClientCertificateCAPath, "grpc-client-cert-ca-path", "", "local path to the CA bundle verifying the client certificates presented to gRPC, which then authenticate API requests (requires --grpc-tls-cert-path)")
+	cmd.Flags().StringSliceVar(&config.PresharedSecureKey, PresharedKeyFlag, []string{}, "preshared key(s) to require for authenticated requests (required unless requests are authenticated with client certificates or JWTs)")
+	cmd.Flags().StringVar(&config.GRPCAuthJWT.JWKSPath, "grpc-jwt-jwks-path", "", "local path to the JWKS file verifying the signed JWTs accepted as bearer tokens (enables JWT authentication)")
+	cmd.Flags().StringVar(&config.GRPCAuthJWT.Issuer, "grpc-jwt-issuer", "", "issuer required in the `iss` claim of JWTs")
+	cmd.Flags().StringVar(&config.GRPCAuthJWT.Audience, "grpc-jwt-audience", "", "audience required in the `aud` claim of JWTs")
+	cmd.Flags().StringVar(&config.GRPCAuthJWT.IdentityClaim, "grpc-jwt-identity-claim", "sub", "claim of JWTs identifying the principal making the request")
 	cmd.Flags().DurationVar(&config.ShutdownGracePeriod, "grpc-shutdown-grace-period", 0*time.Second, "amount of time after receiving sigint to continue serving")
-	if err := cmd.MarkFlagRequired(PresharedKeyFlag); err != nil {
-		return fmt.Errorf("failed to mark flag as required: %w", err)
-	}
+	cmd.Flags().BoolVar(&config.EnableFairQueuing, "grpc-fair-queuing-enabled", false, "enables the queuing of API requests beyond the maximum of concurrent requests, served fairly between tenants identified by their authenticated principal or tenant header")
+	cmd.Flags().Uint32Var(&config.FairQueuing.MaxConcurrentRequests, "grpc-fair-queuing-max-concurrent-requests", 100, "maximum number of API requests processed concurrently when fair queuing is enabled")
+	cmd.Flags().Uint32Var(&config.FairQueuing.MaxQueuedRequests, "grpc-fair-queuing-max-queued-requests", 1000, "maximum number of queued API requests, beyond which requests are rejected with RESOURCE_EXHAUSTED (unlimited if 0)")
+	cmd.Flags().StringVar(&config.FairQueuing.TenantHeader, "grpc-fair-queuing-tenant-header", "", "request header identifying the tenant of API requests, which defaults to their authenticated principal (only use behind a trusted proxy)")
+	cmd.Flags().StringToIntVar(&config.FairQueuing.TenantWeights, "grpc-fair-queuing-tenant-weights", nil, "weights of the tenants of API requests, which default to 1 (tenants identified by their principal are named `presharedkey:<index of the key>`, `certificate:<SAN>` or `jwt:<identity>`)")
+	cmd.Flags().StringToIntVar(&config.FairQueuing.TenantRateLimits, "grpc-fair-queuing-tenant-rate-limits", nil, "maximum number of API requests per second of the tenants, beyond which requests are rejected with RESOURCE_EXHAUSTED")
+	cmd.Flags().Float64Var(&config.FairQueuing.BulkWeight, "grpc-fair-queuing-bulk-weight", 0.1, "factor applied to the weight of the tenant for requests to bulk methods such as LookupResources and BulkCheckPermission")
+	cmd.Flags().BoolVar(&config.EnableMultiTenancy, "grpc-multi-tenancy-enabled", false, "enables multi-tenant mode, in which each API request is scoped to the schema and relationships of its tenant (definition names are stored prefixed by the tenant ID and a slash, which together must not exceed 128 bytes)")
+	cmd.Flags().StringVar(&config.MultiTenancy.TenantHeader, "grpc-multi-tenancy-tenant-header", "", "request header naming the tenant of API requests made with credentials bound to any tenant")
+	cmd.Flags().StringToStringVar(&config.MultiTenancy.TokenTenants, "grpc-multi-tenancy-token-tenants", nil, "tenants of the API requests made with each preshared key or principal authenticated by client certificate or JWT (as certificate:<SAN> or jwt:<identity>), as `key=tenant` pairs where a tenant of * lets requests name any tenant in the tenant header; requests made with other credentials are rejected")
+	cmd.Flags().StringToStringVar(&config.PresharedKeyScopes, "grpc-preshared-key-scopes", nil, "capabilities granted to preshared keys or principals authenticated by client certificate or JWT (as `certificate:<SAN>` or `jwt:<identity>`), as `key=scope` pairs where a scope is a `+`-separated list of `read`, `write` (optionally restricted to resource types, as in `write:document:folder`), `schema` and `watch`; keys without a scope have full access")
+	cmd.Flags().BoolVar(&config.EnableAuditLog, "grpc-audit-log-enabled", false, "enables the audit log of the relationship and schema writes made through the API")
+	cmd.Flags().StringVar(&config.AuditLogSink, "grpc-audit-log-sink", server.AuditLogSinkFile, "destination of audit records: `file` (JSON lines written to the path of --grpc-audit-log-path) or `datastore` (the audit log table of the datastore)")
+	cmd.Flags().StringVar(&config.AuditLogPath, "grpc-audit-log-path", "", "path of the file to which audit records are appended when the audit log sink is `file`")
 
 	// Flags for the datastore
 	if err := datastore.RegisterDatastoreFlags(cmd, &config.DatastoreConfig); err != nil {
@@ -103,10 +119,13 @@ func RegisterServeFlags(cmd *cobra.Command, config *server.Config) error {
 	util.RegisterGRPCServerFlags(cmd.Flags(), &config.DispatchServer, "dispatch-cluster", "dispatch", ":50053", false)
 	server.RegisterCacheFlags(cmd.Flags(), "dispatch-cache", &config.DispatchCacheConfig, dispatchCacheDefaults)
 	server.RegisterCacheFlags(cmd.Flags(), "dispatch-cluster-cache", &config.ClusterDispatchCacheConfig, dispatchClusterCacheDefaults)
+	cmd.Flags().StringVar(&config.DispatchSecondTierCachePath, "dispatch-cache-second-tier-path", "", "directory in which to keep a second tier of the dispatch caches, shared by all processes using it and kept across restarts (disabled if empty)")
+	cmd.Flags().StringVar(&config.DispatchSecondTierCacheMaxSize, "dispatch-cache-second-tier-max-size", "1GiB", "upper bound of the size of the second tier of the dispatch caches on disk (unbounded if 0)")
+	cmd.Flags().BoolVar(&config.EnableExperimentalWatchableDispatchCache, "enable-experimental-watchable-dispatch-cache", false, "enables the experimental mode of the dispatch caches which makes use of the Watch API to keep cached results valid across revisions while the relationships they depend on are unchanged (implies --enable-experimental-watchable-schema-cache)")
 
 	// Flags for configuring dispatch requests
 	cmd.Flags().Uint32Var(&config.DispatchMaxDepth, "dispatch-max-depth", 50, "maximum recursion depth for nested calls")
-	cmd.Flags().StringVar(&config.DispatchUpstreamAddr, "dispatch-upstream-addr", "", "upstream grpc address to dispatch to")
+	cmd.Flags().StringVar(&config.DispatchUpstreamAddr, "dispatch-upstream-addr", "", "upstream grpc address to dispatch to, e.g. kubernetes:///spicedb.default:50053, file:///etc/spicedb/peers for a file listing one host:port per line, or dnssrv:///_dispatch._tcp.spicedb.example.com for DNS SRV records")
 	cmd.Flags().StringVar(&config.DispatchUpstreamCAPath, "dispatch-upstream-ca-path", "", "local path to the TLS CA used when connecting to the dispatch cluster")
 	cmd.Flags().DurationVar(&config.DispatchUpstreamTimeout, "dispatch-upstream-timeout", 60*time.Second, "maximum duration of a dispatch call an upstream cluster before it times out")
 
@@ -117,6 +136,11 @@ func RegisterServeFlags(cmd *cobra.Command, config *server.Config) error {
 	cmd.Flags().Uint16Var(&config.DispatchConcurrencyLimits.LookupSubjects, "dispatch-lookup-subjects-concurrency-limit", 0, "maximum number of parallel goroutines to create for each lookup subjects request or subrequest. defaults to --dispatch-concurrency-limit")
 	cmd.Flags().Uint16Var(&config.DispatchConcurrencyLimits.ReachableResources, "dispatch-reachable-resources-concurrency-limit", 0, "maximum number of parallel goroutines to create for each reachable resources request or subrequest. defaults to --dispatch-concurrency-limit")
 
+	cmd.Flags().BoolVar(&config.EnableExperimentalAdaptiveDispatchConcurrency, "enable-experimental-adaptive-dispatch-concurrency", false, "enables the experimental adjustment of the number of parallel goroutines created for each request or subrequest, up to the concurrency limits, based on the observed datastore latency and number of dispatches in progress")
+	cmd.Flags().Uint16Var(&config.DispatchAdaptiveConcurrency.MinimumLimit, "dispatch-adaptive-concurrency-minimum-limit", 1, "minimum number of parallel goroutines to create for each request or subrequest when adaptive dispatch concurrency is enabled")
+	cmd.Flags().Float64Var(&config.DispatchAdaptiveConcurrency.LatencyTolerance, "dispatch-adaptive-concurrency-latency-tolerance", 2, "ratio of the recent to the baseline datastore latency above which adaptive dispatch concurrency considers the node congested")
+	cmd.Flags().Uint32Var(&config.DispatchAdaptiveConcurrency.MaxQueueDepth, "dispatch-adaptive-concurrency-max-queue-depth", 1000, "maximum number of top-level dispatches of each operation in progress on the node when adaptive dispatch concurrency is enabled, beyond which further dispatches are rejected with RESOURCE_EXHAUSTED (unlimited if 0)")
+
 	cmd.Flags().Uint16Var(&config.DispatchHashringReplicationFactor, "dispatch-hashring-replication-factor", 100, "set the replication factor of the consistent hasher used for the dispatcher")
 	cmd.Flags().Uint8Var(&config.DispatchHashringSpread, "dispatch-hashring-spread", 1, "set the spread of the consistent hasher used for the dispatcher")
 
@@ -29,10 +29,14 @@ import (
 
 	"github.com/authzed/spicedb/internal/dispatch"
 	"github.com/authzed/spicedb/internal/logging"
+	"github.com/authzed/spicedb/internal/middleware/audit"
 	consistencymw "github.com/authzed/spicedb/internal/middleware/consistency"
 	datastoremw "github.com/authzed/spicedb/internal/middleware/datastore"
 	dispatchmw "github.com/authzed/spicedb/internal/middleware/dispatcher"
+	"github.com/authzed/spicedb/internal/middleware/fairqueue"
 	"github.com/authzed/spicedb/internal/middleware/servicespecific"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
+	"github.com/authzed/spicedb/internal/middleware/tokenscope"
 	"github.com/authzed/spicedb/pkg/datastore"
 	logmw "github.com/authzed/spicedb/pkg/middleware/logging"
 	"github.com/authzed/spicedb/pkg/middleware/requestid"
@@ -148,9 +152,13 @@ const (
 	DefaultMiddlewareGRPCAuth      = "grpcauth"
 	DefaultMiddlewareGRPCProm      = "grpcprom"
 	DefaultMiddlewareServerVersion = "serverversion"
+	DefaultMiddlewareFairQueue     = "fairqueue"
 
 	DefaultInternalMiddlewareDispatch       = "dispatch"
 	DefaultInternalMiddlewareDatastore      = "datastore"
+	DefaultInternalMiddlewareTenant         = "tenant"
+	DefaultInternalMiddlewareTokenScope     = "tokenscope"
+	DefaultInternalMiddlewareAudit          = "audit"
 	DefaultInternalMiddlewareConsistency    = "consistency"
 	DefaultInternalMiddlewareServerSpecific = "servicespecific"
 )
@@ -164,6 +172,10 @@ type MiddlewareOption struct {
 	enableRequestLog      bool
 	enableResponseLog     bool
 	disableGRPCHistogram  bool
+	fairQueue             *fairqueue.Scheduler
+	tenantResolver        *tenantmw.Resolver
+	tokenScopes           *tokenscope.Authorizer
+	auditor               *audit.Auditor
 }
 
 // gRPCMetricsUnaryInterceptor creates the default prometheus metrics interceptor for unary gRPCs
@@ -248,6 +260,12 @@ func DefaultUnaryMiddleware(opts MiddlewareOption) (*MiddlewareChain[grpc.UnaryS
 			WithInterceptor(serverversion.UnaryServerInterceptor(opts.enableVersionResponse)).
 			Done(),
 
+		NewUnaryMiddleware().
+			WithName(DefaultMiddlewareFairQueue).
+			WithInterceptor(fairqueue.UnaryServerInterceptor(opts.fairQueue)).
+			EnsureAlreadyExecuted(DefaultMiddlewareGRPCAuth). // so that only authenticated requests are queued
+			Done(),
+
 		NewUnaryMiddleware().
 			WithName(DefaultInternalMiddlewareDispatch).
 			WithInternal(true).
@@ -260,6 +278,24 @@ func DefaultUnaryMiddleware(opts MiddlewareOption) (*MiddlewareChain[grpc.UnaryS
 			WithInterceptor(datastoremw.UnaryServerInterceptor(opts.ds)).
 			Done(),
 
+		NewUnaryMiddleware().
+			WithName(DefaultInternalMiddlewareTenant).
+			WithInternal(true).
+			WithInterceptor(tenantmw.UnaryServerInterceptor(opts.tenantResolver)).
+			Done(),
+
+		NewUnaryMiddleware().
+			WithName(DefaultInternalMiddlewareTokenScope).
+			WithInternal(true).
+			WithInterceptor(tokenscope.UnaryServerInterceptor(opts.tokenScopes)).
+			Done(),
+
+		NewUnaryMiddleware().
+			WithName(DefaultInternalMiddlewareAudit).
+			WithInternal(true).
+			WithInterceptor(audit.UnaryServerInterceptor(opts.auditor)).
+			Done(),
+
 		NewUnaryMiddleware().
 			WithName(DefaultInternalMiddlewareConsistency).
 			WithInternal(true).
@@ -326,6 +362,12 @@ func DefaultStreamingMiddleware(opts MiddlewareOption) (*MiddlewareChain[grpc.St
 			WithInterceptor(serverversion.StreamServerInterceptor(opts.enableVersionResponse)).
 			Done(),
 
+		NewStreamMiddleware().
+			WithName(DefaultMiddlewareFairQueue).
+			WithInterceptor(fairqueue.StreamServerInterceptor(opts.fairQueue)).
+			EnsureInterceptorAlreadyExecuted(DefaultMiddlewareGRPCAuth). // so that only authenticated requests are queued
+			Done(),
+
 		NewStreamMiddleware().
 			WithName(DefaultInternalMiddlewareDispatch).
 			WithInternal(true).
@@ -338,6 +380,24 @@ func DefaultStreamingMiddleware(opts MiddlewareOption) (*MiddlewareChain[grpc.St
 			WithInterceptor(datastoremw.StreamServerInterceptor(opts.ds)).
 			Done(),
 
+		NewStreamMiddleware().
+			WithName(DefaultInternalMiddlewareTenant).
+			WithInternal(true).
+			WithInterceptor(tenantmw.StreamServerInterceptor(opts.tenantResolver)).
+			Done(),
+
+		NewStreamMiddleware().
+			WithName(DefaultInternalMiddlewareTokenScope).
+			WithInternal(true).
+			WithInterceptor(tokenscope.StreamServerInterceptor(opts.tokenScopes)).
+			Done(),
+
+		NewStreamMiddleware().
+			WithName(DefaultInternalMiddlewareAudit).
+			WithInternal(true).
+			WithInterceptor(audit.StreamServerInterceptor(opts.auditor)).
+			Done(),
+
 		NewStreamMiddleware().
 			WithName(DefaultInternalMiddlewareConsistency).
 			WithInternal(true).
@@ -379,6 +439,7 @@ func DefaultDispatchMiddleware(logger zerolog.Logger, authFunc grpcauth.AuthFunc
 			grpcMetricsUnaryInterceptor,
 			grpcauth.UnaryServerInterceptor(authFunc),
 			datastoremw.UnaryServerInterceptor(ds),
+			tenantmw.DispatchUnaryServerInterceptor,
 			servicespecific.UnaryServerInterceptor,
 		}, []grpc.StreamServerInterceptor{
 			requestid.StreamServerInterceptor(requestid.GenerateIfMissing(true)),
@@ -388,6 +449,7 @@ func DefaultDispatchMiddleware(logger zerolog.Logger, authFunc grpcauth.AuthFunc
 			grpcMetricsStreamingInterceptor,
 			grpcauth.StreamServerInterceptor(authFunc),
 			datastoremw.StreamServerInterceptor(ds),
+			tenantmw.DispatchStreamServerInterceptor,
 			servicespecific.StreamServerInterceptor,
 		}
 }
@@ -6,12 +6,14 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/authzed/consistent"
 	"github.com/authzed/grpcutil"
 	"github.com/cespare/xxhash/v2"
+	"github.com/dustin/go-humanize"
 	"github.com/ecordell/optgen/helpers"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/hashicorp/go-multierror"
@@ -28,11 +30,17 @@ import (
 	"github.com/authzed/spicedb/internal/datastore/proxy"
 	"github.com/authzed/spicedb/internal/datastore/proxy/schemacaching"
 	"github.com/authzed/spicedb/internal/dispatch"
+	"github.com/authzed/spicedb/internal/dispatch/caching"
 	clusterdispatch "github.com/authzed/spicedb/internal/dispatch/cluster"
 	combineddispatch "github.com/authzed/spicedb/internal/dispatch/combined"
 	"github.com/authzed/spicedb/internal/dispatch/graph"
 	"github.com/authzed/spicedb/internal/gateway"
 	log "github.com/authzed/spicedb/internal/logging"
+	"github.com/authzed/spicedb/internal/middleware/audit"
+	"github.com/authzed/spicedb/internal/middleware/fairqueue"
+	tenantmw "github.com/authzed/spicedb/internal/middleware/tenant"
+	"github.com/authzed/spicedb/internal/middleware/tokenscope"
+	"github.com/authzed/spicedb/internal/middleware/usagemetrics"
 	"github.com/authzed/spicedb/internal/services"
 	dispatchSvc "github.com/authzed/spicedb/internal/services/dispatch"
 	"github.com/authzed/spicedb/internal/services/health"
@@ -48,14 +56,31 @@ import (
 // underlying hash for the ConsistentHashringBalancers it creates.
 var ConsistentHashringBuilder = consistent.NewBuilder(xxhash.Sum64)
 
+const (
+	// AuditLogSinkFile writes audit records as JSON lines to the file at Config.AuditLogPath.
+	AuditLogSinkFile = "file"
+
+	// AuditLogSinkDatastore writes audit records to the audit log table of the datastore.
+	AuditLogSinkDatastore = "datastore"
+)
+
 //go:generate go run github.com/ecordell/optgen -output zz_generated.options.go . Config
 type Config struct {
 	// API config
 	GRPCServer             util.GRPCServerConfig `debugmap:"visible"`
 	GRPCAuthFunc           grpc_auth.AuthFunc    `debugmap:"visible"`
 	PresharedSecureKey     []string              `debugmap:"sensitive"`
+	GRPCAuthJWT            auth.JWTConfig        `debugmap:"visible"`
 	ShutdownGracePeriod    time.Duration         `debugmap:"visible"`
 	DisableVersionResponse bool                  `debugmap:"visible"`
+	EnableFairQueuing      bool                  `debugmap:"visible"`
+	FairQueuing            fairqueue.Config      `debugmap:"visible"`
+	EnableMultiTenancy     bool                  `debugmap:"visible"`
+	MultiTenancy           tenantmw.Config       `debugmap:"sensitive"`
+	PresharedKeyScopes     map[string]string     `debugmap:"sensitive"`
+	EnableAuditLog         bool                  `debugmap:"visible"`
+	AuditLogSink           string                `debugmap:"visible"`
+	AuditLogPath           string                `debugmap:"visible"`
 
 	// GRPC Gateway config
 	HTTPGateway                    util.HTTPServerConfig `debugmap:"visible"`
@@ -102,6 +127,14 @@ type Config struct {
 	DispatchCacheConfig        CacheConfig `debugmap:"visible"`
 	ClusterDispatchCacheConfig CacheConfig `debugmap:"visible"`
 
+	DispatchSecondTierCachePath    string `debugmap:"visible"`
+	DispatchSecondTierCacheMaxSize string `debugmap:"visible"`
+
+	EnableExperimentalWatchableDispatchCache bool `debugmap:"visible"`
+
+	EnableExperimentalAdaptiveDispatchConcurrency bool                            `debugmap:"visible"`
+	DispatchAdaptiveConcurrency                   graph.AdaptiveConcurrencyConfig `debugmap:"visible"`
+
 	// API Behavior
 	DisableV1SchemaAPI       bool          `debugmap:"visible"`
 	V1SchemaAdditiveOnly     bool          `debugmap:"visible"`
@@ -190,25 +223,105 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 		}
 	}()
 
-	if len(c.PresharedSecureKey) < 1 && c.GRPCAuthFunc == nil {
-		return nil, fmt.Errorf("a preshared key must be provided to authenticate API requests")
+	if len(c.PresharedSecureKey) < 1 && c.GRPCAuthFunc == nil && c.GRPCAuthJWT.JWKSPath == "" && c.GRPCServer.ClientCertificateCAPath == "" {
+		return nil, fmt.Errorf("a preshared key, a JWKS file or a client certificate CA must be provided to authenticate API requests")
+	}
+
+	if len(c.PresharedSecureKey) < 1 && c.GRPCAuthFunc == nil && c.DispatchServer.Enabled && len(c.DispatchUnaryMiddleware) == 0 && len(c.DispatchStreamingMiddleware) == 0 {
+		return nil, fmt.Errorf("a preshared key must be provided to authenticate dispatches between the nodes of the cluster")
+	}
+
+	if c.GRPCServer.ClientCertificateCAPath != "" && c.GRPCServer.TLSCertPath == "" {
+		return nil, fmt.Errorf("a TLS certificate must be provided to verify client certificates")
 	}
 
+	// An auth function provided by the embedder also authenticates dispatches, and must then
+	// itself only grant access to the dispatch API to the other nodes of the cluster.
+	embedderAuthFunc := c.GRPCAuthFunc
 	if c.GRPCAuthFunc == nil {
-		log.Ctx(ctx).Trace().Int("preshared-keys-count", len(c.PresharedSecureKey)).Msg("using gRPC auth with preshared key(s)")
-		for index, presharedKey := range c.PresharedSecureKey {
-			if len(presharedKey) == 0 {
-				return nil, fmt.Errorf("preshared key #%d is empty", index+1)
+		var schemes []auth.Scheme
+		if c.GRPCServer.ClientCertificateCAPath != "" {
+			log.Ctx(ctx).Trace().Str("client-cert-ca-path", c.GRPCServer.ClientCertificateCAPath).Msg("using gRPC auth with client certificates")
+			schemes = append(schemes, auth.CertificateScheme)
+		}
+
+		if len(c.PresharedSecureKey) > 0 {
+			log.Ctx(ctx).Trace().Int("preshared-keys-count", len(c.PresharedSecureKey)).Msg("using gRPC auth with preshared key(s)")
+			for index, presharedKey := range c.PresharedSecureKey {
+				if len(presharedKey) == 0 {
+					return nil, fmt.Errorf("preshared key #%d is empty", index+1)
+				}
+
+				log.Ctx(ctx).Trace().Int("preshared-key-"+strconv.Itoa(index+1)+"-length", len(presharedKey)).Msg("preshared key configured")
 			}
+			schemes = append(schemes, auth.MustPresharedKeyScheme(c.PresharedSecureKey, c.GRPCAuthJWT.JWKSPath != ""))
+		}
 
-			log.Ctx(ctx).Trace().Int("preshared-key-"+strconv.Itoa(index+1)+"-length", len(presharedKey)).Msg("preshared key configured")
+		if c.GRPCAuthJWT.JWKSPath != "" {
+			verifier, err := auth.NewJWTVerifier(c.GRPCAuthJWT)
+			if err != nil {
+				return nil, fmt.Errorf("failed to configure JWT auth: %w", err)
+			}
+
+			log.Ctx(ctx).Trace().Str("jwks-path", c.GRPCAuthJWT.JWKSPath).Msg("using gRPC auth with JWTs")
+			schemes = append(schemes, verifier.Scheme())
 		}
 
-		c.GRPCAuthFunc = auth.MustRequirePresharedKey(c.PresharedSecureKey)
+		c.GRPCAuthFunc = auth.MustRequireAnyScheme(schemes...)
 	} else {
 		log.Ctx(ctx).Trace().Msg("using preconfigured auth function")
 	}
 
+	var fairQueue *fairqueue.Scheduler
+	if c.EnableFairQueuing {
+		fairQueue, err = fairqueue.NewScheduler(c.FairQueuing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure fair queuing: %w", err)
+		}
+
+		for index := range c.PresharedSecureKey {
+			tenant := auth.Principal{Method: auth.MethodPresharedKey, ID: strconv.Itoa(index + 1)}
+			log.Ctx(ctx).Info().Int("preshared-key", index+1).Str("tenant", tenant.String()).Msg("configured fair queuing tenant")
+		}
+	}
+
+	var tenantResolver *tenantmw.Resolver
+	if c.EnableMultiTenancy {
+		for token, tenant := range c.MultiTenancy.TokenTenants {
+			if len(c.PresharedSecureKey) > 0 && !slices.Contains(c.PresharedSecureKey, token) && !auth.IsPrincipalIdentity(token) {
+				return nil, fmt.Errorf("failed to configure multi-tenancy: the credentials bound to tenant `%s` are neither a preshared key nor a principal identity", tenant)
+			}
+		}
+
+		tenantResolver, err = tenantmw.NewResolver(c.MultiTenancy, c.PresharedSecureKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure multi-tenancy: %w", err)
+		}
+		log.Ctx(ctx).Info().Str("tenant-header", c.MultiTenancy.TenantHeader).Int("token-tenants", len(c.MultiTenancy.TokenTenants)).Msg("configured multi-tenancy")
+	}
+
+	var tokenScopes *tokenscope.Authorizer
+	if len(c.PresharedKeyScopes) > 0 {
+		for index, presharedKey := range c.PresharedSecureKey {
+			if scope, ok := c.PresharedKeyScopes[presharedKey]; ok {
+				log.Ctx(ctx).Info().Int("preshared-key", index+1).Str("scope", scope).Msg("configured preshared key scope")
+			}
+		}
+
+		for token := range c.PresharedKeyScopes {
+			if len(c.PresharedSecureKey) > 0 && !slices.Contains(c.PresharedSecureKey, token) && !auth.IsPrincipalIdentity(token) {
+				return nil, fmt.Errorf("failed to configure preshared key scopes: a scoped key is neither a preshared key nor a principal identity")
+			}
+		}
+
+		tokenScopes, err = tokenscope.NewAuthorizer(c.PresharedKeyScopes, c.PresharedSecureKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure preshared key scopes: %w", err)
+		}
+	}
+
+	usagemetrics.SetLabeledPrincipals(c.configuredPrincipals())
+
 	ds := c.Datastore
 	if ds == nil {
 		var err error
@@ -222,6 +335,29 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 	}
 	closeables.AddWithError(ds.Close)
 
+	var auditor *audit.Auditor
+	if c.EnableAuditLog {
+		var sink audit.Sink
+		switch c.AuditLogSink {
+		case AuditLogSinkFile:
+			if c.AuditLogPath == "" {
+				return nil, fmt.Errorf("failed to configure audit log: a path is required for the file sink")
+			}
+			sink, err = audit.NewFileSink(c.AuditLogPath)
+		case AuditLogSinkDatastore:
+			sink, err = audit.NewDatastoreSink(ds)
+		default:
+			err = fmt.Errorf("unknown sink `%s`", c.AuditLogSink)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure audit log: %w", err)
+		}
+
+		auditor = audit.NewAuditor(sink)
+		closeables.AddWithError(auditor.Close)
+		log.Ctx(ctx).Info().Str("sink", c.AuditLogSink).Msg("configured audit log")
+	}
+
 	nscc, err := c.NamespaceCacheConfig.Complete()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create namespace cache: %w", err)
@@ -229,7 +365,7 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 	log.Ctx(ctx).Info().EmbedObject(nscc).Msg("configured namespace cache")
 
 	cachingMode := schemacaching.JustInTimeCaching
-	if c.EnableExperimentalWatchableSchemaCache {
+	if c.EnableExperimentalWatchableSchemaCache || c.EnableExperimentalWatchableDispatchCache {
 		cachingMode = schemacaching.WatchIfSupported
 	}
 
@@ -241,6 +377,42 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 	specificConcurrencyLimits := c.DispatchConcurrencyLimits
 	concurrencyLimits := specificConcurrencyLimits.WithOverallDefaultLimit(c.GlobalDispatchConcurrencyLimit)
 
+	var secondTierCache caching.SecondTierCache
+	if c.DispatchSecondTierCachePath != "" {
+		maxSize, err := humanize.ParseBytes(c.DispatchSecondTierCacheMaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing second tier dispatch cache max size: `%s`: %w", c.DispatchSecondTierCacheMaxSize, err)
+		}
+
+		// Entries are retained as long as those of the dispatch cache, which expire safely
+		// outside of the quantization window.
+		maxAge := c.DispatchCacheConfig.WithRevisionParameters(
+			c.DatastoreConfig.RevisionQuantization,
+			c.DatastoreConfig.FollowerReadDelay,
+			c.DatastoreConfig.MaxRevisionStalenessPercent,
+		).defaultTTL
+
+		diskCache, err := caching.NewDiskCache(c.DispatchSecondTierCachePath, maxAge, maxSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create second tier dispatch cache: %w", err)
+		}
+		closeables.AddWithError(diskCache.Close)
+		secondTierCache = diskCache
+		log.Ctx(ctx).Info().Str("path", c.DispatchSecondTierCachePath).Str("maxSize", humanize.IBytes(maxSize)).Dur("maxAge", maxAge).Msg("configured second tier dispatch cache")
+	}
+
+	var changeTracker *caching.ChangeTracker
+	if c.EnableExperimentalWatchableDispatchCache {
+		changeTracker = caching.NewChangeTracker(ds, c.SchemaWatchHeartbeat)
+		closeables.AddWithError(changeTracker.Close)
+	}
+
+	var adaptiveConcurrency *graph.AdaptiveConcurrency
+	if c.EnableExperimentalAdaptiveDispatchConcurrency {
+		adaptiveConcurrency = graph.NewAdaptiveConcurrency(concurrencyLimits, c.DispatchAdaptiveConcurrency)
+		log.Ctx(ctx).Info().EmbedObject(adaptiveConcurrency).Msg("configured adaptive dispatch concurrency")
+	}
+
 	dispatcher := c.Dispatcher
 	if dispatcher == nil {
 		cc, err := c.DispatchCacheConfig.WithRevisionParameters(
@@ -275,11 +447,16 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 			combineddispatch.GrpcPresharedKey(dispatchPresharedKey),
 			combineddispatch.GrpcDialOpts(
 				grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()), // nolint: staticcheck
+				grpc.WithChainUnaryInterceptor(tenantmw.UnaryClientInterceptor),
+				grpc.WithChainStreamInterceptor(tenantmw.StreamClientInterceptor),
 				grpc.WithDefaultServiceConfig(hashringConfigJSON),
 			),
 			combineddispatch.MetricsEnabled(c.DispatchClientMetricsEnabled),
 			combineddispatch.PrometheusSubsystem(c.DispatchClientMetricsPrefix),
 			combineddispatch.Cache(cc),
+			combineddispatch.SecondTierCache(secondTierCache),
+			combineddispatch.ChangeTracker(changeTracker),
+			combineddispatch.AdaptiveConcurrency(adaptiveConcurrency),
 			combineddispatch.ConcurrencyLimits(concurrencyLimits),
 		)
 		if err != nil {
@@ -290,12 +467,16 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 	}
 	closeables.AddWithError(dispatcher.Close)
 
-	if len(c.DispatchUnaryMiddleware) == 0 && len(c.DispatchStreamingMiddleware) == 0 {
-		if c.GRPCAuthFunc == nil {
-			c.DispatchUnaryMiddleware, c.DispatchStreamingMiddleware = DefaultDispatchMiddleware(log.Logger, auth.MustRequirePresharedKey(c.PresharedSecureKey), ds, c.DisableGRPCLatencyHistogram)
-		} else {
-			c.DispatchUnaryMiddleware, c.DispatchStreamingMiddleware = DefaultDispatchMiddleware(log.Logger, c.GRPCAuthFunc, ds, c.DisableGRPCLatencyHistogram)
+	// Dispatches are only accepted from the other nodes of the cluster, which authenticate with a
+	// preshared key: the dispatch API trusts the tenant forwarded with each dispatch, so the
+	// credentials of API clients must not grant access to it. Embedders providing their own auth
+	// function keep authenticating dispatches with it.
+	if len(c.DispatchUnaryMiddleware) == 0 && len(c.DispatchStreamingMiddleware) == 0 && c.DispatchServer.Enabled {
+		dispatchAuthFunc := embedderAuthFunc
+		if dispatchAuthFunc == nil {
+			dispatchAuthFunc = auth.MustRequireDispatchPresharedKey(c.PresharedSecureKey)
 		}
+		c.DispatchUnaryMiddleware, c.DispatchStreamingMiddleware = DefaultDispatchMiddleware(log.Logger, dispatchAuthFunc, ds, c.DisableGRPCLatencyHistogram)
 	}
 
 	var cachingClusterDispatch dispatch.Dispatcher
@@ -316,7 +497,10 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 			clusterdispatch.MetricsEnabled(c.DispatchClusterMetricsEnabled),
 			clusterdispatch.PrometheusSubsystem(c.DispatchClusterMetricsPrefix),
 			clusterdispatch.Cache(cdcc),
+			clusterdispatch.SecondTierCache(secondTierCache),
+			clusterdispatch.ChangeTracker(changeTracker),
 			clusterdispatch.RemoteDispatchTimeout(c.DispatchUpstreamTimeout),
+			clusterdispatch.AdaptiveConcurrency(adaptiveConcurrency),
 			clusterdispatch.ConcurrencyLimits(concurrencyLimits),
 		)
 		if err != nil {
@@ -364,6 +548,10 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 		c.EnableRequestLogs,
 		c.EnableResponseLogs,
 		c.DisableGRPCLatencyHistogram,
+		fairQueue,
+		tenantResolver,
+		tokenScopes,
+		auditor,
 	}
 	defaultUnaryMiddlewareChain, err := DefaultUnaryMiddleware(opts)
 	if err != nil {
@@ -401,6 +589,8 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 		MaxRelationshipContextSize: c.MaxRelationshipContextSize,
 		MaxDatastoreReadPageSize:   c.MaxDatastoreReadPageSize,
 		StreamingAPITimeout:        c.StreamingAPITimeout,
+
+		MaxLookupPermissionsConcurrency: concurrencyLimits.LookupResources,
 	}
 
 	healthManager := health.NewHealthManager(dispatcher, ds)
@@ -471,6 +661,7 @@ func (c *Config) Complete(ctx context.Context) (RunnableServer, error) {
 		presharedKeys:       c.PresharedSecureKey,
 		telemetryReporter:   reporter,
 		healthManager:       healthManager,
+		changeTracker:       changeTracker,
 		closeFunc:           closeables.Close,
 	}, nil
 }
@@ -572,6 +763,7 @@ type completedServerConfig struct {
 	metricsServer      util.RunnableHTTPServer
 	telemetryReporter  telemetry.Reporter
 	healthManager      health.Manager
+	changeTracker      *caching.ChangeTracker
 
 	unaryMiddleware     []grpc.UnaryServerInterceptor
 	streamingMiddleware []grpc.StreamServerInterceptor
@@ -605,6 +797,13 @@ func (c *completedServerConfig) Run(ctx context.Context) error {
 		}
 	}
 
+	if c.changeTracker != nil {
+		log.Ctx(ctx).Info().Msg("Start-ing dispatch cache change tracker")
+		if err := c.changeTracker.Start(ctx); err != nil {
+			return err
+		}
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	stopOnCancelWithErr := func(stopFn func() error) func() error {
@@ -631,3 +830,24 @@ func (c *completedServerConfig) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// configuredPrincipals returns the identities of the principals authenticated by client
+// certificate or JWT that are named by the configuration.
+func (c *Config) configuredPrincipals() []string {
+	var principals []string
+	for identity := range c.PresharedKeyScopes {
+		principals = append(principals, identity)
+	}
+	for identity := range c.MultiTenancy.TokenTenants {
+		principals = append(principals, identity)
+	}
+	for identity := range c.FairQueuing.TenantWeights {
+		principals = append(principals, identity)
+	}
+	for identity := range c.FairQueuing.TenantRateLimits {
+		principals = append(principals, identity)
+	}
+	return slices.DeleteFunc(principals, func(identity string) bool {
+		return !auth.IsPrincipalIdentity(identity)
+	})
+}
@@ -176,6 +176,45 @@ func TestServerGracefulTerminationOnError(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEmbedderAuthFuncAuthenticatesDispatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ds, err := memdb.NewMemdbDatastore(0, 1*time.Second, 10*time.Second)
+	require.NoError(t, err)
+
+	authenticated := false
+	c := ConfigWithOptions(&Config{
+		GRPCServer: util.GRPCServerConfig{
+			Network: util.BufferedNetwork,
+		},
+		DispatchServer: util.GRPCServerConfig{
+			Network: util.BufferedNetwork,
+			Enabled: true,
+		},
+	}, WithGRPCAuthFunc(func(ctx context.Context) (context.Context, error) {
+		authenticated = true
+		return ctx, nil
+	}), WithDatastore(ds))
+	_, err = c.Complete(ctx)
+	require.NoError(t, err)
+
+	handler := grpc.UnaryHandler(func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	for i := len(c.DispatchUnaryMiddleware) - 1; i >= 0; i-- {
+		interceptor, next := c.DispatchUnaryMiddleware[i], handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/dispatch.v1.DispatchService/DispatchCheck"}, next)
+		}
+	}
+
+	resp, err := handler(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+	require.True(t, authenticated)
+}
+
 func TestReplaceUnaryMiddleware(t *testing.T) {
 	c := Config{UnaryMiddlewareModification: []MiddlewareModification[grpc.UnaryServerInterceptor]{
 		{
@@ -230,7 +269,7 @@ func TestModifyUnaryMiddleware(t *testing.T) {
 		},
 	}}
 
-	opt := MiddlewareOption{logging.Logger, nil, false, nil, nil, false, false, false}
+	opt := MiddlewareOption{logging.Logger, nil, false, nil, nil, false, false, false, nil, nil, nil, nil}
 	defaultMw, err := DefaultUnaryMiddleware(opt)
 	require.NoError(t, err)
 
@@ -256,7 +295,7 @@ func TestModifyStreamingMiddleware(t *testing.T) {
 		},
 	}}
 
-	opt := MiddlewareOption{logging.Logger, nil, false, nil, nil, false, false, false}
+	opt := MiddlewareOption{logging.Logger, nil, false, nil, nil, false, false, false, nil, nil, nil, nil}
 	defaultMw, err := DefaultStreamingMiddleware(opt)
 	require.NoError(t, err)
 
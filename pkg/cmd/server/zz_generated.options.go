@@ -2,8 +2,11 @@
 package server
 
 import (
+	auth1 "github.com/authzed/spicedb/internal/auth"
 	dispatch "github.com/authzed/spicedb/internal/dispatch"
 	graph "github.com/authzed/spicedb/internal/dispatch/graph"
+	fairqueue "github.com/authzed/spicedb/internal/middleware/fairqueue"
+	tenant "github.com/authzed/spicedb/internal/middleware/tenant"
 	datastore "github.com/authzed/spicedb/pkg/cmd/datastore"
 	util "github.com/authzed/spicedb/pkg/cmd/util"
 	datastore1 "github.com/authzed/spicedb/pkg/datastore"
@@ -41,8 +44,17 @@ func (c *Config) ToOption() ConfigOption {
 		to.GRPCServer = c.GRPCServer
 		to.GRPCAuthFunc = c.GRPCAuthFunc
 		to.PresharedSecureKey = c.PresharedSecureKey
+		to.GRPCAuthJWT = c.GRPCAuthJWT
 		to.ShutdownGracePeriod = c.ShutdownGracePeriod
 		to.DisableVersionResponse = c.DisableVersionResponse
+		to.EnableFairQueuing = c.EnableFairQueuing
+		to.FairQueuing = c.FairQueuing
+		to.EnableMultiTenancy = c.EnableMultiTenancy
+		to.MultiTenancy = c.MultiTenancy
+		to.PresharedKeyScopes = c.PresharedKeyScopes
+		to.EnableAuditLog = c.EnableAuditLog
+		to.AuditLogSink = c.AuditLogSink
+		to.AuditLogPath = c.AuditLogPath
 		to.HTTPGateway = c.HTTPGateway
 		to.HTTPGatewayUpstreamAddr = c.HTTPGatewayUpstreamAddr
 		to.HTTPGatewayUpstreamTLSCertPath = c.HTTPGatewayUpstreamTLSCertPath
@@ -74,6 +86,11 @@ func (c *Config) ToOption() ConfigOption {
 		to.DispatchSecondaryUpstreamExprs = c.DispatchSecondaryUpstreamExprs
 		to.DispatchCacheConfig = c.DispatchCacheConfig
 		to.ClusterDispatchCacheConfig = c.ClusterDispatchCacheConfig
+		to.DispatchSecondTierCachePath = c.DispatchSecondTierCachePath
+		to.DispatchSecondTierCacheMaxSize = c.DispatchSecondTierCacheMaxSize
+		to.EnableExperimentalWatchableDispatchCache = c.EnableExperimentalWatchableDispatchCache
+		to.EnableExperimentalAdaptiveDispatchConcurrency = c.EnableExperimentalAdaptiveDispatchConcurrency
+		to.DispatchAdaptiveConcurrency = c.DispatchAdaptiveConcurrency
 		to.DisableV1SchemaAPI = c.DisableV1SchemaAPI
 		to.V1SchemaAdditiveOnly = c.V1SchemaAdditiveOnly
 		to.MaximumUpdatesPerWrite = c.MaximumUpdatesPerWrite
@@ -102,8 +119,17 @@ func (c Config) DebugMap() map[string]any {
 	debugMap["GRPCServer"] = helpers.DebugValue(c.GRPCServer, false)
 	debugMap["GRPCAuthFunc"] = helpers.DebugValue(c.GRPCAuthFunc, false)
 	debugMap["PresharedSecureKey"] = helpers.SensitiveDebugValue(c.PresharedSecureKey)
+	debugMap["GRPCAuthJWT"] = helpers.DebugValue(c.GRPCAuthJWT, false)
 	debugMap["ShutdownGracePeriod"] = helpers.DebugValue(c.ShutdownGracePeriod, false)
 	debugMap["DisableVersionResponse"] = helpers.DebugValue(c.DisableVersionResponse, false)
+	debugMap["EnableFairQueuing"] = helpers.DebugValue(c.EnableFairQueuing, false)
+	debugMap["FairQueuing"] = helpers.DebugValue(c.FairQueuing, false)
+	debugMap["EnableMultiTenancy"] = helpers.DebugValue(c.EnableMultiTenancy, false)
+	debugMap["MultiTenancy"] = helpers.SensitiveDebugValue(c.MultiTenancy)
+	debugMap["PresharedKeyScopes"] = helpers.SensitiveDebugValue(c.PresharedKeyScopes)
+	debugMap["EnableAuditLog"] = helpers.DebugValue(c.EnableAuditLog, false)
+	debugMap["AuditLogSink"] = helpers.DebugValue(c.AuditLogSink, false)
+	debugMap["AuditLogPath"] = helpers.DebugValue(c.AuditLogPath, false)
 	debugMap["HTTPGateway"] = helpers.DebugValue(c.HTTPGateway, false)
 	debugMap["HTTPGatewayUpstreamAddr"] = helpers.DebugValue(c.HTTPGatewayUpstreamAddr, false)
 	debugMap["HTTPGatewayUpstreamTLSCertPath"] = helpers.DebugValue(c.HTTPGatewayUpstreamTLSCertPath, false)
@@ -135,6 +161,11 @@ func (c Config) DebugMap() map[string]any {
 	debugMap["DispatchSecondaryUpstreamExprs"] = helpers.DebugValue(c.DispatchSecondaryUpstreamExprs, false)
 	debugMap["DispatchCacheConfig"] = helpers.DebugValue(c.DispatchCacheConfig, false)
 	debugMap["ClusterDispatchCacheConfig"] = helpers.DebugValue(c.ClusterDispatchCacheConfig, false)
+	debugMap["DispatchSecondTierCachePath"] = helpers.DebugValue(c.DispatchSecondTierCachePath, false)
+	debugMap["DispatchSecondTierCacheMaxSize"] = helpers.DebugValue(c.DispatchSecondTierCacheMaxSize, false)
+	debugMap["EnableExperimentalWatchableDispatchCache"] = helpers.DebugValue(c.EnableExperimentalWatchableDispatchCache, false)
+	debugMap["EnableExperimentalAdaptiveDispatchConcurrency"] = helpers.DebugValue(c.EnableExperimentalAdaptiveDispatchConcurrency, false)
+	debugMap["DispatchAdaptiveConcurrency"] = helpers.DebugValue(c.DispatchAdaptiveConcurrency, false)
 	debugMap["DisableV1SchemaAPI"] = helpers.DebugValue(c.DisableV1SchemaAPI, false)
 	debugMap["V1SchemaAdditiveOnly"] = helpers.DebugValue(c.V1SchemaAdditiveOnly, false)
 	debugMap["MaximumUpdatesPerWrite"] = helpers.DebugValue(c.MaximumUpdatesPerWrite, false)
@@ -197,6 +228,13 @@ func SetPresharedSecureKey(presharedSecureKey []string) ConfigOption {
 	}
 }
 
+// WithGRPCAuthJWT returns an option that can set GRPCAuthJWT on a Config
+func WithGRPCAuthJWT(gRPCAuthJWT auth1.JWTConfig) ConfigOption {
+	return func(c *Config) {
+		c.GRPCAuthJWT = gRPCAuthJWT
+	}
+}
+
 // WithShutdownGracePeriod returns an option that can set ShutdownGracePeriod on a Config
 func WithShutdownGracePeriod(shutdownGracePeriod time.Duration) ConfigOption {
 	return func(c *Config) {
@@ -211,6 +249,69 @@ func WithDisableVersionResponse(disableVersionResponse bool) ConfigOption {
 	}
 }
 
+// WithEnableFairQueuing returns an option that can set EnableFairQueuing on a Config
+func WithEnableFairQueuing(enableFairQueuing bool) ConfigOption {
+	return func(c *Config) {
+		c.EnableFairQueuing = enableFairQueuing
+	}
+}
+
+// WithFairQueuing returns an option that can set FairQueuing on a Config
+func WithFairQueuing(fairQueuing fairqueue.Config) ConfigOption {
+	return func(c *Config) {
+		c.FairQueuing = fairQueuing
+	}
+}
+
+// WithEnableMultiTenancy returns an option that can set EnableMultiTenancy on a Config
+func WithEnableMultiTenancy(enableMultiTenancy bool) ConfigOption {
+	return func(c *Config) {
+		c.EnableMultiTenancy = enableMultiTenancy
+	}
+}
+
+// WithMultiTenancy returns an option that can set MultiTenancy on a Config
+func WithMultiTenancy(multiTenancy tenant.Config) ConfigOption {
+	return func(c *Config) {
+		c.MultiTenancy = multiTenancy
+	}
+}
+
+// WithPresharedKeyScopes returns an option that can append PresharedKeyScopess to Config.PresharedKeyScopes
+func WithPresharedKeyScopes(key string, value string) ConfigOption {
+	return func(c *Config) {
+		c.PresharedKeyScopes[key] = value
+	}
+}
+
+// SetPresharedKeyScopes returns an option that can set PresharedKeyScopes on a Config
+func SetPresharedKeyScopes(presharedKeyScopes map[string]string) ConfigOption {
+	return func(c *Config) {
+		c.PresharedKeyScopes = presharedKeyScopes
+	}
+}
+
+// WithEnableAuditLog returns an option that can set EnableAuditLog on a Config
+func WithEnableAuditLog(enableAuditLog bool) ConfigOption {
+	return func(c *Config) {
+		c.EnableAuditLog = enableAuditLog
+	}
+}
+
+// WithAuditLogSink returns an option that can set AuditLogSink on a Config
+func WithAuditLogSink(auditLogSink string) ConfigOption {
+	return func(c *Config) {
+		c.AuditLogSink = auditLogSink
+	}
+}
+
+// WithAuditLogPath returns an option that can set AuditLogPath on a Config
+func WithAuditLogPath(auditLogPath string) ConfigOption {
+	return func(c *Config) {
+		c.AuditLogPath = auditLogPath
+	}
+}
+
 // WithHTTPGateway returns an option that can set HTTPGateway on a Config
 func WithHTTPGateway(hTTPGateway util.HTTPServerConfig) ConfigOption {
 	return func(c *Config) {
@@ -449,6 +550,41 @@ func WithClusterDispatchCacheConfig(clusterDispatchCacheConfig CacheConfig) Conf
 	}
 }
 
+// WithDispatchSecondTierCachePath returns an option that can set DispatchSecondTierCachePath on a Config
+func WithDispatchSecondTierCachePath(dispatchSecondTierCachePath string) ConfigOption {
+	return func(c *Config) {
+		c.DispatchSecondTierCachePath = dispatchSecondTierCachePath
+	}
+}
+
+// WithDispatchSecondTierCacheMaxSize returns an option that can set DispatchSecondTierCacheMaxSize on a Config
+func WithDispatchSecondTierCacheMaxSize(dispatchSecondTierCacheMaxSize string) ConfigOption {
+	return func(c *Config) {
+		c.DispatchSecondTierCacheMaxSize = dispatchSecondTierCacheMaxSize
+	}
+}
+
+// WithEnableExperimentalWatchableDispatchCache returns an option that can set EnableExperimentalWatchableDispatchCache on a Config
+func WithEnableExperimentalWatchableDispatchCache(enableExperimentalWatchableDispatchCache bool) ConfigOption {
+	return func(c *Config) {
+		c.EnableExperimentalWatchableDispatchCache = enableExperimentalWatchableDispatchCache
+	}
+}
+
+// WithEnableExperimentalAdaptiveDispatchConcurrency returns an option that can set EnableExperimentalAdaptiveDispatchConcurrency on a Config
+func WithEnableExperimentalAdaptiveDispatchConcurrency(enableExperimentalAdaptiveDispatchConcurrency bool) ConfigOption {
+	return func(c *Config) {
+		c.EnableExperimentalAdaptiveDispatchConcurrency = enableExperimentalAdaptiveDispatchConcurrency
+	}
+}
+
+// WithDispatchAdaptiveConcurrency returns an option that can set DispatchAdaptiveConcurrency on a Config
+func WithDispatchAdaptiveConcurrency(dispatchAdaptiveConcurrency graph.AdaptiveConcurrencyConfig) ConfigOption {
+	return func(c *Config) {
+		c.DispatchAdaptiveConcurrency = dispatchAdaptiveConcurrency
+	}
+}
+
 // WithDisableV1SchemaAPI returns an option that can set DisableV1SchemaAPI on a Config
 func WithDisableV1SchemaAPI(disableV1SchemaAPI bool) ConfigOption {
 	return func(c *Config) {
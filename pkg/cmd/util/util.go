@@ -46,6 +46,11 @@ type GRPCServerConfig struct {
 	ClientCAPath string        `debugmap:"visible"`
 	MaxWorkers   uint32        `debugmap:"visible"`
 
+	// ClientCertificateCAPath is the CA bundle verifying the client certificates presented to
+	// the server, which are then available to authenticate requests. Unlike ClientCAPath, which
+	// verifies the server for in-process clients, it is only set for the public API server.
+	ClientCertificateCAPath string `debugmap:"visible"`
+
 	flagPrefix string
 }
 
@@ -165,11 +170,21 @@ func (c *GRPCServerConfig) tlsOpts() ([]grpc.ServerOption, *certwatcher.CertWatc
 		if err != nil {
 			return nil, nil, err
 		}
-		creds := credentials.NewTLS(&tls.Config{
+		tlsConfig := &tls.Config{
 			GetCertificate: watcher.GetCertificate,
 			MinVersion:     tls.VersionTLS12,
-		})
-		return []grpc.ServerOption{grpc.Creds(creds)}, watcher, nil
+		}
+		if c.ClientCertificateCAPath != "" {
+			pool, err := x509util.CustomCertPool(c.ClientCertificateCAPath)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// Clients without a certificate may still authenticate with a bearer token.
+			tlsConfig.ClientCAs = pool
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+		return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, watcher, nil
 	default:
 		return nil, nil, nil
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/authzed/spicedb/pkg/tuple"
 
@@ -61,6 +62,10 @@ type RevisionChanges struct {
 	// DeletedCaveats are any caveats that were deleted.
 	DeletedCaveats []string
 
+	// Metadata is the metadata attached to the transaction that made the relationship changes
+	// at this revision, if any.
+	Metadata *structpb.Struct
+
 	// IsCheckpoint, if true, indicates that the datastore has reported all changes
 	// up until and including the Revision and that no additional schema updates can
 	// have occurred before this point.
@@ -545,6 +550,28 @@ type RepairableDatastore interface {
 	RepairOperations() []RepairOperation
 }
 
+// AuditRecord is a record of the audit log stored by an AuditLogDatastore.
+type AuditRecord struct {
+	// Timestamp is the time at which the audited operation was performed.
+	Timestamp time.Time
+
+	// Data is the JSON encoding of the record.
+	Data []byte
+}
+
+// AuditLogDatastore is an optional extension to the datastore interface that, when implemented,
+// provides the ability to store the records of the audit log in a table of the datastore.
+type AuditLogDatastore interface {
+	Datastore
+
+	// WriteAuditRecord appends the record to the audit log.
+	WriteAuditRecord(ctx context.Context, record AuditRecord) error
+
+	// ReadAuditRecords returns, in order, up to limit records of the audit log whose timestamp is
+	// at or after the given time.
+	ReadAuditRecords(ctx context.Context, since time.Time, limit uint64) ([]AuditRecord, error)
+}
+
 // UnwrappableDatastore represents a datastore that can be unwrapped into the underlying
 // datastore.
 type UnwrappableDatastore interface {
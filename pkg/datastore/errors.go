@@ -51,6 +51,24 @@ type ErrWatchCanceled struct{ error }
 // ErrWatchDisabled occurs when watch is disabled by being unsupported by the datastore.
 type ErrWatchDisabled struct{ error }
 
+// ErrWatchRevisionTooOld occurs when a watch was requested to start at a revision that has fallen
+// outside of the datastore's garbage collection window, and therefore changes after that revision
+// can no longer be guaranteed to be complete.
+type ErrWatchRevisionTooOld struct {
+	error
+	revision Revision
+}
+
+// InvalidRevision is the revision at which the watch was requested to start.
+func (err ErrWatchRevisionTooOld) InvalidRevision() Revision {
+	return err.revision
+}
+
+// MarshalZerologObject implements zerolog object marshalling.
+func (err ErrWatchRevisionTooOld) MarshalZerologObject(e *zerolog.Event) {
+	e.Err(err.error).Stringer("revision", err.revision)
+}
+
 // ErrReadOnly is returned when the operation cannot be completed because the datastore is in
 // read-only mode.
 type ErrReadOnly struct{ error }
@@ -130,6 +148,14 @@ func NewWatchDisabledErr(reason string) error {
 	}
 }
 
+// NewWatchRevisionTooOldErr constructs a new watch revision is too old error.
+func NewWatchRevisionTooOldErr(revision Revision) error {
+	return ErrWatchRevisionTooOld{
+		error:    fmt.Errorf("watch start revision `%s` is too old and may have been garbage collected; resync from a bulk export at a newer revision and resume the watch from that revision", revision),
+		revision: revision,
+	}
+}
+
 // NewWatchTemporaryErr wraps another error in watch, indicating that the error is likely
 // a temporary condition and clients may consider retrying by calling watch again (vs a fatal error).
 func NewWatchTemporaryErr(wrapped error) error {
@@ -0,0 +1,48 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/pkg/datastore"
+)
+
+func AuditLogTest(t *testing.T, tester DatastoreTester) {
+	ctx := context.Background()
+	require := require.New(t)
+
+	ds, err := tester.New(0, veryLargeGCInterval, veryLargeGCWindow, 1)
+	require.NoError(err)
+	t.Cleanup(func() { ds.Close() })
+
+	auditLog := datastore.UnwrapAs[datastore.AuditLogDatastore](ds)
+	if auditLog == nil {
+		t.Skip("datastore does not support an audit log")
+	}
+
+	start := time.Now().UTC().Truncate(time.Millisecond)
+	// Records are written out of order, and must be read back ordered by timestamp.
+	for _, index := range []int{1, 0, 2} {
+		require.NoError(auditLog.WriteAuditRecord(ctx, datastore.AuditRecord{
+			Timestamp: start.Add(time.Duration(index) * time.Second),
+			Data:      []byte(fmt.Sprintf(`{"index": %d}`, index)),
+		}))
+	}
+
+	records, err := auditLog.ReadAuditRecords(ctx, start, 10)
+	require.NoError(err)
+	require.Len(records, 3)
+	for i, record := range records {
+		require.True(start.Add(time.Duration(i) * time.Second).Equal(record.Timestamp))
+		require.JSONEq(fmt.Sprintf(`{"index": %d}`, i), string(record.Data))
+	}
+
+	records, err = auditLog.ReadAuditRecords(ctx, start.Add(time.Second), 1)
+	require.NoError(err)
+	require.Len(records, 1)
+	require.JSONEq(`{"index": 1}`, string(records[0].Data))
+}
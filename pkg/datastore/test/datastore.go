@@ -102,6 +102,7 @@ func AllWithExceptions(t *testing.T, tester DatastoreTester, except Categories)
 	t.Run("TestDeleteOneThousandIndividualInOneCall", func(t *testing.T) { DeleteOneThousandIndividualInOneCallTest(t, tester) })
 	t.Run("TestCreateTouchDeleteTouch", func(t *testing.T) { CreateTouchDeleteTouchTest(t, tester) })
 	t.Run("TestTouchAlreadyExistingCaveated", func(t *testing.T) { TouchAlreadyExistingCaveatedTest(t, tester) })
+	t.Run("TestRelationshipExpiration", func(t *testing.T) { RelationshipExpirationTest(t, tester) })
 	t.Run("TestBulkDeleteRelationships", func(t *testing.T) { BulkDeleteRelationshipsTest(t, tester) })
 	t.Run("TestDeleteCaveatedTuple", func(t *testing.T) { DeleteCaveatedTupleTest(t, tester) })
 	t.Run("TestDeleteWithLimit", func(t *testing.T) { DeleteWithLimitTest(t, tester) })
@@ -132,6 +133,8 @@ func AllWithExceptions(t *testing.T, tester DatastoreTester, except Categories)
 
 	t.Run("TestStats", func(t *testing.T) { StatsTest(t, tester) })
 
+	t.Run("TestAuditLog", func(t *testing.T) { AuditLogTest(t, tester) })
+
 	t.Run("TestRetries", func(t *testing.T) { RetryTest(t, tester) })
 
 	t.Run("TestCaveatNotFound", func(t *testing.T) { CaveatNotFoundTest(t, tester) })
@@ -143,9 +146,12 @@ func AllWithExceptions(t *testing.T, tester DatastoreTester, except Categories)
 	if !except.Watch() {
 		t.Run("TestWatchBasic", func(t *testing.T) { WatchTest(t, tester) })
 		t.Run("TestWatchCancel", func(t *testing.T) { WatchCancelTest(t, tester) })
+		t.Run("TestWatchRevisionTooOld", func(t *testing.T) { WatchRevisionTooOldTest(t, tester) })
 		t.Run("TestCaveatedRelationshipWatch", func(t *testing.T) { CaveatedRelationshipWatchTest(t, tester) })
 		t.Run("TestWatchWithTouch", func(t *testing.T) { WatchWithTouchTest(t, tester) })
 		t.Run("TestWatchWithDelete", func(t *testing.T) { WatchWithDeleteTest(t, tester) })
+		t.Run("TestWatchWithExpiredReplacement", func(t *testing.T) { WatchWithExpiredReplacementTest(t, tester) })
+		t.Run("TestWatchWithMetadata", func(t *testing.T) { WatchWithMetadataTest(t, tester) })
 	}
 
 	if !except.Watch() && !except.WatchSchema() {
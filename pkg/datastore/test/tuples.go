@@ -1379,6 +1379,82 @@ func TouchAlreadyExistingCaveatedTest(t *testing.T, tester DatastoreTester) {
 	ensureTuples(ctx, require, ds, tpl2, tpl3, ctpl1)
 }
 
+// RelationshipExpirationTest tests that expired relationships are not returned and can be
+// replaced by a CREATE or TOUCH.
+func RelationshipExpirationTest(t *testing.T, tester DatastoreTester) {
+	require := require.New(t)
+
+	rawDS, err := tester.New(0, veryLargeGCInterval, veryLargeGCWindow, 1)
+	require.NoError(err)
+
+	ds, _ := testfixtures.StandardDatastoreWithData(rawDS, require)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	future := tuple.WithExpiration(makeTestTuple("foo", "tom"), now.Add(time.Hour))
+	past := tuple.WithExpiration(makeTestTuple("foo", "sarah"), now.Add(-1*time.Hour))
+
+	_, err = common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, future, past)
+	require.NoError(err)
+
+	ensureTuples(ctx, require, ds, future)
+	ensureNotTuples(ctx, require, ds, past)
+
+	// Creating over an expired relationship succeeds.
+	recreated := makeTestTuple("foo", "sarah")
+	_, err = common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, recreated)
+	require.NoError(err)
+	ensureTuples(ctx, require, ds, future, recreated)
+
+	// Creating over an unexpired relationship still fails.
+	_, err = common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, makeTestTuple("foo", "tom"))
+	require.ErrorAs(err, &common.CreateRelationshipExistsError{})
+
+	// Touching with a new expiration replaces the existing relationship.
+	expired := tuple.WithExpiration(makeTestTuple("foo", "tom"), now.Add(-1*time.Minute))
+	_, err = common.WriteTuples(ctx, ds, core.RelationTupleUpdate_TOUCH, expired)
+	require.NoError(err)
+	ensureNotTuples(ctx, require, ds, expired)
+	ensureTuples(ctx, require, ds, recreated)
+
+	// Expiration is evaluated at the time of the revision being read, so a relationship remains
+	// visible at revisions from before its expiration.
+	expiration := time.Now().Add(time.Second)
+	expiring := tuple.WithExpiration(makeTestTuple("foo", "fred"), expiration)
+	beforeExpiration, err := common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, expiring)
+	require.NoError(err)
+
+	time.Sleep(time.Until(expiration) + 100*time.Millisecond)
+
+	afterExpiration, err := common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, makeTestTuple("foo", "george"))
+	require.NoError(err)
+
+	filter := datastore.RelationshipsFilter{
+		OptionalResourceType: expiring.ResourceAndRelation.Namespace,
+		OptionalResourceIds:  []string{expiring.ResourceAndRelation.ObjectId},
+		OptionalSubjectsSelectors: []datastore.SubjectsSelector{
+			{
+				OptionalSubjectType: expiring.Subject.Namespace,
+				OptionalSubjectIds:  []string{expiring.Subject.ObjectId},
+			},
+		},
+	}
+
+	iter, err := ds.SnapshotReader(beforeExpiration).QueryRelationships(ctx, filter)
+	require.NoError(err)
+	found := iter.Next()
+	require.NoError(iter.Err())
+	iter.Close()
+	require.NotNil(found)
+	require.Equal(expiring.Subject.ObjectId, found.Subject.ObjectId)
+
+	iter, err = ds.SnapshotReader(afterExpiration).QueryRelationships(ctx, filter)
+	require.NoError(err)
+	defer iter.Close()
+	require.Nil(iter.Next())
+	require.NoError(iter.Err())
+}
+
 func MultipleReadsInRWTTest(t *testing.T, tester DatastoreTester) {
 	require := require.New(t)
 
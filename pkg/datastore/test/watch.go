@@ -12,10 +12,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/scylladb/go-set/strset"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/authzed/spicedb/internal/datastore/common"
 	"github.com/authzed/spicedb/pkg/datastore"
+	"github.com/authzed/spicedb/pkg/datastore/options"
 	"github.com/authzed/spicedb/pkg/genutil/mapz"
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 	"github.com/authzed/spicedb/pkg/tuple"
@@ -232,6 +235,50 @@ func WatchCancelTest(t *testing.T, tester DatastoreTester) {
 	}
 }
 
+func WatchRevisionTooOldTest(t *testing.T, tester DatastoreTester) {
+	require := require.New(t)
+
+	ds, err := tester.New(0, 10*time.Millisecond, 300*time.Millisecond, 1)
+	require.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	staleRevision := setupDatastore(ds, require)
+
+	// Wait for the revision to fall outside of the GC window.
+	time.Sleep(300 * time.Millisecond)
+
+	gcable, ok := ds.(common.GarbageCollector)
+	if ok {
+		gcable.ResetGCCompleted()
+		require.Eventually(func() bool { return gcable.HasGCRun() }, 5*time.Second, 50*time.Millisecond, "GC was never run as expected")
+	}
+
+	_, err = common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, makeTestTuple("test", "test"))
+	require.NoError(err)
+
+	changes, errchan := ds.Watch(ctx, staleRevision, datastore.WatchJustRelationships())
+
+	errWait := time.NewTimer(waitForChangesTimeout)
+	defer errWait.Stop()
+
+	for {
+		select {
+		case change, ok := <-changes:
+			require.False(ok, "expected no changes from a stale revision, found: %v", change)
+			changes = nil
+		case err := <-errchan:
+			var tooOldErr datastore.ErrWatchRevisionTooOld
+			require.True(errors.As(err, &tooOldErr), "expected ErrWatchRevisionTooOld, found: %v", err)
+			require.True(staleRevision.Equal(tooOldErr.InvalidRevision()))
+			return
+		case <-errWait.C:
+			require.Fail("Timed out waiting for ErrWatchRevisionTooOld")
+		}
+	}
+}
+
 func WatchWithTouchTest(t *testing.T, tester DatastoreTester) {
 	require := require.New(t)
 
@@ -401,6 +448,38 @@ func WatchWithDeleteTest(t *testing.T, tester DatastoreTester) {
 	)
 }
 
+// WatchWithExpiredReplacementTest tests that replacing an expired relationship is reported as a
+// TOUCH only, as the expired relationship was no longer visible.
+func WatchWithExpiredReplacementTest(t *testing.T, tester DatastoreTester) {
+	require := require.New(t)
+
+	ds, err := tester.New(0, veryLargeGCInterval, veryLargeGCWindow, 16)
+	require.NoError(err)
+
+	setupDatastore(ds, require)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	expired := tuple.WithExpiration(tuple.Parse("document:firstdoc#viewer@user:tom"), time.Now().Add(-1*time.Hour))
+	afterExpiredRevision, err := common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, expired)
+	require.NoError(err)
+
+	changes, errchan := ds.Watch(ctx, afterExpiredRevision, datastore.WatchJustRelationships())
+	require.Zero(len(errchan))
+
+	_, err = common.WriteTuples(ctx, ds, core.RelationTupleUpdate_CREATE, tuple.Parse("document:firstdoc#viewer@user:tom"))
+	require.NoError(err)
+
+	VerifyUpdates(require, [][]*core.RelationTupleUpdate{
+		{tuple.Touch(tuple.Parse("document:firstdoc#viewer@user:tom"))},
+	},
+		changes,
+		errchan,
+		false,
+	)
+}
+
 func verifyNoUpdates(
 	require *require.Assertions,
 	changes <-chan *datastore.RevisionChanges,
@@ -667,3 +746,55 @@ func verifyCheckpointUpdate(
 		}
 	}
 }
+
+func WatchWithMetadataTest(t *testing.T, tester DatastoreTester) {
+	require := require.New(t)
+
+	ds, err := tester.New(0, veryLargeGCInterval, veryLargeGCWindow, 16)
+	require.NoError(err)
+
+	setupDatastore(ds, require)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lowestRevision, err := ds.HeadRevision(ctx)
+	require.NoError(err)
+
+	changes, errchan := ds.Watch(ctx, lowestRevision, datastore.WatchJustRelationships())
+	require.Zero(len(errchan))
+
+	metadata, err := structpb.NewStruct(map[string]any{"reason": "ticket-1234", "actor": "tom"})
+	require.NoError(err)
+
+	withMetadataRevision, err := ds.ReadWriteTx(ctx, func(ctx context.Context, rwt datastore.ReadWriteTransaction) error {
+		return rwt.WriteRelationships(ctx, []*core.RelationTupleUpdate{
+			tuple.Touch(tuple.Parse("document:firstdoc#viewer@user:tom")),
+		})
+	}, options.WithMetadata(metadata))
+	require.NoError(err)
+
+	withoutMetadataRevision, err := common.WriteTuples(ctx, ds, core.RelationTupleUpdate_TOUCH, tuple.Parse("document:firstdoc#viewer@user:sarah"))
+	require.NoError(err)
+
+	for _, expected := range []struct {
+		revision datastore.Revision
+		metadata *structpb.Struct
+	}{
+		{withMetadataRevision, metadata},
+		{withoutMetadataRevision, nil},
+	} {
+		changeWait := time.NewTimer(waitForChangesTimeout)
+		select {
+		case change, ok := <-changes:
+			require.True(ok, "unexpected disconnect")
+			require.True(expected.revision.Equal(change.Revision), "unexpected revision %s", change.Revision)
+			require.Len(change.RelationshipChanges, 1)
+			require.True(proto.Equal(expected.metadata, change.Metadata), "unexpected metadata %v", change.Metadata)
+		case err := <-errchan:
+			require.Failf("Failed waiting for changes", "err: %v", err)
+		case <-changeWait.C:
+			require.Fail("Timed out waiting for changes")
+		}
+	}
+}
@@ -260,6 +260,15 @@ func TupleToUserset(tuplesetRelation, usersetRelation string) *core.SetOperation
 	}
 }
 
+// FunctionedTupleToUserset creates a child which first loads all tuples with the specific relation,
+// and then applies the given function over the usersets found by following a relation on those
+// loaded tuples: a union for FUNCTION_ANY and an intersection for FUNCTION_ALL.
+func FunctionedTupleToUserset(tuplesetRelation, usersetRelation string, function core.TupleToUserset_Function) *core.SetOperation_Child {
+	child := TupleToUserset(tuplesetRelation, usersetRelation)
+	child.GetTupleToUserset().Function = function
+	return child
+}
+
 // Rewrite wraps a rewrite as a set operation child of another rewrite.
 func Rewrite(rewrite *core.UsersetRewrite) *core.SetOperation_Child {
 	return &core.SetOperation_Child{
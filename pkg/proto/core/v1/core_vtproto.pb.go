@@ -9,10 +9,12 @@ import (
 	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
 	anypb1 "github.com/planetscale/vtprotobuf/types/known/anypb"
 	structpb1 "github.com/planetscale/vtprotobuf/types/known/structpb"
+	timestamppb1 "github.com/planetscale/vtprotobuf/types/known/timestamppb"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 )
 
@@ -31,6 +33,7 @@ func (m *RelationTuple) CloneVT() *RelationTuple {
 	r.ResourceAndRelation = m.ResourceAndRelation.CloneVT()
 	r.Subject = m.Subject.CloneVT()
 	r.Caveat = m.Caveat.CloneVT()
+	r.OptionalExpirationTime = (*timestamppb.Timestamp)((*timestamppb1.Timestamp)(m.OptionalExpirationTime).CloneVT())
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -739,6 +742,7 @@ func (m *TupleToUserset) CloneVT() *TupleToUserset {
 	r.Tupleset = m.Tupleset.CloneVT()
 	r.ComputedUserset = m.ComputedUserset.CloneVT()
 	r.SourcePosition = m.SourcePosition.CloneVT()
+	r.Function = m.Function
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -865,6 +869,9 @@ func (this *RelationTuple) EqualVT(that *RelationTuple) bool {
 	if !this.Caveat.EqualVT(that.Caveat) {
 		return false
 	}
+	if !(*timestamppb1.Timestamp)(this.OptionalExpirationTime).EqualVT((*timestamppb1.Timestamp)(that.OptionalExpirationTime)) {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1996,6 +2003,9 @@ func (this *TupleToUserset) EqualVT(that *TupleToUserset) bool {
 	if !this.SourcePosition.EqualVT(that.SourcePosition) {
 		return false
 	}
+	if this.Function != that.Function {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -2197,6 +2207,16 @@ func (m *RelationTuple) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.OptionalExpirationTime != nil {
+		size, err := (*timestamppb1.Timestamp)(m.OptionalExpirationTime).MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Caveat != nil {
 		size, err := m.Caveat.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -3945,6 +3965,11 @@ func (m *TupleToUserset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.Function != 0 {
+		i = protohelpers.EncodeVarint(dAtA, i, uint64(m.Function))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.SourcePosition != nil {
 		size, err := m.SourcePosition.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -4224,6 +4249,10 @@ func (m *RelationTuple) SizeVT() (n int) {
 		l = m.Caveat.SizeVT()
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.OptionalExpirationTime != nil {
+		l = (*timestamppb1.Timestamp)(m.OptionalExpirationTime).SizeVT()
+		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -4932,6 +4961,9 @@ func (m *TupleToUserset) SizeVT() (n int) {
 		l = m.SourcePosition.SizeVT()
 		n += 1 + l + protohelpers.SizeOfVarint(uint64(l))
 	}
+	if m.Function != 0 {
+		n += 1 + protohelpers.SizeOfVarint(uint64(m.Function))
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -5166,6 +5198,42 @@ func (m *RelationTuple) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return protohelpers.ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalExpirationTime == nil {
+				m.OptionalExpirationTime = &timestamppb.Timestamp{}
+			}
+			if err := (*timestamppb1.Timestamp)(m.OptionalExpirationTime).UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
@@ -9326,6 +9394,25 @@ func (m *TupleToUserset) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Function", wireType)
+			}
+			m.Function = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protohelpers.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Function |= TupleToUserset_Function(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := protohelpers.Skip(dAtA[iNdEx:])
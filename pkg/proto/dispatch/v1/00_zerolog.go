@@ -12,6 +12,9 @@ func (cr *DispatchCheckRequest) MarshalZerologObject(e *zerolog.Event) {
 	e.Str("resource-type", tuple.StringRR(cr.ResourceRelation))
 	e.Str("subject", tuple.StringONR(cr.Subject))
 	e.Array("resource-ids", strArray(cr.ResourceIds))
+	if len(cr.AdditionalSubjects) > 0 {
+		e.Int("additional-subjects", len(cr.AdditionalSubjects))
+	}
 }
 
 // MarshalZerologObject implements zerolog object marshalling.
@@ -0,0 +1,16 @@
+package dispatchv1
+
+// HasSubjectOfType returns whether the subject or any additional subject of the check request
+// is of the given type.
+func (cr *DispatchCheckRequest) HasSubjectOfType(namespaceName string) bool {
+	if cr.Subject.Namespace == namespaceName {
+		return true
+	}
+
+	for _, subject := range cr.AdditionalSubjects {
+		if subject.Namespace == namespaceName {
+			return true
+		}
+	}
+	return false
+}
@@ -181,6 +181,42 @@ func (m *DispatchCheckRequest) validate(all bool) error {
 
 	// no validation rules for Debug
 
+	for idx, item := range m.GetAdditionalSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DispatchCheckRequestValidationError{
+						field:  fmt.Sprintf("AdditionalSubjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DispatchCheckRequestValidationError{
+						field:  fmt.Sprintf("AdditionalSubjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DispatchCheckRequestValidationError{
+					field:  fmt.Sprintf("AdditionalSubjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AllowWildcardSubject
+
 	if len(errors) > 0 {
 		return DispatchCheckRequestMultiError(errors)
 	}
@@ -358,6 +394,52 @@ func (m *DispatchCheckResponse) validate(all bool) error {
 		}
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetResultsBySubject()))
+		i := 0
+		for key := range m.GetResultsBySubject() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetResultsBySubject()[key]
+			_ = val
+
+			// no validation rules for ResultsBySubject[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, DispatchCheckResponseValidationError{
+							field:  fmt.Sprintf("ResultsBySubject[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, DispatchCheckResponseValidationError{
+							field:  fmt.Sprintf("ResultsBySubject[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return DispatchCheckResponseValidationError{
+						field:  fmt.Sprintf("ResultsBySubject[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return DispatchCheckResponseMultiError(errors)
 	}
@@ -438,6 +520,154 @@ var _ interface {
 	ErrorName() string
 } = DispatchCheckResponseValidationError{}
 
+// Validate checks the field values on SubjectCheckResults with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubjectCheckResults) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubjectCheckResults with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubjectCheckResultsMultiError, or nil if none found.
+func (m *SubjectCheckResults) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubjectCheckResults) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetResultsByResourceId()))
+		i := 0
+		for key := range m.GetResultsByResourceId() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetResultsByResourceId()[key]
+			_ = val
+
+			// no validation rules for ResultsByResourceId[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SubjectCheckResultsValidationError{
+							field:  fmt.Sprintf("ResultsByResourceId[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SubjectCheckResultsValidationError{
+							field:  fmt.Sprintf("ResultsByResourceId[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SubjectCheckResultsValidationError{
+						field:  fmt.Sprintf("ResultsByResourceId[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubjectCheckResultsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectCheckResultsMultiError is an error wrapping multiple validation
+// errors returned by SubjectCheckResults.ValidateAll() if the designated
+// constraints aren't met.
+type SubjectCheckResultsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectCheckResultsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectCheckResultsMultiError) AllErrors() []error { return m }
+
+// SubjectCheckResultsValidationError is the validation error returned by
+// SubjectCheckResults.Validate if the designated constraints aren't met.
+type SubjectCheckResultsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectCheckResultsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectCheckResultsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectCheckResultsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectCheckResultsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectCheckResultsValidationError) ErrorName() string {
+	return "SubjectCheckResultsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubjectCheckResultsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjectCheckResults.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectCheckResultsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectCheckResultsValidationError{}
+
 // Validate checks the field values on ResourceCheckResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -675,6 +905,39 @@ func (m *DispatchExpandRequest) validate(all bool) error {
 
 	// no validation rules for ExpansionMode
 
+	// no validation rules for OptionalMaxDepth
+
+	// no validation rules for OptionalSubjectLimit
+
+	if all {
+		switch v := interface{}(m.GetOptionalCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DispatchExpandRequestValidationError{
+					field:  "OptionalCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DispatchExpandRequestValidationError{
+					field:  "OptionalCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptionalCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DispatchExpandRequestValidationError{
+				field:  "OptionalCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DispatchExpandRequestMultiError(errors)
 	}
@@ -835,6 +1098,40 @@ func (m *DispatchExpandResponse) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetTruncatedNodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DispatchExpandResponseValidationError{
+						field:  fmt.Sprintf("TruncatedNodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DispatchExpandResponseValidationError{
+						field:  fmt.Sprintf("TruncatedNodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DispatchExpandResponseValidationError{
+					field:  fmt.Sprintf("TruncatedNodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return DispatchExpandResponseMultiError(errors)
 	}
@@ -886,7 +1183,167 @@ func (e DispatchExpandResponseValidationError) ErrorName() string {
 }
 
 // Error satisfies the builtin error interface
-func (e DispatchExpandResponseValidationError) Error() string {
+func (e DispatchExpandResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDispatchExpandResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DispatchExpandResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DispatchExpandResponseValidationError{}
+
+// Validate checks the field values on TruncatedExpandNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TruncatedExpandNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TruncatedExpandNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TruncatedExpandNodeMultiError, or nil if none found.
+func (m *TruncatedExpandNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TruncatedExpandNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExpanded()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TruncatedExpandNodeValidationError{
+					field:  "Expanded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TruncatedExpandNodeValidationError{
+					field:  "Expanded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpanded()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TruncatedExpandNodeValidationError{
+				field:  "Expanded",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptionalCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TruncatedExpandNodeValidationError{
+					field:  "OptionalCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TruncatedExpandNodeValidationError{
+					field:  "OptionalCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptionalCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TruncatedExpandNodeValidationError{
+				field:  "OptionalCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TruncatedExpandNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TruncatedExpandNodeMultiError is an error wrapping multiple validation
+// errors returned by TruncatedExpandNode.ValidateAll() if the designated
+// constraints aren't met.
+type TruncatedExpandNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TruncatedExpandNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TruncatedExpandNodeMultiError) AllErrors() []error { return m }
+
+// TruncatedExpandNodeValidationError is the validation error returned by
+// TruncatedExpandNode.Validate if the designated constraints aren't met.
+type TruncatedExpandNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TruncatedExpandNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TruncatedExpandNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TruncatedExpandNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TruncatedExpandNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TruncatedExpandNodeValidationError) ErrorName() string {
+	return "TruncatedExpandNodeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TruncatedExpandNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -898,14 +1355,14 @@ func (e DispatchExpandResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDispatchExpandResponse.%s: %s%s",
+		"invalid %sTruncatedExpandNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DispatchExpandResponseValidationError{}
+var _ error = TruncatedExpandNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -913,7 +1370,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DispatchExpandResponseValidationError{}
+} = TruncatedExpandNodeValidationError{}
 
 // Validate checks the field values on Cursor with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -1771,6 +2228,8 @@ func (m *DispatchLookupResourcesRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for WildcardSetting
+
 	if len(errors) > 0 {
 		return DispatchLookupResourcesRequestMultiError(errors)
 	}
@@ -1878,6 +2337,8 @@ func (m *ResolvedResource) validate(all bool) error {
 
 	// no validation rules for Permissionship
 
+	// no validation rules for GrantedByWildcard
+
 	if len(errors) > 0 {
 		return ResolvedResourceMultiError(errors)
 	}
@@ -2288,6 +2749,37 @@ func (m *DispatchLookupSubjectsRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetOptionalCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DispatchLookupSubjectsRequestValidationError{
+					field:  "OptionalCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DispatchLookupSubjectsRequestValidationError{
+					field:  "OptionalCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptionalCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DispatchLookupSubjectsRequestValidationError{
+				field:  "OptionalCursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OptionalLimit
+
 	if len(errors) > 0 {
 		return DispatchLookupSubjectsRequestMultiError(errors)
 	}
@@ -3406,6 +3898,40 @@ func (m *CheckDebugTrace) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetPlanDecisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckDebugTraceValidationError{
+						field:  fmt.Sprintf("PlanDecisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckDebugTraceValidationError{
+						field:  fmt.Sprintf("PlanDecisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckDebugTraceValidationError{
+					field:  fmt.Sprintf("PlanDecisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CheckDebugTraceMultiError(errors)
 	}
@@ -3483,3 +4009,257 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CheckDebugTraceValidationError{}
+
+// Validate checks the field values on CheckPlanDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckPlanDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckPlanDecision with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckPlanDecisionMultiError, or nil if none found.
+func (m *CheckPlanDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckPlanDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Operation
+
+	// no validation rules for Strategy
+
+	for idx, item := range m.GetBranches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckPlanDecisionValidationError{
+						field:  fmt.Sprintf("Branches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckPlanDecisionValidationError{
+						field:  fmt.Sprintf("Branches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckPlanDecisionValidationError{
+					field:  fmt.Sprintf("Branches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ShortCircuited
+
+	if len(errors) > 0 {
+		return CheckPlanDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckPlanDecisionMultiError is an error wrapping multiple validation errors
+// returned by CheckPlanDecision.ValidateAll() if the designated constraints
+// aren't met.
+type CheckPlanDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckPlanDecisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckPlanDecisionMultiError) AllErrors() []error { return m }
+
+// CheckPlanDecisionValidationError is the validation error returned by
+// CheckPlanDecision.Validate if the designated constraints aren't met.
+type CheckPlanDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckPlanDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckPlanDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckPlanDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckPlanDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckPlanDecisionValidationError) ErrorName() string {
+	return "CheckPlanDecisionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckPlanDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckPlanDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckPlanDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckPlanDecisionValidationError{}
+
+// Validate checks the field values on CheckPlannedBranch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckPlannedBranch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckPlannedBranch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckPlannedBranchMultiError, or nil if none found.
+func (m *CheckPlannedBranch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckPlannedBranch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for SchemaIndex
+
+	// no validation rules for EstimatedCost
+
+	// no validation rules for ObservedCost
+
+	// no validation rules for Skipped
+
+	if len(errors) > 0 {
+		return CheckPlannedBranchMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckPlannedBranchMultiError is an error wrapping multiple validation errors
+// returned by CheckPlannedBranch.ValidateAll() if the designated constraints
+// aren't met.
+type CheckPlannedBranchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckPlannedBranchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckPlannedBranchMultiError) AllErrors() []error { return m }
+
+// CheckPlannedBranchValidationError is the validation error returned by
+// CheckPlannedBranch.Validate if the designated constraints aren't met.
+type CheckPlannedBranchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckPlannedBranchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckPlannedBranchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckPlannedBranchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckPlannedBranchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckPlannedBranchValidationError) ErrorName() string {
+	return "CheckPlannedBranchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckPlannedBranchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckPlannedBranch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckPlannedBranchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckPlannedBranchValidationError{}
@@ -74,19 +74,39 @@ func Compile(schema InputSchema, prefix ObjectPrefixOption, opts ...Option) (*Co
 	}
 
 	mapper := newPositionMapper(schema)
+	root, err := parseSchema(schema, mapper)
+	if err != nil {
+		return nil, err
+	}
+
+	// Imports can only be resolved when compiling from a filesystem.
+	imports := root.FindAll(dslshape.NodeTypeImport)
+	if len(imports) > 0 {
+		return nil, toContextError("import statements are only supported when compiling from a filesystem", "import", imports[0], mapper)
+	}
+
+	return translateRoots(cfg, mapper, schema.SchemaString, root)
+}
+
+// parseSchema parses the input schema, returning the root node or the first error found.
+func parseSchema(schema InputSchema, mapper input.PositionMapper) (*dslNode, error) {
 	root := parser.Parse(createAstNode, schema.Source, schema.SchemaString).(*dslNode)
 	errs := root.FindAll(dslshape.NodeTypeError)
 	if len(errs) > 0 {
-		err := errorNodeToError(errs[0], mapper)
-		return nil, err
+		return nil, errorNodeToError(errs[0], mapper)
 	}
 
+	return root, nil
+}
+
+// translateRoots translates the definitions found under the given parsed root nodes, in order.
+func translateRoots(cfg *config, mapper input.PositionMapper, schemaString string, roots ...*dslNode) (*CompiledSchema, error) {
 	compiled, err := translate(translationContext{
 		objectTypePrefix: cfg.objectTypePrefix,
 		mapper:           mapper,
-		schemaString:     schema.SchemaString,
+		schemaString:     schemaString,
 		skipValidate:     cfg.skipValidation,
-	}, root)
+	}, roots...)
 	if err != nil {
 		var errorWithNode errorWithNode
 		if errors.As(err, &errorWithNode) {
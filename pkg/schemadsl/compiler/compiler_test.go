@@ -71,6 +71,27 @@ func TestCompile(t *testing.T) {
 				namespace.Namespace("sometenant/def"),
 			},
 		},
+		{
+			"relation and permission named import",
+			withTenantPrefix,
+			`definition simple {
+				relation import: bar;
+				permission view = import;
+			}`,
+			"",
+			[]SchemaDefinition{
+				namespace.Namespace("sometenant/simple",
+					namespace.MustRelation("import", nil,
+						namespace.AllowedRelation("sometenant/bar", "..."),
+					),
+					namespace.MustRelation("view",
+						namespace.Union(
+							namespace.ComputedUserset("import"),
+						),
+					),
+				),
+			},
+		},
 		{
 			"simple def",
 			withTenantPrefix,
@@ -357,6 +378,53 @@ func TestCompile(t *testing.T) {
 			},
 		},
 
+		{
+			"any arrow permission",
+			withTenantPrefix,
+			`definition arrowed {
+				permission foos = bars.any(bazs)
+			}`,
+			"",
+			[]SchemaDefinition{
+				namespace.Namespace("sometenant/arrowed",
+					namespace.MustRelation("foos",
+						namespace.Union(
+							namespace.FunctionedTupleToUserset("bars", "bazs", core.TupleToUserset_FUNCTION_ANY),
+						),
+					),
+				),
+			},
+		},
+
+		{
+			"all arrow permission",
+			withTenantPrefix,
+			`definition arrowed {
+				permission foos = bars.all(bazs) + bars->bazs
+			}`,
+			"",
+			[]SchemaDefinition{
+				namespace.Namespace("sometenant/arrowed",
+					namespace.MustRelation("foos",
+						namespace.Union(
+							namespace.FunctionedTupleToUserset("bars", "bazs", core.TupleToUserset_FUNCTION_ALL),
+							namespace.TupleToUserset("bars", "bazs"),
+						),
+					),
+				),
+			},
+		},
+
+		{
+			"unknown arrow function",
+			withTenantPrefix,
+			`definition arrowed {
+				permission foos = bars.some(bazs)
+			}`,
+			"parse error in `unknown arrow function`, line 2, column 32: Expected 'any' or 'all' for arrow function, found: some",
+			[]SchemaDefinition{},
+		},
+
 		{
 			"multiarrow permission",
 			withTenantPrefix,
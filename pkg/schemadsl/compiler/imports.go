@@ -0,0 +1,139 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/authzed/spicedb/pkg/schemadsl/dslshape"
+	"github.com/authzed/spicedb/pkg/schemadsl/input"
+)
+
+// CompileFromFile compiles the schema file found at the given path on the local filesystem, along
+// with all schema files it imports. Imports are resolved relative to the importing file, and cannot
+// reference files outside of the directory containing the root schema file.
+func CompileFromFile(filePath string, prefix ObjectPrefixOption, opts ...Option) (*CompiledSchema, error) {
+	return CompileFromFS(os.DirFS(filepath.Dir(filePath)), filepath.Base(filePath), prefix, opts...)
+}
+
+// CompileFromFS compiles the schema file found at the given path in the filesystem, along with all
+// schema files it imports. Imports are resolved relative to the importing file.
+//
+// Each imported file is compiled at most once, with its definitions placed before those of the
+// first file importing it. Import cycles and definitions reused across files are reported as errors
+// against the file in which they occur.
+func CompileFromFS(fsys fs.FS, filePath string, prefix ObjectPrefixOption, opts ...Option) (*CompiledSchema, error) {
+	cfg := &config{}
+	prefix(cfg) // required option
+
+	for _, fn := range opts {
+		fn(cfg)
+	}
+
+	filePath = path.Clean(filePath)
+	if !fs.ValidPath(filePath) {
+		return nil, fmt.Errorf("invalid schema file path `%s`", filePath)
+	}
+
+	ir := &importResolver{
+		fsys:     fsys,
+		mapper:   newPositionMapper(),
+		resolved: map[string]struct{}{},
+	}
+
+	if err := ir.resolve(filePath, nil); err != nil {
+		return nil, err
+	}
+
+	return translateRoots(cfg, ir.mapper, "", ir.roots...)
+}
+
+// importResolver loads and parses schema files and their imports from a filesystem.
+type importResolver struct {
+	fsys   fs.FS
+	mapper *positionMapper
+
+	// roots holds the parsed root nodes of all files, in dependency order.
+	roots []*dslNode
+
+	// resolved holds the paths of all files which have been fully resolved.
+	resolved map[string]struct{}
+
+	// importStack holds the paths of the files currently being resolved.
+	importStack []string
+}
+
+// resolve loads, parses and resolves the imports of the file at the given path. importNode is the
+// import statement which referenced the file, if any.
+func (ir *importResolver) resolve(filePath string, importNode *dslNode) error {
+	if _, ok := ir.resolved[filePath]; ok {
+		return nil
+	}
+
+	for index, stackPath := range ir.importStack {
+		if stackPath == filePath {
+			cycle := append(append([]string{}, ir.importStack[index:]...), filePath)
+			return ir.importError(importNode, "import cycle detected: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	contents, err := fs.ReadFile(ir.fsys, filePath)
+	if err != nil {
+		if importNode == nil {
+			return fmt.Errorf("could not read schema file `%s`: %w", filePath, err)
+		}
+
+		if errors.Is(err, fs.ErrNotExist) {
+			return ir.importError(importNode, "imported file `%s` not found", filePath)
+		}
+
+		return ir.importError(importNode, "could not read imported file `%s`: %s", filePath, err)
+	}
+
+	schema := InputSchema{
+		Source:       input.Source(filePath),
+		SchemaString: string(contents),
+	}
+	ir.mapper.addSchema(schema)
+
+	root, err := parseSchema(schema, ir.mapper)
+	if err != nil {
+		return err
+	}
+
+	ir.importStack = append(ir.importStack, filePath)
+	for _, child := range root.GetChildren() {
+		if child.GetType() != dslshape.NodeTypeImport {
+			continue
+		}
+
+		importPath, err := child.GetString(dslshape.NodeImportPredicatePath)
+		if err != nil {
+			return ir.importError(child, "invalid import: %s", err)
+		}
+
+		// Imports are relative to the directory of the importing file.
+		resolvedPath := path.Join(path.Dir(filePath), importPath)
+		if path.IsAbs(importPath) || !fs.ValidPath(resolvedPath) {
+			return ir.importError(child, "import path `%s` must be relative and within the schema directory", importPath)
+		}
+
+		if err := ir.resolve(resolvedPath, child); err != nil {
+			return err
+		}
+	}
+	ir.importStack = ir.importStack[:len(ir.importStack)-1]
+
+	ir.resolved[filePath] = struct{}{}
+	ir.roots = append(ir.roots, root)
+	return nil
+}
+
+func (ir *importResolver) importError(importNode *dslNode, message string, args ...any) error {
+	importPath, _ := importNode.GetString(dslshape.NodeImportPredicatePath)
+	return toContextError(fmt.Sprintf(message, args...), importPath, importNode, ir.mapper)
+}
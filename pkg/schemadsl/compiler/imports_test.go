@@ -0,0 +1,221 @@
+package compiler
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/authzed/spicedb/pkg/schemadsl/input"
+)
+
+func TestCompileFromFS(t *testing.T) {
+	type importTest struct {
+		name            string
+		files           map[string]string
+		expectedError   string
+		expectedSource  string
+		expectedDefined []string
+	}
+
+	tests := []importTest{
+		{
+			"no imports",
+			map[string]string{
+				"schema.zed": `definition user {}`,
+			},
+			"",
+			"",
+			[]string{"user"},
+		},
+		{
+			"single import",
+			map[string]string{
+				"schema.zed": `import "user.zed"
+
+				definition document {
+					relation viewer: user
+				}`,
+				"user.zed": `definition user {}`,
+			},
+			"",
+			"",
+			[]string{"user", "document"},
+		},
+		{
+			"nested relative imports",
+			map[string]string{
+				"schema.zed": `import "teams/docs.zed"
+
+				definition folder {
+					relation viewer: user
+				}`,
+				"teams/docs.zed": `import "../common/user.zed"
+
+				definition document {
+					relation viewer: user
+				}`,
+				"common/user.zed": `definition user {}`,
+			},
+			"",
+			"",
+			[]string{"user", "document", "folder"},
+		},
+		{
+			"diamond imports",
+			map[string]string{
+				"schema.zed": `import "a.zed"
+				import "b.zed"`,
+				"a.zed": `import "user.zed"
+				definition adoc {
+					relation viewer: user
+				}`,
+				"b.zed": `import "user.zed"
+				definition bdoc {
+					relation viewer: user
+				}`,
+				"user.zed": `definition user {}`,
+			},
+			"",
+			"",
+			[]string{"user", "adoc", "bdoc"},
+		},
+		{
+			"import cycle",
+			map[string]string{
+				"schema.zed": `import "a.zed"`,
+				"a.zed":      `import "b.zed"`,
+				"b.zed":      `import "a.zed"`,
+			},
+			"parse error in `b.zed`, line 1, column 1: import cycle detected: a.zed -> b.zed -> a.zed",
+			"b.zed",
+			nil,
+		},
+		{
+			"self import",
+			map[string]string{
+				"schema.zed": `import "schema.zed"`,
+			},
+			"parse error in `schema.zed`, line 1, column 1: import cycle detected: schema.zed -> schema.zed",
+			"schema.zed",
+			nil,
+		},
+		{
+			"missing import",
+			map[string]string{
+				"schema.zed": `definition user {}
+import "missing.zed"`,
+			},
+			"parse error in `schema.zed`, line 2, column 1: imported file `missing.zed` not found",
+			"schema.zed",
+			nil,
+		},
+		{
+			"import outside of directory",
+			map[string]string{
+				"schema.zed": `import "../outside.zed"`,
+			},
+			"parse error in `schema.zed`, line 1, column 1: import path `../outside.zed` must be relative and within the schema directory",
+			"schema.zed",
+			nil,
+		},
+		{
+			"duplicate definition across files",
+			map[string]string{
+				"schema.zed": `import "user.zed"
+
+definition user {}`,
+				"user.zed": `definition user {}`,
+			},
+			"parse error in `schema.zed`, line 3, column 1: found name reused between multiple definitions and/or caveats: user",
+			"schema.zed",
+			nil,
+		},
+		{
+			"parse error in imported file",
+			map[string]string{
+				"schema.zed": `import "user.zed"`,
+				"user.zed": `definition user {}
+
+definition broken {
+	relation foo
+}`,
+			},
+			"parse error in `user.zed`, line 4, column 14",
+			"user.zed",
+			nil,
+		},
+		{
+			"invalid relation in imported file",
+			map[string]string{
+				"schema.zed": `import "document.zed"`,
+				"document.zed": `definition document {
+	relation viewer: user#
+}`,
+			},
+			"parse error in `document.zed`, line 3, column 1",
+			"document.zed",
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			fsys := fstest.MapFS{}
+			for path, contents := range test.files {
+				fsys[path] = &fstest.MapFile{Data: []byte(contents)}
+			}
+
+			compiled, err := CompileFromFS(fsys, "schema.zed", AllowUnprefixedObjectType())
+			if test.expectedError != "" {
+				require.Error(err)
+				require.Contains(err.Error(), test.expectedError)
+
+				var errWithContext ErrorWithContext
+				require.True(errors.As(err, &errWithContext))
+				require.Equal(input.Source(test.expectedSource), errWithContext.Source)
+				require.Equal(input.Source(test.expectedSource), errWithContext.SourceRange.Source())
+				return
+			}
+
+			require.NoError(err)
+
+			defined := make([]string, 0, len(compiled.OrderedDefinitions))
+			for _, def := range compiled.OrderedDefinitions {
+				defined = append(defined, def.GetName())
+			}
+			require.Equal(test.expectedDefined, defined)
+		})
+	}
+}
+
+func TestCompileFromFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "common"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "common", "user.zed"), []byte(`definition user {}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schema.zed"), []byte(`import "common/user.zed"
+
+definition document {
+	relation viewer: user
+}`), 0o600))
+
+	compiled, err := CompileFromFile(filepath.Join(dir, "schema.zed"), AllowUnprefixedObjectType())
+	require.NoError(t, err)
+	require.Len(t, compiled.ObjectDefinitions, 2)
+	require.Equal(t, "user", compiled.ObjectDefinitions[0].Name)
+	require.Equal(t, "document", compiled.ObjectDefinitions[1].Name)
+}
+
+func TestCompileRejectsImports(t *testing.T) {
+	_, err := Compile(InputSchema{
+		Source:       input.Source("schema"),
+		SchemaString: `import "user.zed"`,
+	}, AllowUnprefixedObjectType())
+	require.ErrorContains(t, err, "parse error in `schema`, line 1, column 1: import statements are only supported when compiling from a filesystem")
+}
@@ -1,32 +1,69 @@
 package compiler
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/authzed/spicedb/pkg/schemadsl/input"
 )
 
 type positionMapper struct {
-	schema InputSchema
-	mapper input.SourcePositionMapper
+	schemas map[input.Source]InputSchema
+	mappers map[input.Source]input.SourcePositionMapper
 }
 
-func newPositionMapper(schema InputSchema) input.PositionMapper {
-	return &positionMapper{
-		schema: schema,
-		mapper: input.CreateSourcePositionMapper([]byte(schema.SchemaString)),
+func newPositionMapper(schemas ...InputSchema) *positionMapper {
+	pm := &positionMapper{
+		schemas: make(map[input.Source]InputSchema, len(schemas)),
+		mappers: make(map[input.Source]input.SourcePositionMapper, len(schemas)),
 	}
+
+	for _, schema := range schemas {
+		pm.addSchema(schema)
+	}
+
+	return pm
 }
 
-func (pm *positionMapper) RunePositionToLineAndCol(runePosition int, _ input.Source) (int, int, error) {
-	return pm.mapper.RunePositionToLineAndCol(runePosition)
+// addSchema adds the given schema to the mapper, so that positions in its source can be mapped.
+func (pm *positionMapper) addSchema(schema InputSchema) {
+	pm.schemas[schema.Source] = schema
+	pm.mappers[schema.Source] = input.CreateSourcePositionMapper([]byte(schema.SchemaString))
 }
 
-func (pm *positionMapper) LineAndColToRunePosition(lineNumber int, colPosition int, _ input.Source) (int, error) {
-	return pm.mapper.LineAndColToRunePosition(lineNumber, colPosition)
+func (pm *positionMapper) mapperFor(path input.Source) (input.SourcePositionMapper, error) {
+	mapper, ok := pm.mappers[path]
+	if !ok {
+		return input.SourcePositionMapper{}, fmt.Errorf("unknown source `%s`", path)
+	}
+
+	return mapper, nil
 }
 
-func (pm *positionMapper) TextForLine(lineNumber int, _ input.Source) (string, error) {
-	lines := strings.Split(pm.schema.SchemaString, "\n")
+func (pm *positionMapper) RunePositionToLineAndCol(runePosition int, path input.Source) (int, int, error) {
+	mapper, err := pm.mapperFor(path)
+	if err != nil {
+		return -1, -1, err
+	}
+
+	return mapper.RunePositionToLineAndCol(runePosition)
+}
+
+func (pm *positionMapper) LineAndColToRunePosition(lineNumber int, colPosition int, path input.Source) (int, error) {
+	mapper, err := pm.mapperFor(path)
+	if err != nil {
+		return -1, err
+	}
+
+	return mapper.LineAndColToRunePosition(lineNumber, colPosition)
+}
+
+func (pm *positionMapper) TextForLine(lineNumber int, path input.Source) (string, error) {
+	schema, ok := pm.schemas[path]
+	if !ok {
+		return "", fmt.Errorf("unknown source `%s`", path)
+	}
+
+	lines := strings.Split(schema.SchemaString, "\n")
 	return lines[lineNumber], nil
 }
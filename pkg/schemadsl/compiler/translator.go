@@ -42,14 +42,19 @@ func (tctx translationContext) prefixedPath(definitionName string) (string, erro
 
 const Ellipsis = "..."
 
-func translate(tctx translationContext, root *dslNode) (*CompiledSchema, error) {
-	orderedDefinitions := make([]SchemaDefinition, 0, len(root.GetChildren()))
+func translate(tctx translationContext, roots ...*dslNode) (*CompiledSchema, error) {
+	var definitionNodes []*dslNode
+	for _, root := range roots {
+		definitionNodes = append(definitionNodes, root.GetChildren()...)
+	}
+
+	orderedDefinitions := make([]SchemaDefinition, 0, len(definitionNodes))
 	var objectDefinitions []*core.NamespaceDefinition
 	var caveatDefinitions []*core.CaveatDefinition
 
 	names := mapz.NewSet[string]()
 
-	for _, definitionNode := range root.GetChildren() {
+	for _, definitionNode := range definitionNodes {
 		var definition SchemaDefinition
 
 		switch definitionNode.GetType() {
@@ -70,6 +75,10 @@ func translate(tctx translationContext, root *dslNode) (*CompiledSchema, error)
 
 			definition = def
 			objectDefinitions = append(objectDefinitions, def)
+
+		default:
+			// Imports are resolved before translation.
+			continue
 		}
 
 		if !names.Add(definition.GetName()) {
@@ -517,7 +526,25 @@ func translateExpressionOperationDirect(tctx translationContext, expressionOpNod
 			return nil, err
 		}
 
-		return namespace.TupleToUserset(tuplesetRelation, usersetRelation), nil
+		if !expressionOpNode.Has(dslshape.NodeArrowExpressionFunctionName) {
+			return namespace.TupleToUserset(tuplesetRelation, usersetRelation), nil
+		}
+
+		functionName, err := expressionOpNode.GetString(dslshape.NodeArrowExpressionFunctionName)
+		if err != nil {
+			return nil, err
+		}
+
+		switch functionName {
+		case "any":
+			return namespace.FunctionedTupleToUserset(tuplesetRelation, usersetRelation, core.TupleToUserset_FUNCTION_ANY), nil
+
+		case "all":
+			return namespace.FunctionedTupleToUserset(tuplesetRelation, usersetRelation, core.TupleToUserset_FUNCTION_ALL), nil
+
+		default:
+			return nil, expressionOpNode.Errorf("Unknown arrow function `%s`", functionName)
+		}
 
 	case dslshape.NodeTypeUnionExpression:
 		fallthrough
@@ -14,6 +14,7 @@ const (
 
 	NodeTypeDefinition       // A definition.
 	NodeTypeCaveatDefinition // A caveat definition.
+	NodeTypeImport           // An import of another schema file.
 
 	NodeTypeCaveatParameter // A caveat parameter.
 	NodeTypeCaveatExpession // A caveat expression.
@@ -78,6 +79,13 @@ const (
 	// The name of the definition
 	NodeDefinitionPredicateName = "definition-name"
 
+	//
+	// NodeTypeImport
+	//
+
+	// The path of the imported schema file.
+	NodeImportPredicatePath = "import-path"
+
 	//
 	// NodeTypeCaveatDefinition
 	//
@@ -181,4 +189,11 @@ const (
 	//
 	NodeExpressionPredicateLeftExpr  = "left-expr"
 	NodeExpressionPredicateRightExpr = "right-expr"
+
+	//
+	// NodeTypeArrowExpression
+	//
+
+	// The name of the function applied by the arrow, if any (`any` or `all`).
+	NodeArrowExpressionFunctionName = "function-name"
 )
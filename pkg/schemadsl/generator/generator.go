@@ -89,6 +89,23 @@ func GenerateSource(namespace *core.NamespaceDefinition) (string, bool, error) {
 	return generator.buf.String(), !generator.hasIssue, nil
 }
 
+// GenerateRelationSource generates a DSL view of the given relation or permission.
+func GenerateRelationSource(relation *core.Relation) (string, bool, error) {
+	generator := &sourceGenerator{
+		indentationLevel: 0,
+		hasNewline:       true,
+		hasBlankline:     true,
+		hasNewScope:      true,
+	}
+
+	err := generator.emitRelation(relation)
+	if err != nil {
+		return "", false, err
+	}
+
+	return strings.TrimSpace(generator.buf.String()), !generator.hasIssue, nil
+}
+
 func (sg *sourceGenerator) emitCaveat(caveat *core.CaveatDefinition) error {
 	sg.emitComments(caveat.Metadata)
 	sg.append("caveat ")
@@ -289,8 +306,22 @@ func (sg *sourceGenerator) emitSetOpChild(setOpChild *core.SetOperation_Child) {
 
 	case *core.SetOperation_Child_TupleToUserset:
 		sg.append(child.TupleToUserset.Tupleset.Relation)
-		sg.append("->")
-		sg.append(child.TupleToUserset.ComputedUserset.Relation)
+
+		switch child.TupleToUserset.Function {
+		case core.TupleToUserset_FUNCTION_ANY:
+			sg.append(".any(")
+			sg.append(child.TupleToUserset.ComputedUserset.Relation)
+			sg.append(")")
+
+		case core.TupleToUserset_FUNCTION_ALL:
+			sg.append(".all(")
+			sg.append(child.TupleToUserset.ComputedUserset.Relation)
+			sg.append(")")
+
+		default:
+			sg.append("->")
+			sg.append(child.TupleToUserset.ComputedUserset.Relation)
+		}
 	}
 }
 
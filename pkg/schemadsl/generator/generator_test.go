@@ -78,6 +78,51 @@ caveat somecaveat(someParam int) {
 	}
 }
 
+func TestGenerateRelation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *core.Relation
+		expected string
+		okay     bool
+	}{
+		{
+			"relation",
+			namespace.MustRelation("viewer", nil,
+				namespace.AllowedRelation("user", "..."),
+				namespace.AllowedRelation("group", "member"),
+			),
+			"relation viewer: user | group#member",
+			true,
+		},
+		{
+			"permission",
+			namespace.MustRelation("view", namespace.Union(
+				namespace.ComputedUserset("viewer"),
+				namespace.TupleToUserset("parent", "view"),
+			)),
+			"permission view = viewer + parent->view",
+			true,
+		},
+		{
+			"missing types",
+			namespace.MustRelation("viewer", nil),
+			"relation viewer: /* missing allowed types */",
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			source, ok, err := GenerateRelationSource(test.input)
+			require.NoError(err)
+			require.Equal(test.expected, source)
+			require.Equal(test.okay, ok)
+		})
+	}
+}
+
 func TestGenerateNamespace(t *testing.T) {
 	type generatorTest struct {
 		name     string
@@ -151,6 +196,19 @@ func TestGenerateNamespace(t *testing.T) {
 			),
 			`definition foos/test {
 	permission someperm = (rela - relb - rely->relz - nil) + relc
+}`,
+			true,
+		},
+		{
+			"permission with arrow functions",
+			namespace.Namespace("foos/test",
+				namespace.MustRelation("someperm", namespace.Union(
+					namespace.FunctionedTupleToUserset("rely", "relz", core.TupleToUserset_FUNCTION_ANY),
+					namespace.FunctionedTupleToUserset("rely", "relz", core.TupleToUserset_FUNCTION_ALL),
+				)),
+			),
+			`definition foos/test {
+	permission someperm = rely.any(relz) + rely.all(relz)
 }`,
 			true,
 		},
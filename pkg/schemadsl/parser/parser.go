@@ -51,11 +51,18 @@ Loop:
 			break Loop
 		}
 
-		// The top level of the DSL is a set of definitions and caveats:
+		// The top level of the DSL is a set of imports, definitions and caveats:
+		// import "path/to/file.zed"
 		// definition foobar { ... }
 		// caveat somecaveat (...) { ... }
+		//
+		// NOTE: `import` is not a reserved keyword, so that it remains usable as the name of
+		// a relation or permission; it is only recognized at the start of a top-level statement.
 
 		switch {
+		case p.isIdentifier("import"):
+			rootNode.Connect(dslshape.NodePredicateChild, p.consumeImport())
+
 		case p.isKeyword("definition"):
 			rootNode.Connect(dslshape.NodePredicateChild, p.consumeDefinition())
 
@@ -71,6 +78,35 @@ Loop:
 	return rootNode
 }
 
+// consumeImport attempts to consume a single import statement.
+// ```import "path/to/file.zed"```
+func (p *sourceParser) consumeImport() AstNode {
+	importNode := p.startNode(dslshape.NodeTypeImport)
+	defer p.mustFinishNode()
+
+	// import ...
+	p.consumeToken()
+	pathToken, ok := p.consume(lexer.TokenTypeString)
+	if !ok {
+		return importNode
+	}
+
+	// Only single-line, double-quoted paths are supported.
+	if len(pathToken.Value) < 2 || !strings.HasPrefix(pathToken.Value, `"`) || strings.HasPrefix(pathToken.Value, `"""`) {
+		p.emitErrorf("Expected double-quoted path for import, found: %s", pathToken.Value)
+		return importNode
+	}
+
+	importPath := pathToken.Value[1 : len(pathToken.Value)-1]
+	if len(importPath) == 0 {
+		p.emitErrorf("Expected non-empty path for import")
+		return importNode
+	}
+
+	importNode.MustDecorate(dslshape.NodeImportPredicatePath, importPath)
+	return importNode
+}
+
 // consumeCaveat attempts to consume a single caveat definition.
 // ```caveat somecaveat(param1 type, param2 type) { ... }```
 func (p *sourceParser) consumeCaveat() AstNode {
@@ -476,8 +512,14 @@ func (p *sourceParser) tryConsumeComputeExpression(subTryExprFn tryParserFn, bin
 
 // tryConsumeArrowExpression attempts to consume an arrow expression.
 // ```foo->bar->baz->meh```
+// ```foo.any(bar)```
+// ```foo.all(bar)```
 func (p *sourceParser) tryConsumeArrowExpression() (AstNode, bool) {
 	rightNodeBuilder := func(leftNode AstNode, operatorToken lexer.Lexeme) (AstNode, bool) {
+		if operatorToken.Kind == lexer.TokenTypePeriod {
+			return p.consumeArrowFunction(leftNode)
+		}
+
 		rightNode, ok := p.tryConsumeBaseExpression()
 		if !ok {
 			return nil, false
@@ -489,7 +531,42 @@ func (p *sourceParser) tryConsumeArrowExpression() (AstNode, bool) {
 		exprNode.Connect(dslshape.NodeExpressionPredicateRightExpr, rightNode)
 		return exprNode, true
 	}
-	return p.performLeftRecursiveParsing(p.tryConsumeIdentifierLiteral, rightNodeBuilder, nil, lexer.TokenTypeRightArrow)
+	return p.performLeftRecursiveParsing(p.tryConsumeIdentifierLiteral, rightNodeBuilder, nil, lexer.TokenTypeRightArrow, lexer.TokenTypePeriod)
+}
+
+// consumeArrowFunction consumes the function form of an arrow, following the period.
+// ```any(bar)```
+// ```all(bar)```
+func (p *sourceParser) consumeArrowFunction(leftNode AstNode) (AstNode, bool) {
+	functionName, ok := p.consumeIdentifier()
+	if !ok {
+		return nil, false
+	}
+
+	if functionName != "any" && functionName != "all" {
+		p.emitErrorf("Expected 'any' or 'all' for arrow function, found: %s", functionName)
+		return nil, false
+	}
+
+	if _, ok := p.consume(lexer.TokenTypeLeftParen); !ok {
+		return nil, false
+	}
+
+	rightNode, ok := p.tryConsumeIdentifierLiteral()
+	if !ok {
+		p.emitErrorf("Expected relation name in arrow function, found: %v", p.currentToken.Kind)
+		return nil, false
+	}
+
+	if _, ok := p.consume(lexer.TokenTypeRightParen); !ok {
+		return nil, false
+	}
+
+	exprNode := p.createNode(dslshape.NodeTypeArrowExpression)
+	exprNode.Connect(dslshape.NodeExpressionPredicateLeftExpr, leftNode)
+	exprNode.Connect(dslshape.NodeExpressionPredicateRightExpr, rightNode)
+	exprNode.MustDecorate(dslshape.NodeArrowExpressionFunctionName, functionName)
+	return exprNode, true
 }
 
 // tryConsumeBaseExpression attempts to consume base compute expressions (identifiers, parenthesis).
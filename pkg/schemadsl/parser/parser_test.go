@@ -94,6 +94,10 @@ func TestParser(t *testing.T) {
 		{"basic definition test", "basic"},
 		{"doc comments test", "doccomments"},
 		{"arrow test", "arrow"},
+		{"arrow functions test", "arrowfunctions"},
+		{"import test", "import"},
+		{"unquoted import test", "import_unquoted"},
+		{"import as relation and permission name test", "import_as_name"},
 		{"multiple definition test", "multidef"},
 		{"broken test", "broken"},
 		{"relation missing type test", "relation_missing_type"},
@@ -1,6 +1,9 @@
 package tuple
 
-import "google.golang.org/protobuf/types/known/structpb"
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 type objectReference interface {
 	GetObjectType() string
@@ -24,3 +27,7 @@ type relationship[R objectReference, S subjectReference[R], C caveat] interface
 	GetSubject() S
 	GetOptionalCaveat() C
 }
+
+type expiringRelationship interface {
+	GetOptionalExpiresAt() *timestamppb.Timestamp
+}
@@ -7,11 +7,13 @@ import (
 	"reflect"
 	"regexp"
 	"slices"
+	"time"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/jzelinskie/stringz"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	core "github.com/authzed/spicedb/pkg/proto/core/v1"
 )
@@ -49,6 +51,8 @@ var subjectExpr = fmt.Sprintf(
 
 var caveatExpr = fmt.Sprintf(`\[(?P<caveatName>(%s))(:(?P<caveatContext>(\{(.+)\})))?\]`, caveatNameExpr)
 
+var expirationExpr = `\[expiration:(?P<expirationDateTime>[0-9][0-9:.\-+TZ]+)\]`
+
 var (
 	onrRegex        = regexp.MustCompile(fmt.Sprintf("^%s$", onrExpr))
 	subjectRegex    = regexp.MustCompile(fmt.Sprintf("^%s$", subjectExpr))
@@ -58,10 +62,11 @@ var (
 
 var parserRegex = regexp.MustCompile(
 	fmt.Sprintf(
-		`^%s@%s(%s)?$`,
+		`^%s@%s(%s)?(%s)?$`,
 		onrExpr,
 		subjectExpr,
 		caveatExpr,
+		expirationExpr,
 	),
 )
 
@@ -109,7 +114,7 @@ func String(tpl *core.RelationTuple) (string, error) {
 		return "", err
 	}
 
-	return fmt.Sprintf("%s@%s%s", StringONR(tpl.ResourceAndRelation), StringONR(tpl.Subject), caveatString), nil
+	return fmt.Sprintf("%s@%s%s%s", StringONR(tpl.ResourceAndRelation), StringONR(tpl.Subject), caveatString, StringExpiration(tpl.OptionalExpirationTime)), nil
 }
 
 // StringWithoutCaveat converts a tuple to a string, without its caveat included.
@@ -147,6 +152,16 @@ func StringCaveat(caveat *core.ContextualizedCaveat) (string, error) {
 	return fmt.Sprintf("[%s%s]", caveat.CaveatName, contextString), nil
 }
 
+// StringExpiration converts an expiration time to a string. If the expiration is nil, returns an
+// empty string.
+func StringExpiration(expiration *timestamppb.Timestamp) string {
+	if expiration == nil {
+		return ""
+	}
+
+	return "[expiration:" + expiration.AsTime().UTC().Format(time.RFC3339Nano) + "]"
+}
+
 // StringCaveatContext converts the context of a caveat to a string. If the context is nil or empty, returns an empty string.
 func StringCaveatContext(context *structpb.Struct) (string, error) {
 	if context == nil || len(context.Fields) == 0 {
@@ -218,6 +233,17 @@ func Parse(tpl string) *core.RelationTuple {
 		}
 	}
 
+	var optionalExpiration *timestamppb.Timestamp
+	expirationString := groups[slices.Index(parserRegex.SubexpNames(), "expirationDateTime")]
+	if expirationString != "" {
+		expiration, err := time.Parse(time.RFC3339Nano, expirationString)
+		if err != nil {
+			return nil
+		}
+
+		optionalExpiration = timestamppb.New(expiration)
+	}
+
 	resourceID := groups[slices.Index(parserRegex.SubexpNames(), "resourceID")]
 	if err := ValidateResourceID(resourceID); err != nil {
 		return nil
@@ -239,7 +265,8 @@ func Parse(tpl string) *core.RelationTuple {
 			ObjectId:  subjectID,
 			Relation:  subjectRelation,
 		},
-		Caveat: optionalCaveat,
+		Caveat:                 optionalCaveat,
+		OptionalExpirationTime: optionalExpiration,
 	}
 }
 
@@ -274,7 +301,23 @@ func Delete(tpl *core.RelationTuple) *core.RelationTupleUpdate {
 
 // Equal returns true if the two relationships are exactly the same.
 func Equal(lhs, rhs *core.RelationTuple) bool {
-	return OnrEqual(lhs.ResourceAndRelation, rhs.ResourceAndRelation) && OnrEqual(lhs.Subject, rhs.Subject) && caveatEqual(lhs.Caveat, rhs.Caveat)
+	return OnrEqual(lhs.ResourceAndRelation, rhs.ResourceAndRelation) &&
+		OnrEqual(lhs.Subject, rhs.Subject) &&
+		caveatEqual(lhs.Caveat, rhs.Caveat) &&
+		expirationEqual(lhs.OptionalExpirationTime, rhs.OptionalExpirationTime)
+}
+
+func expirationEqual(lhs, rhs *timestamppb.Timestamp) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == nil && rhs == nil
+	}
+
+	return lhs.AsTime().Equal(rhs.AsTime())
+}
+
+// IsExpired returns true if the tuple has an expiration time which is at or before the given time.
+func IsExpired(tpl *core.RelationTuple, now time.Time) bool {
+	return tpl.OptionalExpirationTime != nil && !tpl.OptionalExpirationTime.AsTime().After(now)
 }
 
 func caveatEqual(lhs, rhs *core.ContextualizedCaveat) bool {
@@ -321,7 +364,8 @@ func ToRelationship(tpl *core.RelationTuple) *v1.Relationship {
 			},
 			OptionalRelation: stringz.Default(tpl.Subject.Relation, "", Ellipsis),
 		},
-		OptionalCaveat: caveat,
+		OptionalCaveat:    caveat,
+		OptionalExpiresAt: tpl.OptionalExpirationTime,
 	}
 }
 
@@ -344,6 +388,7 @@ func MustToRelationshipMutating(source *core.RelationTuple, targetRel *v1.Relati
 	targetRel.Subject.Object.ObjectId = source.Subject.ObjectId
 	targetRel.Subject.OptionalRelation = stringz.Default(source.Subject.Relation, "", Ellipsis)
 	targetRel.OptionalCaveat = nil
+	targetRel.OptionalExpiresAt = source.OptionalExpirationTime
 
 	if source.Caveat != nil {
 		if targetCaveat == nil {
@@ -490,6 +535,12 @@ func CopyRelationshipToRelationTuple[T objectReference, S subjectReference[T], C
 	dst.Subject.Namespace = r.GetSubject().GetObject().GetObjectType()
 	dst.Subject.ObjectId = r.GetSubject().GetObject().GetObjectId()
 	dst.Subject.Relation = stringz.DefaultEmpty(r.GetSubject().GetOptionalRelation(), Ellipsis)
+
+	// Only some relationship types (such as the v1 API Relationship) carry an expiration.
+	dst.OptionalExpirationTime = nil
+	if withExpiration, ok := r.(expiringRelationship); ok {
+		dst.OptionalExpirationTime = withExpiration.GetOptionalExpiresAt()
+	}
 }
 
 // CopyRelationTupleToRelationship copies a source core.RelationTuple to a
@@ -516,6 +567,8 @@ func CopyRelationTupleToRelationship(
 	} else {
 		dst.OptionalCaveat = nil
 	}
+
+	dst.OptionalExpiresAt = src.OptionalExpirationTime
 }
 
 // UpdateFromRelationshipUpdate converts a RelationshipUpdate into a
@@ -572,3 +625,10 @@ func WithCaveat(tpl *core.RelationTuple, caveatName string, contexts ...map[stri
 	}
 	return tpl, nil
 }
+
+// WithExpiration sets the given expiration time on the tuple. This is for testing only.
+func WithExpiration(tpl *core.RelationTuple, expiration time.Time) *core.RelationTuple {
+	tpl = tpl.CloneVT()
+	tpl.OptionalExpirationTime = timestamppb.New(expiration)
+	return tpl
+}
@@ -3,6 +3,7 @@ package tuple
 import (
 	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/stretchr/testify/require"
@@ -421,6 +422,92 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseAndSerializeExpiration(t *testing.T) {
+	expiration := time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	expirationTestCases := []struct {
+		input          string
+		expectedOutput string
+		tupleFormat    *core.RelationTuple
+	}{
+		{
+			input:          "document:foo#viewer@user:tom[expiration:2026-12-31T23:59:59Z]",
+			expectedOutput: "document:foo#viewer@user:tom[expiration:2026-12-31T23:59:59Z]",
+			tupleFormat: WithExpiration(
+				makeTuple(
+					ObjectAndRelation("document", "foo", "viewer"),
+					ObjectAndRelation("user", "tom", "..."),
+				),
+				expiration,
+			),
+		},
+		{
+			input:          "document:foo#viewer@user:tom[expiration:2026-12-31T18:59:59-05:00]",
+			expectedOutput: "document:foo#viewer@user:tom[expiration:2026-12-31T23:59:59Z]",
+			tupleFormat: WithExpiration(
+				makeTuple(
+					ObjectAndRelation("document", "foo", "viewer"),
+					ObjectAndRelation("user", "tom", "..."),
+				),
+				expiration,
+			),
+		},
+		{
+			input:          `document:foo#viewer@user:tom[somecaveat:{"hi":"there"}][expiration:2026-12-31T23:59:59Z]`,
+			expectedOutput: `document:foo#viewer@user:tom[somecaveat:{"hi":"there"}][expiration:2026-12-31T23:59:59Z]`,
+			tupleFormat: WithExpiration(
+				MustWithCaveat(
+					makeTuple(
+						ObjectAndRelation("document", "foo", "viewer"),
+						ObjectAndRelation("user", "tom", "..."),
+					),
+					"somecaveat",
+					map[string]any{"hi": "there"},
+				),
+				expiration,
+			),
+		},
+		{
+			input:          "document:foo#viewer@user:tom[expiration:tomorrow]",
+			expectedOutput: "",
+			tupleFormat:    nil,
+		},
+		{
+			input:          "document:foo#viewer@user:tom[expiration:2026-13-45T23:59:59Z]",
+			expectedOutput: "",
+			tupleFormat:    nil,
+		},
+		{
+			input:          "document:foo#viewer@user:tom[expiration:2026-12-31T23:59:59Z][somecaveat]",
+			expectedOutput: "",
+			tupleFormat:    nil,
+		},
+	}
+
+	for _, tc := range expirationTestCases {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			parsed := Parse(tc.input)
+			testutil.RequireProtoEqual(t, tc.tupleFormat, parsed, "found difference in parsed tuple")
+			if tc.tupleFormat == nil {
+				return
+			}
+
+			require.Equal(t, tc.expectedOutput, MustString(parsed))
+			require.NotContains(t, StringWithoutCaveat(parsed), "expiration")
+		})
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Now()
+
+	require.False(t, IsExpired(MustParse("document:foo#viewer@user:tom"), now))
+	require.False(t, IsExpired(WithExpiration(MustParse("document:foo#viewer@user:tom"), now.Add(time.Second)), now))
+	require.True(t, IsExpired(WithExpiration(MustParse("document:foo#viewer@user:tom"), now), now))
+	require.True(t, IsExpired(WithExpiration(MustParse("document:foo#viewer@user:tom"), now.Add(-time.Second)), now))
+}
+
 func TestConvert(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
@@ -587,6 +674,8 @@ func TestEqual(t *testing.T) {
 		MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":{\"yo\":123}}]"),
 		MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":{\"yo\":{\"hey\":true}}, \"hi2\":{\"yo2\":{\"hey2\":false}}}]"),
 		MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":{\"yo\":{\"hey\":true}}, \"hi2\":{\"yo2\":{\"hey2\":[1,2,3]}}}]"),
+		MustParse("document:foo#viewer@user:tom[expiration:2020-01-01T00:00:00Z]"),
+		MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":\"there\"}][expiration:2020-01-01T00:00:00.5Z]"),
 	}
 
 	for _, tc := range equalTestCases {
@@ -750,6 +839,16 @@ func TestEqual(t *testing.T) {
 			lhs:  MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":{\"yo\":123}}]"),
 			rhs:  MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":{\"yo\":124}}]"),
 		},
+		{
+			name: "missing expiration",
+			lhs:  MustParse("document:foo#viewer@user:tom[expiration:2020-01-01T00:00:00Z]"),
+			rhs:  MustParse("document:foo#viewer@user:tom"),
+		},
+		{
+			name: "mismatch expiration",
+			lhs:  MustParse("document:foo#viewer@user:tom[expiration:2020-01-01T00:00:00Z]"),
+			rhs:  MustParse("document:foo#viewer@user:tom[expiration:2020-01-01T00:00:01Z]"),
+		},
 		{
 			name: "mismatch caveat context, deeply nested with array",
 			lhs:  MustParse("document:foo#viewer@user:tom[somecaveat:{\"hi\":{\"yo\":[1,2,3]}}]"),
@@ -103,6 +103,13 @@ func computeRewriteOpReachability(ctx context.Context, children []*core.SetOpera
 				return err
 			}
 
+			// An arrow with the `all` function requires the subject to be found via *every*
+			// subject of the tupleset, so reaching it through any one of them is conditional.
+			arrowResultState := operationResultState
+			if child.TupleToUserset.Function == core.TupleToUserset_FUNCTION_ALL {
+				arrowResultState = core.ReachabilityEntrypoint_REACHABLE_CONDITIONAL_RESULT
+			}
+
 			computedUsersetRelation := child.TupleToUserset.ComputedUserset.Relation
 			for _, allowedRelationType := range directRelationTypes {
 				// For each namespace allowed to be found on the right hand side of the
@@ -144,7 +151,7 @@ func computeRewriteOpReachability(ctx context.Context, children []*core.SetOpera
 					err := addSubjectEntrypoint(graph, allowedRelationType.Namespace, computedUsersetRelation, &core.ReachabilityEntrypoint{
 						Kind:             core.ReachabilityEntrypoint_TUPLESET_TO_USERSET_ENTRYPOINT,
 						TargetRelation:   rr,
-						ResultStatus:     operationResultState,
+						ResultStatus:     arrowResultState,
 						TuplesetRelation: tuplesetRelation,
 					})
 					if err != nil {